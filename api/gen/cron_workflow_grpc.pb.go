@@ -22,6 +22,7 @@ type CronWorkflowServiceClient interface {
 	UpdateCronWorkflow(ctx context.Context, in *UpdateCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error)
 	GetCronWorkflow(ctx context.Context, in *GetCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflow, error)
 	ListCronWorkflows(ctx context.Context, in *ListCronWorkflowRequest, opts ...grpc.CallOption) (*ListCronWorkflowsResponse, error)
+	UpdateCronWorkflowSharing(ctx context.Context, in *UpdateCronWorkflowSharingRequest, opts ...grpc.CallOption) (*Sharing, error)
 	DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
@@ -69,6 +70,15 @@ func (c *cronWorkflowServiceClient) ListCronWorkflows(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *cronWorkflowServiceClient) UpdateCronWorkflowSharing(ctx context.Context, in *UpdateCronWorkflowSharingRequest, opts ...grpc.CallOption) (*Sharing, error) {
+	out := new(Sharing)
+	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/UpdateCronWorkflowSharing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cronWorkflowServiceClient) DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.CronWorkflowService/DeleteCronWorkflow", in, out, opts...)
@@ -86,6 +96,7 @@ type CronWorkflowServiceServer interface {
 	UpdateCronWorkflow(context.Context, *UpdateCronWorkflowRequest) (*CronWorkflow, error)
 	GetCronWorkflow(context.Context, *GetCronWorkflowRequest) (*CronWorkflow, error)
 	ListCronWorkflows(context.Context, *ListCronWorkflowRequest) (*ListCronWorkflowsResponse, error)
+	UpdateCronWorkflowSharing(context.Context, *UpdateCronWorkflowSharingRequest) (*Sharing, error)
 	DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCronWorkflowServiceServer()
 }
@@ -106,6 +117,9 @@ func (UnimplementedCronWorkflowServiceServer) GetCronWorkflow(context.Context, *
 func (UnimplementedCronWorkflowServiceServer) ListCronWorkflows(context.Context, *ListCronWorkflowRequest) (*ListCronWorkflowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCronWorkflows not implemented")
 }
+func (UnimplementedCronWorkflowServiceServer) UpdateCronWorkflowSharing(context.Context, *UpdateCronWorkflowSharingRequest) (*Sharing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCronWorkflowSharing not implemented")
+}
 func (UnimplementedCronWorkflowServiceServer) DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronWorkflow not implemented")
 }
@@ -194,6 +208,24 @@ func _CronWorkflowService_ListCronWorkflows_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronWorkflowService_UpdateCronWorkflowSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCronWorkflowSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).UpdateCronWorkflowSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.CronWorkflowService/UpdateCronWorkflowSharing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).UpdateCronWorkflowSharing(ctx, req.(*UpdateCronWorkflowSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CronWorkflowService_DeleteCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteCronWorkflowRequest)
 	if err := dec(in); err != nil {
@@ -232,6 +264,10 @@ var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListCronWorkflows",
 			Handler:    _CronWorkflowService_ListCronWorkflows_Handler,
 		},
+		{
+			MethodName: "UpdateCronWorkflowSharing",
+			Handler:    _CronWorkflowService_UpdateCronWorkflowSharing_Handler,
+		},
 		{
 			MethodName: "DeleteCronWorkflow",
 			Handler:    _CronWorkflowService_DeleteCronWorkflow_Handler,
@@ -19,6 +19,15 @@ const _ = grpc.SupportPackageIsVersion7
 type NamespaceServiceClient interface {
 	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
 	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*Namespace, error)
+	// Sets up a namespace with the configuration, RBAC and templates of another, role bindings and a resource quota.
+	// Resources that already exist are skipped.
+	BootstrapNamespace(ctx context.Context, in *BootstrapNamespaceRequest, opts ...grpc.CallOption) (*NamespaceBootstrapResult, error)
+	// Deletes a namespace with its resources, volumes and database records.
+	// With dryRun, nothing is deleted and the report has what would be.
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*NamespaceDeletionReport, error)
+	// Copies workflow templates, workspace templates, cron workflows and secrets from sourceNamespace to namespace.
+	// Cron workflows are created suspended.
+	CopyResources(ctx context.Context, in *CopyResourcesRequest, opts ...grpc.CallOption) (*CopyResourcesResponse, error)
 }
 
 type namespaceServiceClient struct {
@@ -47,12 +56,48 @@ func (c *namespaceServiceClient) CreateNamespace(ctx context.Context, in *Create
 	return out, nil
 }
 
+func (c *namespaceServiceClient) BootstrapNamespace(ctx context.Context, in *BootstrapNamespaceRequest, opts ...grpc.CallOption) (*NamespaceBootstrapResult, error) {
+	out := new(NamespaceBootstrapResult)
+	err := c.cc.Invoke(ctx, "/api.NamespaceService/BootstrapNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*NamespaceDeletionReport, error) {
+	out := new(NamespaceDeletionReport)
+	err := c.cc.Invoke(ctx, "/api.NamespaceService/DeleteNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) CopyResources(ctx context.Context, in *CopyResourcesRequest, opts ...grpc.CallOption) (*CopyResourcesResponse, error) {
+	out := new(CopyResourcesResponse)
+	err := c.cc.Invoke(ctx, "/api.NamespaceService/CopyResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NamespaceServiceServer is the server API for NamespaceService service.
 // All implementations must embed UnimplementedNamespaceServiceServer
 // for forward compatibility
 type NamespaceServiceServer interface {
 	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*Namespace, error)
+	// Sets up a namespace with the configuration, RBAC and templates of another, role bindings and a resource quota.
+	// Resources that already exist are skipped.
+	BootstrapNamespace(context.Context, *BootstrapNamespaceRequest) (*NamespaceBootstrapResult, error)
+	// Deletes a namespace with its resources, volumes and database records.
+	// With dryRun, nothing is deleted and the report has what would be.
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*NamespaceDeletionReport, error)
+	// Copies workflow templates, workspace templates, cron workflows and secrets from sourceNamespace to namespace.
+	// Cron workflows are created suspended.
+	CopyResources(context.Context, *CopyResourcesRequest) (*CopyResourcesResponse, error)
 	mustEmbedUnimplementedNamespaceServiceServer()
 }
 
@@ -66,6 +111,15 @@ func (UnimplementedNamespaceServiceServer) ListNamespaces(context.Context, *List
 func (UnimplementedNamespaceServiceServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*Namespace, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
 }
+func (UnimplementedNamespaceServiceServer) BootstrapNamespace(context.Context, *BootstrapNamespaceRequest) (*NamespaceBootstrapResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootstrapNamespace not implemented")
+}
+func (UnimplementedNamespaceServiceServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*NamespaceDeletionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedNamespaceServiceServer) CopyResources(context.Context, *CopyResourcesRequest) (*CopyResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyResources not implemented")
+}
 func (UnimplementedNamespaceServiceServer) mustEmbedUnimplementedNamespaceServiceServer() {}
 
 // UnsafeNamespaceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -115,6 +169,60 @@ func _NamespaceService_CreateNamespace_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespaceService_BootstrapNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).BootstrapNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NamespaceService/BootstrapNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).BootstrapNamespace(ctx, req.(*BootstrapNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NamespaceService/DeleteNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_CopyResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).CopyResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.NamespaceService/CopyResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).CopyResources(ctx, req.(*CopyResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NamespaceService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.NamespaceService",
 	HandlerType: (*NamespaceServiceServer)(nil),
@@ -127,6 +235,18 @@ var _NamespaceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNamespace",
 			Handler:    _NamespaceService_CreateNamespace_Handler,
 		},
+		{
+			MethodName: "BootstrapNamespace",
+			Handler:    _NamespaceService_BootstrapNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _NamespaceService_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "CopyResources",
+			Handler:    _NamespaceService_CopyResources_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "namespace.proto",
@@ -26,6 +26,14 @@ type SecretServiceClient interface {
 	DeleteSecretKey(ctx context.Context, in *DeleteSecretKeyRequest, opts ...grpc.CallOption) (*DeleteSecretKeyResponse, error)
 	AddSecretKeyValue(ctx context.Context, in *AddSecretKeyValueRequest, opts ...grpc.CallOption) (*AddSecretKeyValueResponse, error)
 	UpdateSecretKeyValue(ctx context.Context, in *UpdateSecretKeyValueRequest, opts ...grpc.CallOption) (*UpdateSecretKeyValueResponse, error)
+	// Upserts and deletes many keys of a secret in one atomic change
+	PatchSecret(ctx context.Context, in *PatchSecretRequest, opts ...grpc.CallOption) (*SecretVersion, error)
+	// Creates a secret with registry, S3 or GCS credentials, or an SSH key
+	CreateTypedSecret(ctx context.Context, in *CreateTypedSecretRequest, opts ...grpc.CallOption) (*Secret, error)
+	ListSecretVersions(ctx context.Context, in *ListSecretVersionsRequest, opts ...grpc.CallOption) (*ListSecretVersionsResponse, error)
+	RollbackSecret(ctx context.Context, in *RollbackSecretRequest, opts ...grpc.CallOption) (*SecretVersion, error)
+	// Returns the templates, cron workflows and workspaces that reference a secret
+	GetSecretUsage(ctx context.Context, in *GetSecretUsageRequest, opts ...grpc.CallOption) (*SecretUsage, error)
 }
 
 type secretServiceClient struct {
@@ -108,6 +116,51 @@ func (c *secretServiceClient) UpdateSecretKeyValue(ctx context.Context, in *Upda
 	return out, nil
 }
 
+func (c *secretServiceClient) PatchSecret(ctx context.Context, in *PatchSecretRequest, opts ...grpc.CallOption) (*SecretVersion, error) {
+	out := new(SecretVersion)
+	err := c.cc.Invoke(ctx, "/api.SecretService/PatchSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretServiceClient) CreateTypedSecret(ctx context.Context, in *CreateTypedSecretRequest, opts ...grpc.CallOption) (*Secret, error) {
+	out := new(Secret)
+	err := c.cc.Invoke(ctx, "/api.SecretService/CreateTypedSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretServiceClient) ListSecretVersions(ctx context.Context, in *ListSecretVersionsRequest, opts ...grpc.CallOption) (*ListSecretVersionsResponse, error) {
+	out := new(ListSecretVersionsResponse)
+	err := c.cc.Invoke(ctx, "/api.SecretService/ListSecretVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretServiceClient) RollbackSecret(ctx context.Context, in *RollbackSecretRequest, opts ...grpc.CallOption) (*SecretVersion, error) {
+	out := new(SecretVersion)
+	err := c.cc.Invoke(ctx, "/api.SecretService/RollbackSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretServiceClient) GetSecretUsage(ctx context.Context, in *GetSecretUsageRequest, opts ...grpc.CallOption) (*SecretUsage, error) {
+	out := new(SecretUsage)
+	err := c.cc.Invoke(ctx, "/api.SecretService/GetSecretUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretServiceServer is the server API for SecretService service.
 // All implementations must embed UnimplementedSecretServiceServer
 // for forward compatibility
@@ -120,6 +173,14 @@ type SecretServiceServer interface {
 	DeleteSecretKey(context.Context, *DeleteSecretKeyRequest) (*DeleteSecretKeyResponse, error)
 	AddSecretKeyValue(context.Context, *AddSecretKeyValueRequest) (*AddSecretKeyValueResponse, error)
 	UpdateSecretKeyValue(context.Context, *UpdateSecretKeyValueRequest) (*UpdateSecretKeyValueResponse, error)
+	// Upserts and deletes many keys of a secret in one atomic change
+	PatchSecret(context.Context, *PatchSecretRequest) (*SecretVersion, error)
+	// Creates a secret with registry, S3 or GCS credentials, or an SSH key
+	CreateTypedSecret(context.Context, *CreateTypedSecretRequest) (*Secret, error)
+	ListSecretVersions(context.Context, *ListSecretVersionsRequest) (*ListSecretVersionsResponse, error)
+	RollbackSecret(context.Context, *RollbackSecretRequest) (*SecretVersion, error)
+	// Returns the templates, cron workflows and workspaces that reference a secret
+	GetSecretUsage(context.Context, *GetSecretUsageRequest) (*SecretUsage, error)
 	mustEmbedUnimplementedSecretServiceServer()
 }
 
@@ -151,6 +212,21 @@ func (UnimplementedSecretServiceServer) AddSecretKeyValue(context.Context, *AddS
 func (UnimplementedSecretServiceServer) UpdateSecretKeyValue(context.Context, *UpdateSecretKeyValueRequest) (*UpdateSecretKeyValueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecretKeyValue not implemented")
 }
+func (UnimplementedSecretServiceServer) PatchSecret(context.Context, *PatchSecretRequest) (*SecretVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchSecret not implemented")
+}
+func (UnimplementedSecretServiceServer) CreateTypedSecret(context.Context, *CreateTypedSecretRequest) (*Secret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTypedSecret not implemented")
+}
+func (UnimplementedSecretServiceServer) ListSecretVersions(context.Context, *ListSecretVersionsRequest) (*ListSecretVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecretVersions not implemented")
+}
+func (UnimplementedSecretServiceServer) RollbackSecret(context.Context, *RollbackSecretRequest) (*SecretVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackSecret not implemented")
+}
+func (UnimplementedSecretServiceServer) GetSecretUsage(context.Context, *GetSecretUsageRequest) (*SecretUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretUsage not implemented")
+}
 func (UnimplementedSecretServiceServer) mustEmbedUnimplementedSecretServiceServer() {}
 
 // UnsafeSecretServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -308,6 +384,96 @@ func _SecretService_UpdateSecretKeyValue_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecretService_PatchSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).PatchSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SecretService/PatchSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).PatchSecret(ctx, req.(*PatchSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretService_CreateTypedSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTypedSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).CreateTypedSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SecretService/CreateTypedSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).CreateTypedSecret(ctx, req.(*CreateTypedSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretService_ListSecretVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).ListSecretVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SecretService/ListSecretVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).ListSecretVersions(ctx, req.(*ListSecretVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretService_RollbackSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).RollbackSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SecretService/RollbackSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).RollbackSecret(ctx, req.(*RollbackSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SecretService_GetSecretUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretServiceServer).GetSecretUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.SecretService/GetSecretUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretServiceServer).GetSecretUsage(ctx, req.(*GetSecretUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SecretService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.SecretService",
 	HandlerType: (*SecretServiceServer)(nil),
@@ -344,6 +510,26 @@ var _SecretService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSecretKeyValue",
 			Handler:    _SecretService_UpdateSecretKeyValue_Handler,
 		},
+		{
+			MethodName: "PatchSecret",
+			Handler:    _SecretService_PatchSecret_Handler,
+		},
+		{
+			MethodName: "CreateTypedSecret",
+			Handler:    _SecretService_CreateTypedSecret_Handler,
+		},
+		{
+			MethodName: "ListSecretVersions",
+			Handler:    _SecretService_ListSecretVersions_Handler,
+		},
+		{
+			MethodName: "RollbackSecret",
+			Handler:    _SecretService_RollbackSecret_Handler,
+		},
+		{
+			MethodName: "GetSecretUsage",
+			Handler:    _SecretService_GetSecretUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret.proto",
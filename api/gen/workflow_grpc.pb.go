@@ -27,9 +27,34 @@ type WorkflowServiceClient interface {
 	ListWorkflowExecutions(ctx context.Context, in *ListWorkflowExecutionsRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionsResponse, error)
 	WatchWorkflowExecution(ctx context.Context, in *WatchWorkflowExecutionRequest, opts ...grpc.CallOption) (WorkflowService_WatchWorkflowExecutionClient, error)
 	GetWorkflowExecutionLogs(ctx context.Context, in *GetWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (WorkflowService_GetWorkflowExecutionLogsClient, error)
+	// Searches the logs of all of the pods of a workflow execution
+	SearchWorkflowExecutionLogs(ctx context.Context, in *SearchWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*SearchWorkflowExecutionLogsResponse, error)
+	// Returns the logs of all of the pods of a workflow execution as a single zip or gzipped tar file
+	// The file is in the data of the response. Logs over 64 MiB in total are not bundled, FailedPrecondition is returned.
+	DownloadWorkflowExecutionLogs(ctx context.Context, in *DownloadWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*WorkflowExecutionLogsBundle, error)
+	// Explains why the nodes of a workflow execution are pending, or why they failed
+	GetWorkflowExecutionDiagnostics(ctx context.Context, in *GetWorkflowExecutionDiagnosticsRequest, opts ...grpc.CallOption) (*WorkflowExecutionDiagnostics, error)
+	// Returns when every node of a workflow execution was queued, scheduled, started and finished, and its critical path
+	GetWorkflowExecutionTimeline(ctx context.Context, in *GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*WorkflowExecutionTimeline, error)
 	GetWorkflowExecutionMetrics(ctx context.Context, in *GetWorkflowExecutionMetricsRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionMetricsResponse, error)
 	ResubmitWorkflowExecution(ctx context.Context, in *ResubmitWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	// Retries a Failed or Error workflow execution, optionally from a node and with parameter overrides.
+	// Successful nodes that are not descendants of the node keep their outputs.
+	RetryWorkflowExecution(ctx context.Context, in *RetryWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Resumes a suspended WorkflowExecution and its suspended nodes, except for approval gates.
+	ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error)
+	// Approves an approval gate, a suspend node whose template is annotated with onepanel.io/approval-required: "true"
+	// It needs the approve action on workflows, which resuming doesn't give, e.g. the approver role
+	ApproveWorkflowNode(ctx context.Context, in *ApproveWorkflowNodeRequest, opts ...grpc.CallOption) (*WorkflowExecutionApproval, error)
+	ListWorkflowExecutionApprovals(ctx context.Context, in *ListWorkflowExecutionApprovalsRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionApprovalsResponse, error)
+	UpdateWorkflowExecutionSharing(ctx context.Context, in *UpdateWorkflowExecutionSharingRequest, opts ...grpc.CallOption) (*Sharing, error)
 	TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates an execution of a workflow template for each combination of parameter values in a sweep
+	CreateWorkflowExecutionSweep(ctx context.Context, in *CreateWorkflowExecutionSweepRequest, opts ...grpc.CallOption) (*WorkflowExecutionSweep, error)
+	GetWorkflowExecutionSweep(ctx context.Context, in *GetWorkflowExecutionSweepRequest, opts ...grpc.CallOption) (*WorkflowExecutionSweep, error)
+	// Terminates every execution of a sweep that has not finished
+	TerminateWorkflowExecutionSweep(ctx context.Context, in *TerminateWorkflowExecutionSweepRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetArtifact(ctx context.Context, in *GetArtifactRequest, opts ...grpc.CallOption) (*ArtifactResponse, error)
 	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
 	AddWorkflowExecutionStatistics(ctx context.Context, in *AddWorkflowExecutionStatisticRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -157,6 +182,42 @@ func (x *workflowServiceGetWorkflowExecutionLogsClient) Recv() (*LogStreamRespon
 	return m, nil
 }
 
+func (c *workflowServiceClient) SearchWorkflowExecutionLogs(ctx context.Context, in *SearchWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*SearchWorkflowExecutionLogsResponse, error) {
+	out := new(SearchWorkflowExecutionLogsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/SearchWorkflowExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) DownloadWorkflowExecutionLogs(ctx context.Context, in *DownloadWorkflowExecutionLogsRequest, opts ...grpc.CallOption) (*WorkflowExecutionLogsBundle, error) {
+	out := new(WorkflowExecutionLogsBundle)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/DownloadWorkflowExecutionLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetWorkflowExecutionDiagnostics(ctx context.Context, in *GetWorkflowExecutionDiagnosticsRequest, opts ...grpc.CallOption) (*WorkflowExecutionDiagnostics, error) {
+	out := new(WorkflowExecutionDiagnostics)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetWorkflowExecutionDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetWorkflowExecutionTimeline(ctx context.Context, in *GetWorkflowExecutionTimelineRequest, opts ...grpc.CallOption) (*WorkflowExecutionTimeline, error) {
+	out := new(WorkflowExecutionTimeline)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetWorkflowExecutionTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) GetWorkflowExecutionMetrics(ctx context.Context, in *GetWorkflowExecutionMetricsRequest, opts ...grpc.CallOption) (*GetWorkflowExecutionMetricsResponse, error) {
 	out := new(GetWorkflowExecutionMetricsResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetWorkflowExecutionMetrics", in, out, opts...)
@@ -175,6 +236,60 @@ func (c *workflowServiceClient) ResubmitWorkflowExecution(ctx context.Context, i
 	return out, nil
 }
 
+func (c *workflowServiceClient) RetryWorkflowExecution(ctx context.Context, in *RetryWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
+	out := new(WorkflowExecution)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/RetryWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) SuspendWorkflowExecution(ctx context.Context, in *SuspendWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/SuspendWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ResumeWorkflowExecution(ctx context.Context, in *ResumeWorkflowExecutionRequest, opts ...grpc.CallOption) (*WorkflowExecution, error) {
+	out := new(WorkflowExecution)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/ResumeWorkflowExecution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ApproveWorkflowNode(ctx context.Context, in *ApproveWorkflowNodeRequest, opts ...grpc.CallOption) (*WorkflowExecutionApproval, error) {
+	out := new(WorkflowExecutionApproval)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/ApproveWorkflowNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) ListWorkflowExecutionApprovals(ctx context.Context, in *ListWorkflowExecutionApprovalsRequest, opts ...grpc.CallOption) (*ListWorkflowExecutionApprovalsResponse, error) {
+	out := new(ListWorkflowExecutionApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/ListWorkflowExecutionApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) UpdateWorkflowExecutionSharing(ctx context.Context, in *UpdateWorkflowExecutionSharingRequest, opts ...grpc.CallOption) (*Sharing, error) {
+	out := new(Sharing)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/UpdateWorkflowExecutionSharing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) TerminateWorkflowExecution(ctx context.Context, in *TerminateWorkflowExecutionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/TerminateWorkflowExecution", in, out, opts...)
@@ -184,6 +299,33 @@ func (c *workflowServiceClient) TerminateWorkflowExecution(ctx context.Context,
 	return out, nil
 }
 
+func (c *workflowServiceClient) CreateWorkflowExecutionSweep(ctx context.Context, in *CreateWorkflowExecutionSweepRequest, opts ...grpc.CallOption) (*WorkflowExecutionSweep, error) {
+	out := new(WorkflowExecutionSweep)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/CreateWorkflowExecutionSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) GetWorkflowExecutionSweep(ctx context.Context, in *GetWorkflowExecutionSweepRequest, opts ...grpc.CallOption) (*WorkflowExecutionSweep, error) {
+	out := new(WorkflowExecutionSweep)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetWorkflowExecutionSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workflowServiceClient) TerminateWorkflowExecutionSweep(ctx context.Context, in *TerminateWorkflowExecutionSweepRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/api.WorkflowService/TerminateWorkflowExecutionSweep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workflowServiceClient) GetArtifact(ctx context.Context, in *GetArtifactRequest, opts ...grpc.CallOption) (*ArtifactResponse, error) {
 	out := new(ArtifactResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkflowService/GetArtifact", in, out, opts...)
@@ -269,9 +411,34 @@ type WorkflowServiceServer interface {
 	ListWorkflowExecutions(context.Context, *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error)
 	WatchWorkflowExecution(*WatchWorkflowExecutionRequest, WorkflowService_WatchWorkflowExecutionServer) error
 	GetWorkflowExecutionLogs(*GetWorkflowExecutionLogsRequest, WorkflowService_GetWorkflowExecutionLogsServer) error
+	// Searches the logs of all of the pods of a workflow execution
+	SearchWorkflowExecutionLogs(context.Context, *SearchWorkflowExecutionLogsRequest) (*SearchWorkflowExecutionLogsResponse, error)
+	// Returns the logs of all of the pods of a workflow execution as a single zip or gzipped tar file
+	// The file is in the data of the response. Logs over 64 MiB in total are not bundled, FailedPrecondition is returned.
+	DownloadWorkflowExecutionLogs(context.Context, *DownloadWorkflowExecutionLogsRequest) (*WorkflowExecutionLogsBundle, error)
+	// Explains why the nodes of a workflow execution are pending, or why they failed
+	GetWorkflowExecutionDiagnostics(context.Context, *GetWorkflowExecutionDiagnosticsRequest) (*WorkflowExecutionDiagnostics, error)
+	// Returns when every node of a workflow execution was queued, scheduled, started and finished, and its critical path
+	GetWorkflowExecutionTimeline(context.Context, *GetWorkflowExecutionTimelineRequest) (*WorkflowExecutionTimeline, error)
 	GetWorkflowExecutionMetrics(context.Context, *GetWorkflowExecutionMetricsRequest) (*GetWorkflowExecutionMetricsResponse, error)
 	ResubmitWorkflowExecution(context.Context, *ResubmitWorkflowExecutionRequest) (*WorkflowExecution, error)
+	// Retries a Failed or Error workflow execution, optionally from a node and with parameter overrides.
+	// Successful nodes that are not descendants of the node keep their outputs.
+	RetryWorkflowExecution(context.Context, *RetryWorkflowExecutionRequest) (*WorkflowExecution, error)
+	SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*emptypb.Empty, error)
+	// Resumes a suspended WorkflowExecution and its suspended nodes, except for approval gates.
+	ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*WorkflowExecution, error)
+	// Approves an approval gate, a suspend node whose template is annotated with onepanel.io/approval-required: "true"
+	// It needs the approve action on workflows, which resuming doesn't give, e.g. the approver role
+	ApproveWorkflowNode(context.Context, *ApproveWorkflowNodeRequest) (*WorkflowExecutionApproval, error)
+	ListWorkflowExecutionApprovals(context.Context, *ListWorkflowExecutionApprovalsRequest) (*ListWorkflowExecutionApprovalsResponse, error)
+	UpdateWorkflowExecutionSharing(context.Context, *UpdateWorkflowExecutionSharingRequest) (*Sharing, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*emptypb.Empty, error)
+	// Creates an execution of a workflow template for each combination of parameter values in a sweep
+	CreateWorkflowExecutionSweep(context.Context, *CreateWorkflowExecutionSweepRequest) (*WorkflowExecutionSweep, error)
+	GetWorkflowExecutionSweep(context.Context, *GetWorkflowExecutionSweepRequest) (*WorkflowExecutionSweep, error)
+	// Terminates every execution of a sweep that has not finished
+	TerminateWorkflowExecutionSweep(context.Context, *TerminateWorkflowExecutionSweepRequest) (*emptypb.Empty, error)
 	GetArtifact(context.Context, *GetArtifactRequest) (*ArtifactResponse, error)
 	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
 	AddWorkflowExecutionStatistics(context.Context, *AddWorkflowExecutionStatisticRequest) (*emptypb.Empty, error)
@@ -308,15 +475,54 @@ func (UnimplementedWorkflowServiceServer) WatchWorkflowExecution(*WatchWorkflowE
 func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionLogs(*GetWorkflowExecutionLogsRequest, WorkflowService_GetWorkflowExecutionLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionLogs not implemented")
 }
+func (UnimplementedWorkflowServiceServer) SearchWorkflowExecutionLogs(context.Context, *SearchWorkflowExecutionLogsRequest) (*SearchWorkflowExecutionLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchWorkflowExecutionLogs not implemented")
+}
+func (UnimplementedWorkflowServiceServer) DownloadWorkflowExecutionLogs(context.Context, *DownloadWorkflowExecutionLogsRequest) (*WorkflowExecutionLogsBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadWorkflowExecutionLogs not implemented")
+}
+func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionDiagnostics(context.Context, *GetWorkflowExecutionDiagnosticsRequest) (*WorkflowExecutionDiagnostics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionDiagnostics not implemented")
+}
+func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionTimeline(context.Context, *GetWorkflowExecutionTimelineRequest) (*WorkflowExecutionTimeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionTimeline not implemented")
+}
 func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionMetrics(context.Context, *GetWorkflowExecutionMetricsRequest) (*GetWorkflowExecutionMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionMetrics not implemented")
 }
 func (UnimplementedWorkflowServiceServer) ResubmitWorkflowExecution(context.Context, *ResubmitWorkflowExecutionRequest) (*WorkflowExecution, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResubmitWorkflowExecution not implemented")
 }
+func (UnimplementedWorkflowServiceServer) RetryWorkflowExecution(context.Context, *RetryWorkflowExecutionRequest) (*WorkflowExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryWorkflowExecution not implemented")
+}
+func (UnimplementedWorkflowServiceServer) SuspendWorkflowExecution(context.Context, *SuspendWorkflowExecutionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendWorkflowExecution not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ResumeWorkflowExecution(context.Context, *ResumeWorkflowExecutionRequest) (*WorkflowExecution, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWorkflowExecution not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ApproveWorkflowNode(context.Context, *ApproveWorkflowNodeRequest) (*WorkflowExecutionApproval, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWorkflowNode not implemented")
+}
+func (UnimplementedWorkflowServiceServer) ListWorkflowExecutionApprovals(context.Context, *ListWorkflowExecutionApprovalsRequest) (*ListWorkflowExecutionApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkflowExecutionApprovals not implemented")
+}
+func (UnimplementedWorkflowServiceServer) UpdateWorkflowExecutionSharing(context.Context, *UpdateWorkflowExecutionSharingRequest) (*Sharing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkflowExecutionSharing not implemented")
+}
 func (UnimplementedWorkflowServiceServer) TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflowExecution not implemented")
 }
+func (UnimplementedWorkflowServiceServer) CreateWorkflowExecutionSweep(context.Context, *CreateWorkflowExecutionSweepRequest) (*WorkflowExecutionSweep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkflowExecutionSweep not implemented")
+}
+func (UnimplementedWorkflowServiceServer) GetWorkflowExecutionSweep(context.Context, *GetWorkflowExecutionSweepRequest) (*WorkflowExecutionSweep, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowExecutionSweep not implemented")
+}
+func (UnimplementedWorkflowServiceServer) TerminateWorkflowExecutionSweep(context.Context, *TerminateWorkflowExecutionSweepRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateWorkflowExecutionSweep not implemented")
+}
 func (UnimplementedWorkflowServiceServer) GetArtifact(context.Context, *GetArtifactRequest) (*ArtifactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArtifact not implemented")
 }
@@ -486,6 +692,78 @@ func (x *workflowServiceGetWorkflowExecutionLogsServer) Send(m *LogStreamRespons
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WorkflowService_SearchWorkflowExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchWorkflowExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SearchWorkflowExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/SearchWorkflowExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SearchWorkflowExecutionLogs(ctx, req.(*SearchWorkflowExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_DownloadWorkflowExecutionLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadWorkflowExecutionLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).DownloadWorkflowExecutionLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/DownloadWorkflowExecutionLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).DownloadWorkflowExecutionLogs(ctx, req.(*DownloadWorkflowExecutionLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetWorkflowExecutionDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/GetWorkflowExecutionDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionDiagnostics(ctx, req.(*GetWorkflowExecutionDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetWorkflowExecutionTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/GetWorkflowExecutionTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionTimeline(ctx, req.(*GetWorkflowExecutionTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_GetWorkflowExecutionMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWorkflowExecutionMetricsRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +800,114 @@ func _WorkflowService_ResubmitWorkflowExecution_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_RetryWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).RetryWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/RetryWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).RetryWorkflowExecution(ctx, req.(*RetryWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_SuspendWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).SuspendWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/SuspendWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).SuspendWorkflowExecution(ctx, req.(*SuspendWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ResumeWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWorkflowExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ResumeWorkflowExecution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/ResumeWorkflowExecution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ResumeWorkflowExecution(ctx, req.(*ResumeWorkflowExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ApproveWorkflowNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWorkflowNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ApproveWorkflowNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/ApproveWorkflowNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ApproveWorkflowNode(ctx, req.(*ApproveWorkflowNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_ListWorkflowExecutionApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkflowExecutionApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).ListWorkflowExecutionApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/ListWorkflowExecutionApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).ListWorkflowExecutionApprovals(ctx, req.(*ListWorkflowExecutionApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_UpdateWorkflowExecutionSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkflowExecutionSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).UpdateWorkflowExecutionSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/UpdateWorkflowExecutionSharing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).UpdateWorkflowExecutionSharing(ctx, req.(*UpdateWorkflowExecutionSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_TerminateWorkflowExecution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TerminateWorkflowExecutionRequest)
 	if err := dec(in); err != nil {
@@ -540,6 +926,60 @@ func _WorkflowService_TerminateWorkflowExecution_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkflowService_CreateWorkflowExecutionSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkflowExecutionSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).CreateWorkflowExecutionSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/CreateWorkflowExecutionSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).CreateWorkflowExecutionSweep(ctx, req.(*CreateWorkflowExecutionSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_GetWorkflowExecutionSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowExecutionSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/GetWorkflowExecutionSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).GetWorkflowExecutionSweep(ctx, req.(*GetWorkflowExecutionSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkflowService_TerminateWorkflowExecutionSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateWorkflowExecutionSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkflowServiceServer).TerminateWorkflowExecutionSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkflowService/TerminateWorkflowExecutionSweep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkflowServiceServer).TerminateWorkflowExecutionSweep(ctx, req.(*TerminateWorkflowExecutionSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkflowService_GetArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetArtifactRequest)
 	if err := dec(in); err != nil {
@@ -708,6 +1148,22 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListWorkflowExecutions",
 			Handler:    _WorkflowService_ListWorkflowExecutions_Handler,
 		},
+		{
+			MethodName: "SearchWorkflowExecutionLogs",
+			Handler:    _WorkflowService_SearchWorkflowExecutionLogs_Handler,
+		},
+		{
+			MethodName: "DownloadWorkflowExecutionLogs",
+			Handler:    _WorkflowService_DownloadWorkflowExecutionLogs_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionDiagnostics",
+			Handler:    _WorkflowService_GetWorkflowExecutionDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionTimeline",
+			Handler:    _WorkflowService_GetWorkflowExecutionTimeline_Handler,
+		},
 		{
 			MethodName: "GetWorkflowExecutionMetrics",
 			Handler:    _WorkflowService_GetWorkflowExecutionMetrics_Handler,
@@ -716,10 +1172,46 @@ var _WorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResubmitWorkflowExecution",
 			Handler:    _WorkflowService_ResubmitWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "RetryWorkflowExecution",
+			Handler:    _WorkflowService_RetryWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "SuspendWorkflowExecution",
+			Handler:    _WorkflowService_SuspendWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ResumeWorkflowExecution",
+			Handler:    _WorkflowService_ResumeWorkflowExecution_Handler,
+		},
+		{
+			MethodName: "ApproveWorkflowNode",
+			Handler:    _WorkflowService_ApproveWorkflowNode_Handler,
+		},
+		{
+			MethodName: "ListWorkflowExecutionApprovals",
+			Handler:    _WorkflowService_ListWorkflowExecutionApprovals_Handler,
+		},
+		{
+			MethodName: "UpdateWorkflowExecutionSharing",
+			Handler:    _WorkflowService_UpdateWorkflowExecutionSharing_Handler,
+		},
 		{
 			MethodName: "TerminateWorkflowExecution",
 			Handler:    _WorkflowService_TerminateWorkflowExecution_Handler,
 		},
+		{
+			MethodName: "CreateWorkflowExecutionSweep",
+			Handler:    _WorkflowService_CreateWorkflowExecutionSweep_Handler,
+		},
+		{
+			MethodName: "GetWorkflowExecutionSweep",
+			Handler:    _WorkflowService_GetWorkflowExecutionSweep_Handler,
+		},
+		{
+			MethodName: "TerminateWorkflowExecutionSweep",
+			Handler:    _WorkflowService_TerminateWorkflowExecutionSweep_Handler,
+		},
 		{
 			MethodName: "GetArtifact",
 			Handler:    _WorkflowService_GetArtifact_Handler,
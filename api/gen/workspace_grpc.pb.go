@@ -27,8 +27,17 @@ type WorkspaceServiceClient interface {
 	PauseWorkspace(ctx context.Context, in *PauseWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ResumeWorkspace(ctx context.Context, in *ResumeWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteWorkspace(ctx context.Context, in *DeleteWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateWorkspaceSharing(ctx context.Context, in *UpdateWorkspaceSharingRequest, opts ...grpc.CallOption) (*Sharing, error)
 	RetryLastWorkspaceAction(ctx context.Context, in *RetryActionWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetWorkspaceContainerLogs(ctx context.Context, in *GetWorkspaceContainerLogsRequest, opts ...grpc.CallOption) (WorkspaceService_GetWorkspaceContainerLogsClient, error)
+	// Lists the containers and init containers of the workspace's pod
+	ListWorkspaceContainers(ctx context.Context, in *ListWorkspaceContainersRequest, opts ...grpc.CallOption) (*ListWorkspaceContainersResponse, error)
+	// Streams the logs of several containers of the workspace, merged and ordered by timestamp
+	GetWorkspaceLogs(ctx context.Context, in *GetWorkspaceLogsRequest, opts ...grpc.CallOption) (WorkspaceService_GetWorkspaceLogsClient, error)
+	// Returns the events, pod status and workflow status of a workspace, with known problems explained as findings
+	GetWorkspaceDiagnostics(ctx context.Context, in *GetWorkspaceDiagnosticsRequest, opts ...grpc.CallOption) (*WorkspaceDiagnostics, error)
+	// Returns the sampled CPU, memory and GPU usage of a workspace
+	GetWorkspaceMetrics(ctx context.Context, in *GetWorkspaceMetricsRequest, opts ...grpc.CallOption) (*GetWorkspaceMetricsResponse, error)
 	ListWorkspacesField(ctx context.Context, in *ListWorkspacesFieldRequest, opts ...grpc.CallOption) (*ListWorkspacesFieldResponse, error)
 }
 
@@ -121,6 +130,15 @@ func (c *workspaceServiceClient) DeleteWorkspace(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *workspaceServiceClient) UpdateWorkspaceSharing(ctx context.Context, in *UpdateWorkspaceSharingRequest, opts ...grpc.CallOption) (*Sharing, error) {
+	out := new(Sharing)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/UpdateWorkspaceSharing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) RetryLastWorkspaceAction(ctx context.Context, in *RetryActionWorkspaceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceService/RetryLastWorkspaceAction", in, out, opts...)
@@ -162,6 +180,65 @@ func (x *workspaceServiceGetWorkspaceContainerLogsClient) Recv() (*LogStreamResp
 	return m, nil
 }
 
+func (c *workspaceServiceClient) ListWorkspaceContainers(ctx context.Context, in *ListWorkspaceContainersRequest, opts ...grpc.CallOption) (*ListWorkspaceContainersResponse, error) {
+	out := new(ListWorkspaceContainersResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspaceContainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetWorkspaceLogs(ctx context.Context, in *GetWorkspaceLogsRequest, opts ...grpc.CallOption) (WorkspaceService_GetWorkspaceLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WorkspaceService_serviceDesc.Streams[1], "/api.WorkspaceService/GetWorkspaceLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workspaceServiceGetWorkspaceLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkspaceService_GetWorkspaceLogsClient interface {
+	Recv() (*LogStreamResponse, error)
+	grpc.ClientStream
+}
+
+type workspaceServiceGetWorkspaceLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workspaceServiceGetWorkspaceLogsClient) Recv() (*LogStreamResponse, error) {
+	m := new(LogStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workspaceServiceClient) GetWorkspaceDiagnostics(ctx context.Context, in *GetWorkspaceDiagnosticsRequest, opts ...grpc.CallOption) (*WorkspaceDiagnostics, error) {
+	out := new(WorkspaceDiagnostics)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/GetWorkspaceDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workspaceServiceClient) GetWorkspaceMetrics(ctx context.Context, in *GetWorkspaceMetricsRequest, opts ...grpc.CallOption) (*GetWorkspaceMetricsResponse, error) {
+	out := new(GetWorkspaceMetricsResponse)
+	err := c.cc.Invoke(ctx, "/api.WorkspaceService/GetWorkspaceMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workspaceServiceClient) ListWorkspacesField(ctx context.Context, in *ListWorkspacesFieldRequest, opts ...grpc.CallOption) (*ListWorkspacesFieldResponse, error) {
 	out := new(ListWorkspacesFieldResponse)
 	err := c.cc.Invoke(ctx, "/api.WorkspaceService/ListWorkspacesField", in, out, opts...)
@@ -184,8 +261,17 @@ type WorkspaceServiceServer interface {
 	PauseWorkspace(context.Context, *PauseWorkspaceRequest) (*emptypb.Empty, error)
 	ResumeWorkspace(context.Context, *ResumeWorkspaceRequest) (*emptypb.Empty, error)
 	DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*emptypb.Empty, error)
+	UpdateWorkspaceSharing(context.Context, *UpdateWorkspaceSharingRequest) (*Sharing, error)
 	RetryLastWorkspaceAction(context.Context, *RetryActionWorkspaceRequest) (*emptypb.Empty, error)
 	GetWorkspaceContainerLogs(*GetWorkspaceContainerLogsRequest, WorkspaceService_GetWorkspaceContainerLogsServer) error
+	// Lists the containers and init containers of the workspace's pod
+	ListWorkspaceContainers(context.Context, *ListWorkspaceContainersRequest) (*ListWorkspaceContainersResponse, error)
+	// Streams the logs of several containers of the workspace, merged and ordered by timestamp
+	GetWorkspaceLogs(*GetWorkspaceLogsRequest, WorkspaceService_GetWorkspaceLogsServer) error
+	// Returns the events, pod status and workflow status of a workspace, with known problems explained as findings
+	GetWorkspaceDiagnostics(context.Context, *GetWorkspaceDiagnosticsRequest) (*WorkspaceDiagnostics, error)
+	// Returns the sampled CPU, memory and GPU usage of a workspace
+	GetWorkspaceMetrics(context.Context, *GetWorkspaceMetricsRequest) (*GetWorkspaceMetricsResponse, error)
 	ListWorkspacesField(context.Context, *ListWorkspacesFieldRequest) (*ListWorkspacesFieldResponse, error)
 	mustEmbedUnimplementedWorkspaceServiceServer()
 }
@@ -221,12 +307,27 @@ func (UnimplementedWorkspaceServiceServer) ResumeWorkspace(context.Context, *Res
 func (UnimplementedWorkspaceServiceServer) DeleteWorkspace(context.Context, *DeleteWorkspaceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkspace not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) UpdateWorkspaceSharing(context.Context, *UpdateWorkspaceSharingRequest) (*Sharing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkspaceSharing not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) RetryLastWorkspaceAction(context.Context, *RetryActionWorkspaceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RetryLastWorkspaceAction not implemented")
 }
 func (UnimplementedWorkspaceServiceServer) GetWorkspaceContainerLogs(*GetWorkspaceContainerLogsRequest, WorkspaceService_GetWorkspaceContainerLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetWorkspaceContainerLogs not implemented")
 }
+func (UnimplementedWorkspaceServiceServer) ListWorkspaceContainers(context.Context, *ListWorkspaceContainersRequest) (*ListWorkspaceContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspaceContainers not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceLogs(*GetWorkspaceLogsRequest, WorkspaceService_GetWorkspaceLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetWorkspaceLogs not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceDiagnostics(context.Context, *GetWorkspaceDiagnosticsRequest) (*WorkspaceDiagnostics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceDiagnostics not implemented")
+}
+func (UnimplementedWorkspaceServiceServer) GetWorkspaceMetrics(context.Context, *GetWorkspaceMetricsRequest) (*GetWorkspaceMetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkspaceMetrics not implemented")
+}
 func (UnimplementedWorkspaceServiceServer) ListWorkspacesField(context.Context, *ListWorkspacesFieldRequest) (*ListWorkspacesFieldResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWorkspacesField not implemented")
 }
@@ -405,6 +506,24 @@ func _WorkspaceService_DeleteWorkspace_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkspaceService_UpdateWorkspaceSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWorkspaceSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/UpdateWorkspaceSharing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).UpdateWorkspaceSharing(ctx, req.(*UpdateWorkspaceSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_RetryLastWorkspaceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RetryActionWorkspaceRequest)
 	if err := dec(in); err != nil {
@@ -444,6 +563,81 @@ func (x *workspaceServiceGetWorkspaceContainerLogsServer) Send(m *LogStreamRespo
 	return x.ServerStream.SendMsg(m)
 }
 
+func _WorkspaceService_ListWorkspaceContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkspaceContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).ListWorkspaceContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/ListWorkspaceContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).ListWorkspaceContainers(ctx, req.(*ListWorkspaceContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetWorkspaceLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetWorkspaceLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkspaceServiceServer).GetWorkspaceLogs(m, &workspaceServiceGetWorkspaceLogsServer{stream})
+}
+
+type WorkspaceService_GetWorkspaceLogsServer interface {
+	Send(*LogStreamResponse) error
+	grpc.ServerStream
+}
+
+type workspaceServiceGetWorkspaceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workspaceServiceGetWorkspaceLogsServer) Send(m *LogStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkspaceService_GetWorkspaceDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/GetWorkspaceDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceDiagnostics(ctx, req.(*GetWorkspaceDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkspaceService_GetWorkspaceMetrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkspaceMetricsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkspaceServiceServer).GetWorkspaceMetrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.WorkspaceService/GetWorkspaceMetrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkspaceServiceServer).GetWorkspaceMetrics(ctx, req.(*GetWorkspaceMetricsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkspaceService_ListWorkspacesField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListWorkspacesFieldRequest)
 	if err := dec(in); err != nil {
@@ -502,10 +696,26 @@ var _WorkspaceService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkspace",
 			Handler:    _WorkspaceService_DeleteWorkspace_Handler,
 		},
+		{
+			MethodName: "UpdateWorkspaceSharing",
+			Handler:    _WorkspaceService_UpdateWorkspaceSharing_Handler,
+		},
 		{
 			MethodName: "RetryLastWorkspaceAction",
 			Handler:    _WorkspaceService_RetryLastWorkspaceAction_Handler,
 		},
+		{
+			MethodName: "ListWorkspaceContainers",
+			Handler:    _WorkspaceService_ListWorkspaceContainers_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceDiagnostics",
+			Handler:    _WorkspaceService_GetWorkspaceDiagnostics_Handler,
+		},
+		{
+			MethodName: "GetWorkspaceMetrics",
+			Handler:    _WorkspaceService_GetWorkspaceMetrics_Handler,
+		},
 		{
 			MethodName: "ListWorkspacesField",
 			Handler:    _WorkspaceService_ListWorkspacesField_Handler,
@@ -517,6 +727,11 @@ var _WorkspaceService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _WorkspaceService_GetWorkspaceContainerLogs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetWorkspaceLogs",
+			Handler:       _WorkspaceService_GetWorkspaceLogs_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "workspace.proto",
 }
@@ -48,7 +48,7 @@ func Up20210329194731(tx *sql.Tx) error {
 			return err
 		}
 
-		cronWorkflowsCount, err := client.CountCronWorkflows(namespace.Name, uid)
+		cronWorkflowsCount, err := client.CountCronWorkflows(namespace.Name, uid, nil)
 		if err != nil {
 			return err
 		}
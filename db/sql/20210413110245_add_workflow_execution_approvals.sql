@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE workflow_execution_approvals
+(
+    id                          serial PRIMARY KEY,
+    workflow_execution_id       integer NOT NULL REFERENCES workflow_executions ON DELETE CASCADE,
+    node_id                     varchar(255) NOT NULL,
+    approved_by                 varchar(255) NOT NULL,
+    comment                     text NOT NULL DEFAULT '',
+
+    -- auditing info
+    created_at                  timestamp NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX workflow_execution_approvals_workflow_execution_id ON workflow_execution_approvals (workflow_execution_id);
+
+-- +goose Down
+DROP TABLE workflow_execution_approvals;
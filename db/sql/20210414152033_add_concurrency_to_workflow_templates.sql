@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE workflow_templates ADD COLUMN concurrency JSONB;
+ALTER TABLE workflow_executions ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX workflow_executions_namespace_phase ON workflow_executions (namespace, phase);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX workflow_executions_namespace_phase;
+ALTER TABLE workflow_executions DROP COLUMN priority;
+ALTER TABLE workflow_templates DROP COLUMN concurrency;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE workflow_executions ADD COLUMN resource_usage JSONB;
+ALTER TABLE workspaces ADD COLUMN resource_usage JSONB;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE workflow_executions DROP COLUMN resource_usage;
+ALTER TABLE workspaces DROP COLUMN resource_usage;
@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE api_tokens
+(
+    id           serial PRIMARY KEY,
+    uid          varchar(36) UNIQUE NOT NULL CHECK(uid <> ''),
+    name         text NOT NULL CHECK(name <> ''),
+    token_hash   varchar(64) UNIQUE NOT NULL,
+    owner        text NOT NULL,
+    owner_groups text[] NOT NULL DEFAULT '{}',
+    namespaces   text[] NOT NULL,
+    scopes       text[] NOT NULL,
+
+    -- auditing info
+    created_at   timestamp NOT NULL DEFAULT (NOW() at time zone 'utc'),
+    expires_at   timestamp NOT NULL,
+    last_used_at timestamp,
+    revoked_at   timestamp
+);
+
+CREATE INDEX api_tokens_owner_idx ON api_tokens (owner);
+
+-- +goose Down
+DROP TABLE api_tokens;
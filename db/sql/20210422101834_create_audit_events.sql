@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE audit_events
+(
+    id            serial PRIMARY KEY,
+    uid           varchar(36) UNIQUE NOT NULL CHECK(uid <> ''),
+    username      text NOT NULL,
+    groups        text[] NOT NULL DEFAULT '{}',
+    namespace     text NOT NULL DEFAULT '',
+    resource_type text NOT NULL,
+    resource_uid  text NOT NULL DEFAULT '',
+    method        text NOT NULL,
+    action        text NOT NULL,
+    request       JSONB,
+    code          text NOT NULL,
+    message       text NOT NULL DEFAULT '',
+
+    created_at    timestamp NOT NULL DEFAULT (NOW() at time zone 'utc')
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX audit_events_namespace_created_at_idx ON audit_events (namespace, created_at);
+CREATE INDEX audit_events_resource_idx ON audit_events (resource_type, resource_uid);
+
+-- +goose Down
+DROP TABLE audit_events;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE role_bindings
+(
+    id           serial PRIMARY KEY,
+    uid          varchar(36) UNIQUE NOT NULL CHECK(uid <> ''),
+    namespace    text NOT NULL CHECK(namespace <> ''),
+    subject_kind varchar(10) NOT NULL,
+    subject_name text NOT NULL CHECK(subject_name <> ''),
+    role         text NOT NULL,
+
+    -- auditing info
+    created_at   timestamp NOT NULL DEFAULT (NOW() at time zone 'utc'),
+
+    UNIQUE (namespace, subject_kind, subject_name, role)
+);
+
+-- +goose Down
+DROP TABLE role_bindings;
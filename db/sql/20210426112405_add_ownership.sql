@@ -0,0 +1,37 @@
+-- +goose Up
+ALTER TABLE workspaces ADD COLUMN owner text NOT NULL DEFAULT '';
+ALTER TABLE workspaces ADD COLUMN visibility varchar(10) NOT NULL DEFAULT 'namespace';
+ALTER TABLE workspaces ADD COLUMN shared_users text[] NOT NULL DEFAULT '{}';
+ALTER TABLE workspaces ADD COLUMN shared_groups text[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE workflow_executions ADD COLUMN owner text NOT NULL DEFAULT '';
+ALTER TABLE workflow_executions ADD COLUMN visibility varchar(10) NOT NULL DEFAULT 'namespace';
+ALTER TABLE workflow_executions ADD COLUMN shared_users text[] NOT NULL DEFAULT '{}';
+ALTER TABLE workflow_executions ADD COLUMN shared_groups text[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE cron_workflows ADD COLUMN owner text NOT NULL DEFAULT '';
+ALTER TABLE cron_workflows ADD COLUMN visibility varchar(10) NOT NULL DEFAULT 'namespace';
+ALTER TABLE cron_workflows ADD COLUMN shared_users text[] NOT NULL DEFAULT '{}';
+ALTER TABLE cron_workflows ADD COLUMN shared_groups text[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX workspaces_owner_index ON workspaces (namespace, owner);
+CREATE INDEX workflow_executions_owner_index ON workflow_executions (namespace, owner);
+
+-- +goose Down
+DROP INDEX workflow_executions_owner_index;
+DROP INDEX workspaces_owner_index;
+
+ALTER TABLE cron_workflows DROP COLUMN shared_groups;
+ALTER TABLE cron_workflows DROP COLUMN shared_users;
+ALTER TABLE cron_workflows DROP COLUMN visibility;
+ALTER TABLE cron_workflows DROP COLUMN owner;
+
+ALTER TABLE workflow_executions DROP COLUMN shared_groups;
+ALTER TABLE workflow_executions DROP COLUMN shared_users;
+ALTER TABLE workflow_executions DROP COLUMN visibility;
+ALTER TABLE workflow_executions DROP COLUMN owner;
+
+ALTER TABLE workspaces DROP COLUMN shared_groups;
+ALTER TABLE workspaces DROP COLUMN shared_users;
+ALTER TABLE workspaces DROP COLUMN visibility;
+ALTER TABLE workspaces DROP COLUMN owner;
@@ -21,10 +21,16 @@ import (
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
 	"github.com/onepanelio/core/pkg/util/env"
+	"github.com/onepanelio/core/pkg/util/metrics"
+	"github.com/onepanelio/core/pkg/util/tracing"
 	"github.com/onepanelio/core/server"
 	"github.com/onepanelio/core/server/auth"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -38,14 +44,26 @@ import (
 )
 
 var (
-	rpcPort      = flag.String("rpc-port", ":8887", "RPC Port")
-	httpPort     = flag.String("http-port", ":8888", "RPC Port")
-	recoveryFunc grpc_recovery.RecoveryHandlerFunc
+	rpcPort        = flag.String("rpc-port", ":8887", "RPC Port")
+	httpPort       = flag.String("http-port", ":8888", "RPC Port")
+	auditRetention = flag.Duration("audit-retention", v1.DefaultAuditEventRetention, "How long audit events are kept, 0 keeps them forever")
+	serveMetrics   = flag.Bool("metrics", true, "Serve prometheus metrics at /metrics on the HTTP port")
+	recoveryFunc   grpc_recovery.RecoveryHandlerFunc
 )
 
 func main() {
 	flag.Parse()
 
+	shutdownTracing, err := tracing.Init(context.Background())
+	if err != nil {
+		log.Fatalf("Failed to initialize tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Printf("[error] shutting down tracing %v", err.Error())
+		}
+	}()
+
 	// stopCh is used to indicate when the RPC server should reload.
 	// We do this when the configuration has been changed, so the server has the latest configuration
 	stopCh := make(chan struct{})
@@ -100,8 +118,20 @@ func main() {
 
 			s := startRPCServer(v1.NewDB(db), kubeConfig, sysConfig, stopCh)
 
+			metricsCollector := v1.NewMetricsCollector(v1.NewDB(db))
+			prometheus.MustRegister(metricsCollector)
+
+			backgroundStopCh := make(chan struct{})
+			go watchWorkflowCompletions(kubeConfig, v1.NewDB(db), sysConfig, backgroundStopCh)
+			go sampleResourceUsage(kubeConfig, v1.NewDB(db), sysConfig, backgroundStopCh)
+			if *auditRetention > 0 {
+				go pruneAuditEvents(kubeConfig, v1.NewDB(db), sysConfig, backgroundStopCh)
+			}
+
 			<-stopCh
 
+			close(backgroundStopCh)
+			prometheus.Unregister(metricsCollector)
 			s.Stop()
 			if err := db.Close(); err != nil {
 				log.Printf("[error] closing db connection %v", err.Error())
@@ -137,14 +167,23 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 
 	s := grpc.NewServer(grpc.UnaryInterceptor(
 		grpc_middleware.ChainUnaryServer(
+			otelgrpc.UnaryServerInterceptor(),
+			metrics.UnaryServerInterceptor(),
 			grpc_logrus.UnaryServerInterceptor(logEntry),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpts...),
-			auth.UnaryInterceptor(kubeConfig, db, sysConfig)),
+			auth.AuditUnaryInterceptor(db),
+			auth.UnaryInterceptor(kubeConfig, db, sysConfig),
+			auth.RoleUnaryInterceptor(),
+			auth.OwnershipUnaryInterceptor()),
 	), grpc.StreamInterceptor(
 		grpc_middleware.ChainStreamServer(
+			otelgrpc.StreamServerInterceptor(),
+			metrics.StreamServerInterceptor(),
 			grpc_logrus.StreamServerInterceptor(logEntry),
 			grpc_recovery.StreamServerInterceptor(recoveryOpts...),
-			auth.StreamingInterceptor(kubeConfig, db, sysConfig)),
+			auth.StreamingInterceptor(kubeConfig, db, sysConfig),
+			auth.RoleStreamInterceptor(),
+			auth.OwnershipStreamInterceptor()),
 	), grpc.MaxRecvMsgSize(math.MaxInt64), grpc.MaxSendMsgSize(math.MaxInt64))
 	api.RegisterWorkflowTemplateServiceServer(s, server.NewWorkflowTemplateServer())
 	api.RegisterCronWorkflowServiceServer(s, server.NewCronWorkflowServer())
@@ -157,6 +196,9 @@ func startRPCServer(db *v1.DB, kubeConfig *v1.Config, sysConfig v1.SystemConfig,
 	api.RegisterWorkspaceServiceServer(s, server.NewWorkspaceServer())
 	api.RegisterConfigServiceServer(s, server.NewConfigServer())
 	api.RegisterServiceServiceServer(s, server.NewServiceServer())
+	api.RegisterTokenServiceServer(s, server.NewTokenServer())
+	api.RegisterAuditServiceServer(s, server.NewAuditServer())
+	api.RegisterRoleServiceServer(s, server.NewRoleServer())
 
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -179,7 +221,9 @@ func startHTTPProxy() {
 	// Note: Make sure the gRPC server is running properly and accessible
 	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(customHeaderMatcher))
 	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt64),
-		grpc.MaxCallRecvMsgSize(math.MaxInt64))}
+		grpc.MaxCallRecvMsgSize(math.MaxInt64)),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor())}
 
 	registerHandler(api.RegisterWorkflowTemplateServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterWorkflowServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
@@ -192,6 +236,9 @@ func startHTTPProxy() {
 	registerHandler(api.RegisterWorkspaceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterConfigServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 	registerHandler(api.RegisterServiceServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterTokenServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterAuditServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
+	registerHandler(api.RegisterRoleServiceHandlerFromEndpoint, ctx, mux, endpoint, opts)
 
 	log.Printf("Starting HTTP proxy on port %v", *httpPort)
 
@@ -206,11 +253,18 @@ func startHTTPProxy() {
 	// Allow PUT. Have to include all others as it clears them out.
 	allowedMethods := handlers.AllowedMethods([]string{"HEAD", "GET", "POST", "PUT", "DELETE", "PATCH"})
 
-	if err := http.ListenAndServe(*httpPort, wsproxy.WebsocketProxy(
+	httpMux := http.NewServeMux()
+	if *serveMetrics {
+		httpMux.Handle("/metrics", promhttp.Handler())
+	}
+	httpMux.Handle(auth.OIDCPathPrefix, otelhttp.NewHandler(auth.NewOIDCHandler(), "oidc"))
+	httpMux.Handle("/", otelhttp.NewHandler(wsproxy.WebsocketProxy(
 		handlers.CORS(
 			handlers.AllowedOriginValidator(ogValidator), allowedHeaders, allowedMethods)(mux),
-		wsproxy.WithTokenCookieName("auth-token"),
-	)); err != nil {
+		wsproxy.WithTokenCookieName(auth.SessionCookieName),
+	), "grpc-gateway"))
+
+	if err := http.ListenAndServe(*httpPort, httpMux); err != nil {
 		log.Fatalf("Failed to serve HTTP listener: %v", err)
 	}
 }
@@ -278,6 +332,40 @@ func watchConfigmapChanges(namespace string, stopCh <-chan struct{}, onChange fu
 	controller.Run(neverStopCh)
 }
 
+// watchWorkflowCompletions archives the status of workflow executions as they complete until stopCh is closed,
+// so they can still be inspected after argo deletes their workflows
+func watchWorkflowCompletions(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig, stopCh <-chan struct{}) {
+	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	if err != nil {
+		log.Errorf("Failed to create client to archive workflow executions: %v", err)
+		return
+	}
+
+	client.WatchWorkflowExecutionCompletions(stopCh)
+}
+
+// sampleResourceUsage samples the resource usage of workflow executions and workspaces until stopCh is closed
+func sampleResourceUsage(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig, stopCh <-chan struct{}) {
+	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	if err != nil {
+		log.Errorf("Failed to create client to sample resource usage: %v", err)
+		return
+	}
+
+	client.SampleResourceUsageEvery(v1.DefaultResourceUsageSampleInterval, stopCh)
+}
+
+// pruneAuditEvents deletes the audit events older than the audit retention until stopCh is closed
+func pruneAuditEvents(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig, stopCh <-chan struct{}) {
+	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	if err != nil {
+		log.Errorf("Failed to create client to prune audit events: %v", err)
+		return
+	}
+
+	client.PruneAuditEventsEvery(*auditRetention, v1.DefaultAuditEventPruneInterval, stopCh)
+}
+
 // customHeaderMatcher is used to allow certain headers so we don't require a grpc-gateway prefix
 func customHeaderMatcher(key string) (string, bool) {
 	lowerCaseKey := strings.ToLower(key)
@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// apiTokenLastUsedInterval is how often the last used timestamp of a token is updated, so every request doesn't write
+const apiTokenLastUsedInterval = time.Minute
+
+var apiTokenColumns = []string{"id", "uid", "name", "token_hash", "owner", "owner_groups", "namespaces", "scopes",
+	"created_at", "expires_at", "last_used_at", "revoked_at"}
+
+// generateAPIToken returns a new random API token
+func generateAPIToken() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// CreateAPIToken stores a new API token for its owner that expires after ttl, or DefaultAPITokenTTL if ttl is 0.
+// The token is returned, it can't be retrieved afterwards.
+func (c *Client) CreateAPIToken(apiToken *APIToken, ttl time.Duration) (token string, err error) {
+	apiToken.Name = strings.TrimSpace(apiToken.Name)
+	if apiToken.Name == "" {
+		return "", util.NewUserError(codes.InvalidArgument, "Name is required.")
+	}
+	if apiToken.Owner == "" {
+		return "", util.NewUserError(codes.InvalidArgument, "Owner is required.")
+	}
+	if len(apiToken.Namespaces) == 0 {
+		return "", util.NewUserError(codes.InvalidArgument, "At least one namespace is required.")
+	}
+	if len(apiToken.Scopes) == 0 {
+		return "", util.NewUserError(codes.InvalidArgument, "At least one scope is required.")
+	}
+	for _, scope := range apiToken.Scopes {
+		if err := ValidateAPITokenScope(scope); err != nil {
+			return "", util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
+	if ttl == 0 {
+		ttl = DefaultAPITokenTTL
+	}
+	if ttl < 0 || ttl > MaxAPITokenTTL {
+		return "", util.NewUserError(codes.InvalidArgument, "TTL must be between 0 and 365 days.")
+	}
+
+	token, err = generateAPIToken()
+	if err != nil {
+		return "", err
+	}
+
+	if apiToken.OwnerGroups == nil {
+		apiToken.OwnerGroups = make([]string, 0)
+	}
+	apiToken.UID = uuid.New().String()
+	apiToken.TokenHash = HashAPIToken(token)
+	apiToken.CreatedAt = time.Now().UTC()
+	apiToken.ExpiresAt = apiToken.CreatedAt.Add(ttl)
+
+	err = sb.Insert("api_tokens").
+		SetMap(sq.Eq{
+			"uid":          apiToken.UID,
+			"name":         apiToken.Name,
+			"token_hash":   apiToken.TokenHash,
+			"owner":        apiToken.Owner,
+			"owner_groups": apiToken.OwnerGroups,
+			"namespaces":   apiToken.Namespaces,
+			"scopes":       apiToken.Scopes,
+			"created_at":   apiToken.CreatedAt,
+			"expires_at":   apiToken.ExpiresAt,
+		}).
+		Suffix("RETURNING id").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&apiToken.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// ListAPITokens returns the API tokens of owner that have not been revoked, newest first
+func (c *Client) ListAPITokens(owner string) (apiTokens []*APIToken, err error) {
+	query := sb.Select(apiTokenColumns...).
+		From("api_tokens").
+		Where(sq.Eq{
+			"owner":      owner,
+			"revoked_at": nil,
+		}).
+		OrderBy("created_at DESC")
+
+	apiTokens = make([]*APIToken, 0)
+	err = c.DB.Selectx(&apiTokens, query)
+
+	return
+}
+
+// RevokeAPIToken revokes an API token of owner, so it can no longer be used
+func (c *Client) RevokeAPIToken(owner, uid string) error {
+	result, err := sb.Update("api_tokens").
+		Set("revoked_at", time.Now().UTC()).
+		Where(sq.Eq{
+			"owner":      owner,
+			"uid":        uid,
+			"revoked_at": nil,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "API token not found.")
+	}
+
+	return nil
+}
+
+// AuthenticateAPIToken returns the API token with the given value if it has not expired or been revoked,
+// and records that it was used
+func (c *Client) AuthenticateAPIToken(token string) (*APIToken, error) {
+	if !IsAPIToken(token) {
+		return nil, util.NewUserError(codes.Unauthenticated, "Invalid API token.")
+	}
+
+	now := time.Now().UTC()
+	query := sb.Select(apiTokenColumns...).
+		From("api_tokens").
+		Where(sq.And{
+			sq.Eq{
+				"token_hash": HashAPIToken(token),
+				"revoked_at": nil,
+			},
+			sq.Gt{"expires_at": now},
+		})
+
+	apiToken := &APIToken{}
+	if err := c.DB.Getx(apiToken, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.Unauthenticated, "Invalid, expired or revoked API token.")
+		}
+		return nil, err
+	}
+
+	if apiToken.LastUsedAt == nil || now.Sub(*apiToken.LastUsedAt) >= apiTokenLastUsedInterval {
+		_, err := sb.Update("api_tokens").
+			Set("last_used_at", now).
+			Where(sq.Eq{"id": apiToken.ID}).
+			RunWith(c.DB).
+			Exec()
+		if err != nil {
+			log.WithFields(log.Fields{
+				"UID":   apiToken.UID,
+				"Error": err.Error(),
+			}).Error("Unable to update last used timestamp of API token.")
+		} else {
+			apiToken.LastUsedAt = &now
+		}
+	}
+
+	return apiToken, nil
+}
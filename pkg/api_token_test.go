@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testAPIToken(owner string) *APIToken {
+	return &APIToken{
+		Name:        "ci",
+		Owner:       owner,
+		OwnerGroups: []string{"system:serviceaccounts"},
+		Namespaces:  []string{"onepanel"},
+		Scopes:      []string{"workflows:create"},
+	}
+}
+
+func TestClient_CreateAPIToken(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	apiToken := testAPIToken("admin")
+	token, err := c.CreateAPIToken(apiToken, time.Hour)
+	assert.Nil(t, err)
+	assert.True(t, IsAPIToken(token))
+	assert.NotEmpty(t, apiToken.UID)
+	assert.Equal(t, HashAPIToken(token), apiToken.TokenHash)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), apiToken.ExpiresAt, time.Minute)
+
+	_, err = c.CreateAPIToken(&APIToken{Name: "ci", Owner: "admin", Namespaces: []string{"onepanel"}, Scopes: []string{"workflows:run"}}, time.Hour)
+	assert.NotNil(t, err)
+
+	_, err = c.CreateAPIToken(&APIToken{Name: "ci", Owner: "admin", Scopes: []string{"workflows:create"}}, time.Hour)
+	assert.NotNil(t, err)
+
+	_, err = c.CreateAPIToken(testAPIToken("admin"), MaxAPITokenTTL+time.Hour)
+	assert.NotNil(t, err)
+}
+
+func TestClient_AuthenticateAPIToken(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	apiToken := testAPIToken("admin")
+	token, err := c.CreateAPIToken(apiToken, time.Hour)
+	assert.Nil(t, err)
+
+	authenticated, err := c.AuthenticateAPIToken(token)
+	assert.Nil(t, err)
+	assert.Equal(t, apiToken.UID, authenticated.UID)
+	assert.Equal(t, "admin", authenticated.Owner)
+	assert.Equal(t, []string{"system:serviceaccounts"}, []string(authenticated.OwnerGroups))
+	assert.NotNil(t, authenticated.LastUsedAt)
+
+	_, err = c.AuthenticateAPIToken(token + "x")
+	assert.NotNil(t, err)
+
+	_, err = database.Exec("UPDATE api_tokens SET expires_at = $1 WHERE uid = $2", time.Now().UTC().Add(-time.Minute), apiToken.UID)
+	assert.Nil(t, err)
+	_, err = c.AuthenticateAPIToken(token)
+	assert.NotNil(t, err)
+}
+
+func TestClient_RevokeAPIToken(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	apiToken := testAPIToken("admin")
+	token, err := c.CreateAPIToken(apiToken, time.Hour)
+	assert.Nil(t, err)
+	_, err = c.CreateAPIToken(testAPIToken("other"), time.Hour)
+	assert.Nil(t, err)
+
+	apiTokens, err := c.ListAPITokens("admin")
+	assert.Nil(t, err)
+	if assert.Len(t, apiTokens, 1) {
+		assert.Equal(t, apiToken.UID, apiTokens[0].UID)
+	}
+
+	assert.NotNil(t, c.RevokeAPIToken("other", apiToken.UID))
+	assert.Nil(t, c.RevokeAPIToken("admin", apiToken.UID))
+	assert.NotNil(t, c.RevokeAPIToken("admin", apiToken.UID))
+
+	_, err = c.AuthenticateAPIToken(token)
+	assert.NotNil(t, err)
+
+	apiTokens, err = c.ListAPITokens("admin")
+	assert.Nil(t, err)
+	assert.Empty(t, apiTokens)
+}
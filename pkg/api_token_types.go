@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// APITokenPrefix starts every API token, so they can be told apart from Kubernetes and session tokens
+	APITokenPrefix = "onepanel-api."
+	// DefaultAPITokenTTL is how long API tokens are valid for if a ttl is not given
+	DefaultAPITokenTTL = 30 * 24 * time.Hour
+	// MaxAPITokenTTL is the longest an API token can be valid for
+	MaxAPITokenTTL = 365 * 24 * time.Hour
+
+	// APITokenScopeAll matches any resource or action in a scope
+	APITokenScopeAll = "*"
+)
+
+// APITokenActions are the actions a scope can allow on a resource
+var APITokenActions = []string{"read", "create", "update", "delete", "approve"}
+
+// APITokenResources are the resources a scope can allow actions on
+var APITokenResources = []string{
+	"workflows",
+	"workflowtemplates",
+	"cronworkflows",
+	"workspaces",
+	"workspacetemplates",
+	"secrets",
+	"namespaces",
+	"labels",
+	"config",
+	"services",
+	"auth",
+	"auditevents",
+	"roles",
+}
+
+// APIToken is a token that acts as its owner, limited to its namespaces and scopes.
+// Only the hash of the token is stored.
+type APIToken struct {
+	ID          uint64
+	UID         string
+	Name        string
+	TokenHash   string         `db:"token_hash"`
+	Owner       string         // username of the user that created the token
+	OwnerGroups pq.StringArray `db:"owner_groups"`
+	Namespaces  pq.StringArray
+	Scopes      pq.StringArray
+	CreatedAt   time.Time  `db:"created_at"`
+	ExpiresAt   time.Time  `db:"expires_at"`
+	LastUsedAt  *time.Time `db:"last_used_at"`
+	RevokedAt   *time.Time `db:"revoked_at"`
+}
+
+// IsAPIToken returns true if token is an API token
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, APITokenPrefix)
+}
+
+// HashAPIToken returns the hash an API token is stored as
+func HashAPIToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// ValidateAPITokenScope returns an error if scope is not <resource>:<action> with a known resource and action
+func ValidateAPITokenScope(scope string) error {
+	parts := strings.Split(scope, ":")
+	if len(parts) != 2 {
+		return fmt.Errorf("scope '%v' must be <resource>:<action>", scope)
+	}
+
+	if parts[0] != APITokenScopeAll && !containsString(APITokenResources, parts[0]) {
+		return fmt.Errorf("scope '%v' has unknown resource '%v'", scope, parts[0])
+	}
+	if parts[1] != APITokenScopeAll && !containsString(APITokenActions, parts[1]) {
+		return fmt.Errorf("scope '%v' has unknown action '%v'", scope, parts[1])
+	}
+
+	return nil
+}
+
+// HasScope returns true if one of the token's scopes allows action on resource
+func (t *APIToken) HasScope(resource, action string) bool {
+	for _, scope := range t.Scopes {
+		parts := strings.Split(scope, ":")
+		if len(parts) != 2 {
+			continue
+		}
+
+		if (parts[0] == APITokenScopeAll || parts[0] == resource) && (parts[1] == APITokenScopeAll || parts[1] == action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllowsNamespace returns true if the token can be used in namespace
+func (t *APIToken) AllowsNamespace(namespace string) bool {
+	return containsString(t.Namespaces, namespace)
+}
+
+// containsString returns true if values has value
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
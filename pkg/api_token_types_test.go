@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAPITokenScope(t *testing.T) {
+	assert.Nil(t, ValidateAPITokenScope("workflows:create"))
+	assert.Nil(t, ValidateAPITokenScope("*:read"))
+	assert.Nil(t, ValidateAPITokenScope("secrets:*"))
+
+	assert.NotNil(t, ValidateAPITokenScope("workflows"))
+	assert.NotNil(t, ValidateAPITokenScope("workflows:run"))
+	assert.NotNil(t, ValidateAPITokenScope("tokens:create"))
+	assert.NotNil(t, ValidateAPITokenScope("workflows:create:all"))
+}
+
+func TestAPIToken_HasScope(t *testing.T) {
+	apiToken := &APIToken{Scopes: []string{"workflows:create", "*:read", "workspaces:*"}}
+
+	assert.True(t, apiToken.HasScope("workflows", "create"))
+	assert.True(t, apiToken.HasScope("secrets", "read"))
+	assert.True(t, apiToken.HasScope("workspaces", "delete"))
+
+	assert.False(t, apiToken.HasScope("workflows", "delete"))
+	assert.False(t, apiToken.HasScope("secrets", "create"))
+}
+
+func TestAPIToken_AllowsNamespace(t *testing.T) {
+	apiToken := &APIToken{Namespaces: []string{"team-a"}}
+
+	assert.True(t, apiToken.AllowsNamespace("team-a"))
+	assert.False(t, apiToken.AllowsNamespace("team-b"))
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// MaxAuditEventsExport is the most audit events that are exported at once
+	MaxAuditEventsExport = 100000
+	// DefaultAuditEventRetention is how long audit events are kept before they are pruned
+	DefaultAuditEventRetention = 90 * 24 * time.Hour
+	// DefaultAuditEventPruneInterval is how often audit events older than their retention are pruned
+	DefaultAuditEventPruneInterval = time.Hour
+)
+
+var auditEventColumns = []string{"id", "uid", "username", "groups", "namespace", "resource_type", "resource_uid",
+	"method", "action", "request::text", "code", "message", "created_at"}
+
+// CreateAuditEvent stores an audit event
+func (c *Client) CreateAuditEvent(event *AuditEvent) error {
+	event.UID = uuid.New().String()
+	event.CreatedAt = time.Now().UTC()
+	if event.Groups == nil {
+		event.Groups = make([]string, 0)
+	}
+
+	return sb.Insert("audit_events").
+		SetMap(sq.Eq{
+			"uid":           event.UID,
+			"username":      event.Username,
+			"groups":        event.Groups,
+			"namespace":     event.Namespace,
+			"resource_type": event.ResourceType,
+			"resource_uid":  event.ResourceUID,
+			"method":        event.Method,
+			"action":        event.Action,
+			"request":       event.Request,
+			"code":          event.Code,
+			"message":       event.Message,
+			"created_at":    event.CreatedAt,
+		}).
+		Suffix("RETURNING id").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&event.ID)
+}
+
+// selectAuditEventsBuilder selects the audit events matching filter, newest first
+func selectAuditEventsBuilder(filter *AuditEventFilter) sq.SelectBuilder {
+	query := sb.Select(auditEventColumns...).
+		From("audit_events").
+		OrderBy("created_at DESC", "id DESC")
+
+	return filter.apply(query)
+}
+
+// ListAuditEvents returns a page of the audit events matching filter, newest first
+func (c *Client) ListAuditEvents(filter *AuditEventFilter, paginator *pagination.PaginationRequest) (events []*AuditEvent, err error) {
+	query := selectAuditEventsBuilder(filter)
+	if paginator != nil {
+		query = *paginator.ApplyToSelect(&query)
+	}
+
+	events = make([]*AuditEvent, 0)
+	err = c.DB.Selectx(&events, query)
+
+	return
+}
+
+// CountAuditEvents returns the number of audit events matching filter
+func (c *Client) CountAuditEvents(filter *AuditEventFilter) (count int, err error) {
+	query := filter.apply(sb.Select("COUNT(*)").From("audit_events"))
+
+	err = c.DB.Getx(&count, query)
+
+	return
+}
+
+// ExportAuditEvents returns up to MaxAuditEventsExport of the audit events matching filter as JSON lines, newest first
+func (c *Client) ExportAuditEvents(filter *AuditEventFilter) ([]byte, error) {
+	query := selectAuditEventsBuilder(filter).Limit(MaxAuditEventsExport)
+
+	events := make([]*AuditEvent, 0)
+	if err := c.DB.Selectx(&events, query); err != nil {
+		return nil, err
+	}
+
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return nil, err
+		}
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// DeleteAuditEventsBefore deletes the audit events created before t and returns how many were deleted
+func (c *Client) DeleteAuditEventsBefore(t time.Time) (int64, error) {
+	result, err := sb.Delete("audit_events").
+		Where(sq.Lt{"created_at": t.UTC()}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// PruneAuditEventsEvery deletes the audit events older than retention every interval until stopCh is closed
+func (c *Client) PruneAuditEventsEvery(retention, interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		deleted, err := c.DeleteAuditEventsBefore(time.Now().Add(-retention))
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Error": err.Error(),
+			}).Error("Unable to prune audit events.")
+			return
+		}
+		if deleted > 0 {
+			log.WithFields(log.Fields{
+				"Deleted": deleted,
+			}).Info("Pruned audit events.")
+		}
+	}, interval, stopCh)
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_ListAuditEvents(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	request := `{"namespace":"onepanel","uid":"test"}`
+	events := []*AuditEvent{
+		{Username: "admin", Namespace: "onepanel", ResourceType: "workspaces", ResourceUID: "test", Method: "/api.WorkspaceService/DeleteWorkspace", Action: "delete", Request: &request, Code: "OK"},
+		{Username: "admin", Namespace: "other", ResourceType: "secrets", ResourceUID: "aws", Method: "/api.SecretService/DeleteSecret", Action: "delete", Code: "PermissionDenied", Message: "Permission denied."},
+	}
+	for _, event := range events {
+		assert.Nil(t, c.CreateAuditEvent(event))
+	}
+
+	result, err := c.ListAuditEvents(&AuditEventFilter{Namespace: "onepanel"}, pagination.Start())
+	assert.Nil(t, err)
+	if assert.Len(t, result, 1) {
+		assert.Equal(t, "test", result[0].ResourceUID)
+		assert.JSONEq(t, request, *result[0].Request)
+	}
+
+	since := time.Now().Add(-time.Hour)
+	count, err := c.CountAuditEvents(&AuditEventFilter{Username: "admin", Since: &since})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	count, err = c.CountAuditEvents(&AuditEventFilter{Code: "PermissionDenied"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	data, err := c.ExportAuditEvents(nil)
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if assert.Len(t, lines, 2) {
+		exported := make(map[string]interface{})
+		assert.Nil(t, json.Unmarshal([]byte(lines[1]), &exported))
+		assert.Equal(t, map[string]interface{}{"namespace": "onepanel", "uid": "test"}, exported["request"])
+	}
+}
+
+func TestClient_DeleteAuditEventsBefore(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	old := &AuditEvent{Username: "admin", ResourceType: "secrets", Method: "/api.SecretService/DeleteSecret", Action: "delete", Code: "OK"}
+	recent := &AuditEvent{Username: "admin", ResourceType: "secrets", Method: "/api.SecretService/CreateSecret", Action: "create", Code: "OK"}
+	assert.Nil(t, c.CreateAuditEvent(old))
+	assert.Nil(t, c.CreateAuditEvent(recent))
+	_, err := c.DB.Exec("UPDATE audit_events SET created_at = $1 WHERE id = $2", time.Now().UTC().Add(-2*DefaultAuditEventRetention), old.ID)
+	assert.Nil(t, err)
+
+	deleted, err := c.DeleteAuditEventsBefore(time.Now().Add(-DefaultAuditEventRetention))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	count, err := c.CountAuditEvents(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// AuditEvent records a mutating API call: who made it, what it changed and its result
+type AuditEvent struct {
+	ID           uint64         `json:"-"`
+	UID          string         `json:"uid"`
+	Username     string         `json:"username"`
+	Groups       pq.StringArray `json:"groups"`
+	Namespace    string         `json:"namespace"`
+	ResourceType string         `db:"resource_type" json:"resourceType"`
+	ResourceUID  string         `db:"resource_uid" json:"resourceUid"`
+	Method       string         `json:"method"`
+	Action       string         `json:"action"`
+	// Request is the request as JSON, with secret values redacted
+	Request   *string   `json:"request"`
+	Code      string    `json:"code"`
+	Message   string    `json:"message"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+// AuditEventFilter filters audit events. Empty fields are ignored.
+type AuditEventFilter struct {
+	Namespace    string
+	Username     string
+	ResourceType string
+	ResourceUID  string
+	Method       string
+	Action       string
+	Code         string
+	Since        *time.Time
+	Until        *time.Time
+}
+
+// apply adds the conditions of the filter to a query
+func (f *AuditEventFilter) apply(query sq.SelectBuilder) sq.SelectBuilder {
+	if f == nil {
+		return query
+	}
+
+	fields := map[string]string{
+		"namespace":     f.Namespace,
+		"username":      f.Username,
+		"resource_type": f.ResourceType,
+		"resource_uid":  f.ResourceUID,
+		"method":        f.Method,
+		"action":        f.Action,
+		"code":          f.Code,
+	}
+	for column, value := range fields {
+		if value != "" {
+			query = query.Where(sq.Eq{column: value})
+		}
+	}
+
+	if f.Since != nil {
+		query = query.Where(sq.GtOrEq{"created_at": f.Since.UTC()})
+	}
+	if f.Until != nil {
+		query = query.Where(sq.Lt{"created_at": f.Until.UTC()})
+	}
+
+	return query
+}
+
+// MarshalJSON encodes the event with its request as a JSON object rather than a string
+func (e *AuditEvent) MarshalJSON() ([]byte, error) {
+	type auditEvent AuditEvent
+
+	var request json.RawMessage
+	if e.Request != nil && json.Valid([]byte(*e.Request)) {
+		request = json.RawMessage(*e.Request)
+	}
+
+	return json.Marshal(&struct {
+		*auditEvent
+		Request json.RawMessage `json:"request"`
+	}{
+		auditEvent: (*auditEvent)(e),
+		Request:    request,
+	})
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEvent_MarshalJSON(t *testing.T) {
+	request := `{"namespace":"onepanel"}`
+	data, err := json.Marshal(&AuditEvent{UID: "uid", Username: "admin", Request: &request})
+	assert.Nil(t, err)
+
+	decoded := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, "uid", decoded["uid"])
+	assert.Equal(t, map[string]interface{}{"namespace": "onepanel"}, decoded["request"])
+
+	data, err = json.Marshal(&AuditEvent{UID: "uid"})
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"request":null`)
+}
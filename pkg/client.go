@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	argoprojv1alpha1 "github.com/argoproj/argo/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
@@ -9,6 +10,7 @@ import (
 	"github.com/onepanelio/core/pkg/util/gcs"
 	"github.com/onepanelio/core/pkg/util/router"
 	"github.com/onepanelio/core/pkg/util/s3"
+	"github.com/onepanelio/core/pkg/util/tracing"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -27,6 +29,8 @@ type Client struct {
 	argoprojV1alpha1 argoprojv1alpha1.ArgoprojV1alpha1Interface
 	*DB
 	systemConfig SystemConfig
+	// ctx is the parent of the spans of the client's requests to Kubernetes, Argo and artifact repositories
+	ctx context.Context
 }
 
 func (c *Client) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface {
@@ -77,6 +81,12 @@ func GetDefaultClientWithDB(db *DB) (*Client, error) {
 // NewClient creates a client to interact with the Onepanel system.
 // It includes access to the database, kubernetes, argo, and configuration.
 func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Client, err error) {
+	return NewClientWithContext(context.Background(), config, db, systemConfig)
+}
+
+// NewClientWithContext creates a client like NewClient whose requests to the database, Kubernetes, Argo and
+// artifact repositories are traced as children of the span in ctx, usually the span of a gRPC request.
+func NewClientWithContext(ctx context.Context, config *Config, db *DB, systemConfig SystemConfig) (client *Client, err error) {
 	if config.BearerToken != "" {
 		config.BearerTokenFile = ""
 		config.Username = ""
@@ -87,32 +97,42 @@ func NewClient(config *Config, db *DB, systemConfig SystemConfig) (client *Clien
 
 	config.Timeout = getKubernetesTimeout()
 
-	kubeClient, err := kubernetes.NewForConfig(config)
+	kubeConfig := rest.CopyConfig(config)
+	kubeConfig.Wrap(tracing.WrapTransport(ctx, "kubernetes"))
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
 	if err != nil {
 		return
 	}
 
-	argoClient, err := argoprojv1alpha1.NewForConfig(config)
+	argoConfig := rest.CopyConfig(config)
+	argoConfig.Wrap(tracing.WrapTransport(ctx, "argo"))
+	argoClient, err := argoprojv1alpha1.NewForConfig(argoConfig)
 	if err != nil {
 		return
 	}
 
+	if db != nil {
+		db = db.WithContext(ctx)
+	}
+
 	return &Client{
 		Interface:        kubeClient,
 		argoprojV1alpha1: argoClient,
 		DB:               db,
 		systemConfig:     systemConfig,
+		ctx:              ctx,
 	}, nil
 }
 
 // GetS3Client initializes a client to Amazon Cloud Storage.
 func (c *Client) GetS3Client(namespace string, config *ArtifactRepositoryS3Provider) (s3Client *s3.Client, err error) {
 	s3Client, err = s3.NewClient(s3.Config{
-		Endpoint:  config.Endpoint,
-		Region:    config.Region,
-		AccessKey: config.AccessKey,
-		SecretKey: config.Secretkey,
-		InSecure:  config.Insecure,
+		Endpoint:      config.Endpoint,
+		Region:        config.Region,
+		AccessKey:     config.AccessKey,
+		SecretKey:     config.Secretkey,
+		InSecure:      config.Insecure,
+		WrapTransport: tracing.WrapTransport(c.ctx, "s3"),
 	})
 	if err != nil {
 		log.WithFields(log.Fields{
@@ -127,7 +147,7 @@ func (c *Client) GetS3Client(namespace string, config *ArtifactRepositoryS3Provi
 
 // GetGCSClient initializes a client to Google Cloud Storage.
 func (c *Client) GetGCSClient(namespace string, config *ArtifactRepositoryGCSProvider) (gcsClient *gcs.Client, err error) {
-	return gcs.NewClient(namespace, config.ServiceAccountJSON)
+	return gcs.NewClient(namespace, config.ServiceAccountJSON, tracing.WrapTransport(c.ctx, "gcs"))
 }
 
 // GetWebRouter creates a new web router using the system configuration
@@ -107,6 +107,9 @@ func clearDatabase(t *testing.T) {
 		DELETE FROM workflow_templates;
 		DELETE FROM workspace_template_versions;
 		DELETE FROM workflow_template_versions;
+		DELETE FROM api_tokens;
+		DELETE FROM audit_events;
+		DELETE FROM role_bindings;
 	`
 
 	_, err := database.Exec(query)
@@ -9,6 +9,15 @@ import (
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// Parameter types whose options are loaded from a source at render time
+const (
+	ParameterTypeSelectNodePool          = "select.nodepool"
+	ParameterTypeSelectSecret            = "select.secret"
+	ParameterTypeSelectWorkflowExecution = "select.workflow-execution"
+	ParameterTypeSelectArtifact          = "select.artifact"
+	ParameterTypeSelectLabelValue        = "select.label-value"
+)
+
 type ParameterOption struct {
 	Name  string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
@@ -23,6 +32,36 @@ type Parameter struct {
 	Hint        *string            `json:"hint,omitempty" protobuf:"bytes,5,opt,name=hint"`
 	Options     []*ParameterOption `json:"options,omitempty" protobuf:"bytes,6,opt,name=options"`
 	Required    bool               `json:"required,omitempty" protobuf:"bytes,7,opt,name=required"`
+	Source      *ParameterSource   `json:"source,omitempty" yaml:"source"`
+}
+
+// ParameterSource configures where the options of a dynamic select parameter are loaded from.
+// Which fields are used depends on the parameter type.
+type ParameterSource struct {
+	// WorkflowTemplateUID is the template whose executions are listed for select.workflow-execution.
+	// It defaults to the template the parameter belongs to.
+	WorkflowTemplateUID string `json:"workflowTemplateUid,omitempty" yaml:"workflowTemplateUid"`
+	// Phase filters the executions listed for select.workflow-execution, e.g. Succeeded
+	Phase string `json:"phase,omitempty" yaml:"phase"`
+	// Prefix is the artifact repository key listed for select.artifact
+	Prefix string `json:"prefix,omitempty" yaml:"prefix"`
+	// Key is the label key whose values are listed for select.label-value
+	Key string `json:"key,omitempty" yaml:"key"`
+	// Resource is the resource whose labels are listed for select.label-value, e.g. workflow_execution
+	Resource string `json:"resource,omitempty" yaml:"resource"`
+	// Limit is the maximum number of options loaded. 0 uses the default.
+	Limit uint64 `json:"limit,omitempty" yaml:"limit"`
+}
+
+// IsDynamicParameterType returns true if the parameter type has its options loaded at render time
+// from a source other than the manifest, excluding select.nodepool which comes from the system config.
+func IsDynamicParameterType(parameterType string) bool {
+	switch parameterType {
+	case ParameterTypeSelectSecret, ParameterTypeSelectWorkflowExecution, ParameterTypeSelectArtifact, ParameterTypeSelectLabelValue:
+		return true
+	}
+
+	return false
 }
 
 // IsValidParameter returns nil if the parameter is valid or an error otherwise
@@ -86,10 +125,14 @@ func ParseParametersFromManifest(manifest []byte) ([]Parameter, error) {
 			parameter.Visibility = ptr.String("public")
 		}
 
-		if parameter.Type == "select.nodepool" {
+		if parameter.Type == ParameterTypeSelectNodePool {
 			parameter.Options = make([]*ParameterOption, 0)
 			parameter.Value = ptr.String("default")
 		}
+
+		if IsDynamicParameterType(parameter.Type) {
+			parameter.Options = make([]*ParameterOption, 0)
+		}
 	}
 
 	if err := IsValidParameters(manifestResult.Arguments.Parameters); err != nil {
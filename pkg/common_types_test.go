@@ -66,3 +66,61 @@ func TestParseParametersFromManifest(t *testing.T) {
 	// Make sure string values are correctly parsed
 	assert.Equal(t, *keyedParameters["extras"].Value, "none")
 }
+
+// TestParseParametersFromManifest_DynamicSource makes sure dynamic select parameters keep their source and have their options cleared
+func TestParseParametersFromManifest_DynamicSource(t *testing.T) {
+	manifest := `arguments:
+  parameters:
+  - name: checkpoint
+    type: select.workflow-execution
+    source:
+      workflowTemplateUid: train
+      phase: Succeeded
+      limit: 10
+  - name: model
+    type: select.artifact
+    source:
+      prefix: models/
+    options:
+    - name: stale
+      value: stale
+  - name: dataset
+    type: select.label-value
+    value: coco
+    source:
+      key: dataset
+      resource: workflow_execution
+`
+
+	parameters, err := ParseParametersFromManifest([]byte(manifest))
+	assert.Nil(t, err)
+	assert.Len(t, parameters, 3)
+
+	keyedParameters := MapParametersByName(parameters)
+
+	checkpoint := keyedParameters["checkpoint"]
+	assert.NotNil(t, checkpoint.Source)
+	assert.Equal(t, "train", checkpoint.Source.WorkflowTemplateUID)
+	assert.Equal(t, "Succeeded", checkpoint.Source.Phase)
+	assert.Equal(t, uint64(10), checkpoint.Source.Limit)
+
+	model := keyedParameters["model"]
+	assert.Equal(t, "models/", model.Source.Prefix)
+	assert.Empty(t, model.Options)
+
+	dataset := keyedParameters["dataset"]
+	assert.Equal(t, "dataset", dataset.Source.Key)
+	assert.Equal(t, "workflow_execution", dataset.Source.Resource)
+	assert.Equal(t, "coco", *dataset.Value)
+}
+
+// TestIsDynamicParameterType tests the IsDynamicParameterType function
+func TestIsDynamicParameterType(t *testing.T) {
+	assert.True(t, IsDynamicParameterType(ParameterTypeSelectSecret))
+	assert.True(t, IsDynamicParameterType(ParameterTypeSelectWorkflowExecution))
+	assert.True(t, IsDynamicParameterType(ParameterTypeSelectArtifact))
+	assert.True(t, IsDynamicParameterType(ParameterTypeSelectLabelValue))
+	assert.False(t, IsDynamicParameterType(ParameterTypeSelectNodePool))
+	assert.False(t, IsDynamicParameterType("select.select"))
+	assert.False(t, IsDynamicParameterType("input.text"))
+}
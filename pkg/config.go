@@ -43,7 +43,7 @@ func (c *Client) GetSystemConfig() (config SystemConfig, err error) {
 		return
 	}
 
-	secret, err := c.GetSecret(namespace, name)
+	secret, err := c.kubernetesSecrets().GetSecret(namespace, name)
 	if err != nil {
 		return
 	}
@@ -81,7 +81,7 @@ func (c *Client) GetNamespaceConfig(namespace string) (config *NamespaceConfig,
 		return nil, util.NewUserError(codes.NotFound, "Artifact repository config not found.")
 	}
 
-	secret, err := c.GetSecret(namespace, "onepanel")
+	secret, err := c.kubernetesSecrets().GetSecret(namespace, "onepanel")
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/onepanelio/core/pkg/util/ptr"
 	log "github.com/sirupsen/logrus"
@@ -44,6 +45,14 @@ func NewSystemConfig(configMap *ConfigMap, secret *Secret) (config SystemConfig,
 	}
 	config["hmac"] = string(hmac)
 
+	if encoded, ok := secret.Data["oidcClientSecret"]; ok {
+		oidcClientSecret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, err
+		}
+		config["oidcClientSecret"] = string(oidcClientSecret)
+	}
+
 	return
 }
 
@@ -239,6 +248,77 @@ func (s SystemConfig) HMACKey() []byte {
 	return []byte(*hmac)
 }
 
+// OIDCGroupMapping gives the members of an identity provider group a role in a namespace.
+// Role is the name of a ClusterRole.
+type OIDCGroupMapping struct {
+	Group     string `json:"group"`
+	Namespace string `json:"namespace"`
+	Role      string `json:"role"`
+}
+
+// OIDCConfig is the configuration of logging in with an OpenID Connect identity provider
+type OIDCConfig struct {
+	IssuerURL       string
+	ClientID        string
+	ClientSecret    string
+	RedirectURL     string
+	Scopes          []string
+	UsernameClaim   string
+	GroupsClaim     string
+	GroupMappings   []*OIDCGroupMapping
+	SessionDuration time.Duration
+}
+
+// OIDC returns the configuration of logging in with an OpenID Connect identity provider,
+// or nil if oidcIssuerURL is not set.
+func (s SystemConfig) OIDC() (config *OIDCConfig, err error) {
+	issuerURL, ok := s["oidcIssuerURL"]
+	if !ok || issuerURL == "" {
+		return nil, nil
+	}
+
+	config = &OIDCConfig{
+		IssuerURL:       issuerURL,
+		ClientID:        s["oidcClientID"],
+		ClientSecret:    s["oidcClientSecret"],
+		RedirectURL:     s["oidcRedirectURL"],
+		Scopes:          []string{"openid", "profile", "email", "groups"},
+		UsernameClaim:   "email",
+		GroupsClaim:     "groups",
+		GroupMappings:   make([]*OIDCGroupMapping, 0),
+		SessionDuration: 12 * time.Hour,
+	}
+
+	if config.RedirectURL == "" {
+		apiURL := s.APIURL()
+		if apiURL == nil {
+			return nil, fmt.Errorf("oidcRedirectURL or ONEPANEL_API_URL must be set")
+		}
+		config.RedirectURL = strings.TrimSuffix(*apiURL, "/") + "/auth/oidc/callback"
+	}
+	if scopes := strings.Fields(s["oidcScopes"]); len(scopes) > 0 {
+		config.Scopes = scopes
+	}
+	if claim := s["oidcUsernameClaim"]; claim != "" {
+		config.UsernameClaim = claim
+	}
+	if claim := s["oidcGroupsClaim"]; claim != "" {
+		config.GroupsClaim = claim
+	}
+	if data := s["oidcGroupMappings"]; data != "" {
+		if err = k8yaml.Unmarshal([]byte(data), &config.GroupMappings); err != nil {
+			return nil, err
+		}
+	}
+	if duration := s["oidcSessionDuration"]; duration != "" {
+		if config.SessionDuration, err = time.ParseDuration(duration); err != nil {
+			return nil, err
+		}
+	}
+
+	return
+}
+
 // ArtifactRepositoryS3Provider is meant to be used
 // by the CLI. CLI will marshal this struct into the correct
 // YAML structure for k8s configmap / secret.
@@ -120,6 +120,10 @@ func (c *Client) UpdateCronWorkflow(namespace string, uid string, cronWorkflow *
 }
 
 func (c *Client) CreateCronWorkflow(namespace string, cronWorkflow *CronWorkflow) (*CronWorkflow, error) {
+	if err := cronWorkflow.Ownership.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
 	workflow := cronWorkflow.WorkflowExecution
 	workflowTemplate, err := c.GetWorkflowTemplate(namespace, workflow.WorkflowTemplate.UID, workflow.WorkflowTemplate.Version)
 	if err != nil {
@@ -220,6 +224,10 @@ func (c *Client) CreateCronWorkflow(namespace string, cronWorkflow *CronWorkflow
 			"namespace":                    namespace,
 			"is_archived":                  false,
 			"labels":                       cronWorkflow.Labels,
+			"owner":                        cronWorkflow.Owner,
+			"visibility":                   cronWorkflow.Visibility,
+			"shared_users":                 cronWorkflow.SharedUsers,
+			"shared_groups":                cronWorkflow.SharedGroups,
 		}).
 		Suffix("RETURNING id").
 		RunWith(c.DB).
@@ -308,10 +316,14 @@ func (c *Client) DeleteCronWorkflowLabel(namespace, name string, keysToDelete ..
 	return wf.Labels, nil
 }
 
-// ListCronWorkflows selects all of the cron workflows for the given namespace and workflow template uid
-func (c *Client) ListCronWorkflows(namespace, workflowTemplateUID string, pagination *pagination.PaginationRequest) (cronWorkflows []*CronWorkflow, err error) {
+// ListCronWorkflows selects all of the cron workflows for the given namespace and workflow template uid.
+// If ownership is not nil, only the cron workflows it allows are selected.
+func (c *Client) ListCronWorkflows(namespace, workflowTemplateUID string, pagination *pagination.PaginationRequest, ownership *OwnershipFilter) (cronWorkflows []*CronWorkflow, err error) {
 	sb := c.cronWorkflowSelectBuilder(namespace, workflowTemplateUID).
 		OrderBy("cw.created_at DESC")
+	if ownership != nil {
+		sb = ownership.apply(sb, "cw")
+	}
 	sb = *pagination.ApplyToSelect(&sb)
 
 	if err := c.DB.Selectx(&cronWorkflows, sb); err != nil {
@@ -321,9 +333,13 @@ func (c *Client) ListCronWorkflows(namespace, workflowTemplateUID string, pagina
 	return
 }
 
-func (c *Client) CountCronWorkflows(namespace, workflowTemplateUID string) (count int, err error) {
-	err = c.cronWorkflowSelectBuilderNoColumns(namespace, workflowTemplateUID).
-		Columns("COUNT(*)").
+func (c *Client) CountCronWorkflows(namespace, workflowTemplateUID string, ownership *OwnershipFilter) (count int, err error) {
+	sb := c.cronWorkflowSelectBuilderNoColumns(namespace, workflowTemplateUID)
+	if ownership != nil {
+		sb = ownership.apply(sb, "cw")
+	}
+
+	err = sb.Columns("COUNT(*)").
 		RunWith(c.DB).
 		QueryRow().
 		Scan(&count)
@@ -418,6 +434,10 @@ func (c *Client) updateCronWorkflow(namespace string, uid string, workflowTempla
 		return
 	}
 
+	if err = c.materializeSecrets(namespace, &cwf.Spec.WorkflowSpec); err != nil {
+		return
+	}
+
 	cwf.Name = uid
 	cwf.ResourceVersion = toUpdateCWF.ResourceVersion
 	updatedCronWorkflow, err = c.ArgoprojV1alpha1().CronWorkflows(namespace).Update(cwf)
@@ -433,6 +453,10 @@ func (c *Client) createCronWorkflow(namespace string, workflowTemplateId *uint64
 	if err != nil {
 		return
 	}
+	// Runs are started by Argo, so the secrets are copied when the cron workflow is created and updated
+	if err = c.materializeSecrets(namespace, &cwf.Spec.WorkflowSpec); err != nil {
+		return
+	}
 	createdCronWorkflow, err = c.ArgoprojV1alpha1().CronWorkflows(namespace).Create(cwf)
 	if err != nil {
 		return nil, err
@@ -23,6 +23,7 @@ type CronWorkflow struct {
 	WorkflowTemplateVersionID uint64 `db:"workflow_template_version_id"`
 	Manifest                  string
 	Namespace                 string `db:"namespace"`
+	Ownership
 }
 
 // CronWorkflowManifest is a client representation of a CronWorkflowManifest
@@ -87,6 +88,7 @@ func (cw *CronWorkflow) AddToManifestSpec(key, manifest string) error {
 // see formatColumnSelect
 func getCronWorkflowColumns(aliasAndDestination ...string) []string {
 	columns := []string{"id", "created_at", "uid", "name", "workflow_template_version_id", "manifest", "namespace", "labels"}
+	columns = append(columns, ownershipColumns...)
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
@@ -1,42 +1,91 @@
 package v1
 
 import (
+	"context"
+	"database/sql"
+	"time"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/onepanelio/core/pkg/util/metrics"
+	"github.com/onepanelio/core/pkg/util/tracing"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/semconv"
 )
 
 // DB represents a database connection. It wraps a sqlx.DB to provide convenience methods.
 type DB struct {
 	sqlx.DB
+	// ctx is the parent of the spans of queries, it is not used to cancel them
+	ctx context.Context
 }
 
 // NewDB creates a new DB using an existing sqlx.DB connection.
 func NewDB(db *sqlx.DB) *DB {
 	return &DB{
-		*db,
+		DB:  *db,
+		ctx: context.Background(),
 	}
 }
 
+// WithContext returns a DB that shares the connection of db, and traces queries as children of the span in ctx
+func (db *DB) WithContext(ctx context.Context) *DB {
+	return &DB{
+		DB:  db.DB,
+		ctx: trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx)),
+	}
+}
+
+// startQuerySpan starts a span for a query
+func (db *DB) startQuerySpan(name, query string) (context.Context, trace.Span) {
+	ctx := db.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return tracing.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.DBSystemPostgres,
+		semconv.DBStatementKey.String(query),
+	))
+}
+
 // Selectx performs a select query using a squirrel SelectBuilder as an argument.
 //
 // This is a convenience wrapper. Any errors from squirrel or sqlx are returned as is.
-func (db *DB) Selectx(dest interface{}, builder sq.SelectBuilder) error {
+func (db *DB) Selectx(dest interface{}, builder sq.SelectBuilder) (err error) {
 	sql, args, err := builder.ToSql()
 	if err != nil {
 		return err
 	}
 
-	return db.Select(dest, sql, args...)
+	defer metrics.ObserveDBQuery("select", time.Now())
+
+	ctx, span := db.startQuerySpan("db.select", sql)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	return db.SelectContext(ctx, dest, sql, args...)
 }
 
 // Getx performs a get query using a squirrel SelectBuilder as an argument.
 //
 // This is a convenience wrapper. Any errors from squirrel or sqlx are returned as is.
-func (db *DB) Getx(dest interface{}, builder sq.SelectBuilder) error {
+func (db *DB) Getx(dest interface{}, builder sq.SelectBuilder) (err error) {
 	query, args, err := builder.ToSql()
 	if err != nil {
 		return err
 	}
 
-	return db.Get(dest, query, args...)
+	defer metrics.ObserveDBQuery("get", time.Now())
+
+	ctx, span := db.startQuerySpan("db.get", query)
+	defer func() {
+		// No rows is an expected result of a get, rather than a failed query
+		if err == sql.ErrNoRows {
+			tracing.EndSpan(span, nil)
+			return
+		}
+		tracing.EndSpan(span, err)
+	}()
+
+	return db.GetContext(ctx, dest, query, args...)
 }
@@ -0,0 +1,594 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// Severities of diagnostic findings
+const (
+	DiagnosticSeverityInfo    = "info"
+	DiagnosticSeverityWarning = "warning"
+	DiagnosticSeverityError   = "error"
+)
+
+// KubernetesEvent is a kubernetes event about an object related to a workspace or workflow execution
+type KubernetesEvent struct {
+	Kind           string
+	Name           string
+	Type           string
+	Reason         string
+	Message        string
+	Count          int32
+	FirstTimestamp *time.Time
+	LastTimestamp  *time.Time
+}
+
+// DiagnosticFinding is a human readable explanation of a known problem, like a pod waiting for a GPU node
+type DiagnosticFinding struct {
+	Severity string
+	Reason   string
+	Message  string
+	// Object is the kind and name of the object the finding is about, like Pod/my-workspace-0
+	Object string
+}
+
+// PodCondition is a condition of a pod, like PodScheduled
+type PodCondition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// WorkflowNodeFailure is a node of a workflow that failed
+type WorkflowNodeFailure struct {
+	ID          string
+	DisplayName string
+	Phase       string
+	Message     string
+}
+
+// WorkflowStatusSummary is a summary of the status of an argo workflow
+type WorkflowStatusSummary struct {
+	UID         string
+	Phase       string
+	Message     string
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	FailedNodes []*WorkflowNodeFailure
+}
+
+// diagnosticObject is a kubernetes object whose events are collected
+type diagnosticObject struct {
+	Kind string
+	Name string
+}
+
+// String returns the object as Kind/Name
+func (o diagnosticObject) String() string {
+	return o.Kind + "/" + o.Name
+}
+
+// listObjectEvents returns the events of the objects ordered by when they last happened.
+// Objects whose events can't be listed are skipped.
+func (c *Client) listObjectEvents(namespace string, objects []diagnosticObject) []*KubernetesEvent {
+	events := make([]*KubernetesEvent, 0)
+	for _, object := range objects {
+		selector := fields.Set{
+			"involvedObject.kind": object.Kind,
+			"involvedObject.name": object.Name,
+		}.AsSelector()
+
+		list, err := c.CoreV1().Events(namespace).List(metav1.ListOptions{
+			FieldSelector: selector.String(),
+		})
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Object":    object.String(),
+				"Error":     err.Error(),
+			}).Error("Unable to list events.")
+			continue
+		}
+
+		for i := range list.Items {
+			events = append(events, kubernetesEventFromEvent(&list.Items[i]))
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].LastTimestamp, events[j].LastTimestamp
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+
+	return events
+}
+
+// kubernetesEventFromEvent converts a kubernetes event
+func kubernetesEventFromEvent(event *corev1.Event) *KubernetesEvent {
+	result := &KubernetesEvent{
+		Kind:    event.InvolvedObject.Kind,
+		Name:    event.InvolvedObject.Name,
+		Type:    event.Type,
+		Reason:  event.Reason,
+		Message: event.Message,
+		Count:   event.Count,
+	}
+
+	if !event.FirstTimestamp.IsZero() {
+		firstTimestamp := event.FirstTimestamp.UTC()
+		result.FirstTimestamp = &firstTimestamp
+	}
+	lastTimestamp := event.LastTimestamp.Time
+	if lastTimestamp.IsZero() {
+		lastTimestamp = event.EventTime.Time
+	}
+	if !lastTimestamp.IsZero() {
+		lastTimestamp = lastTimestamp.UTC()
+		result.LastTimestamp = &lastTimestamp
+	}
+
+	return result
+}
+
+// eventFindingPattern explains events with a reason whose message contains a substring
+type eventFindingPattern struct {
+	Reason   string
+	Contains string
+	Severity string
+	Message  string
+}
+
+// eventFindingPatterns are the known problems that can be recognized from events. The first matching pattern is used.
+var eventFindingPatterns = []eventFindingPattern{
+	{
+		Reason:   "FailedScheduling",
+		Contains: "Insufficient nvidia.com/gpu",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "No node has a free GPU. The pod will start once a GPU node is available, or the node pool scales up.",
+	},
+	{
+		Reason:   "FailedScheduling",
+		Contains: "Insufficient cpu",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "No node has enough free CPU. The pod will start once a node is available, or the node pool scales up.",
+	},
+	{
+		Reason:   "FailedScheduling",
+		Contains: "Insufficient memory",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "No node has enough free memory. The pod will start once a node is available, or the node pool scales up.",
+	},
+	{
+		Reason:   "FailedScheduling",
+		Contains: "unbound immediate PersistentVolumeClaims",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "The pod is waiting for its volumes to be provisioned.",
+	},
+	{
+		Reason:   "FailedScheduling",
+		Contains: "didn't match node selector",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "No node in the selected node pool is available. The pod will start once the node pool scales up.",
+	},
+	{
+		Reason:   "FailedScheduling",
+		Contains: "had taint",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "The available nodes have taints the pod doesn't tolerate.",
+	},
+	{
+		Reason:   "FailedScheduling",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "The pod can't be scheduled on any node yet.",
+	},
+	{
+		Reason:   "NotTriggerScaleUp",
+		Severity: DiagnosticSeverityError,
+		Message:  "The cluster autoscaler can't add a node that fits the pod. Check that the node pool exists and has capacity left.",
+	},
+	{
+		Reason:   "TriggeredScaleUp",
+		Severity: DiagnosticSeverityInfo,
+		Message:  "The cluster autoscaler is adding a node for the pod.",
+	},
+	{
+		Reason:   "ProvisioningFailed",
+		Severity: DiagnosticSeverityError,
+		Message:  "The volume could not be provisioned.",
+	},
+	{
+		Reason:   "FailedAttachVolume",
+		Severity: DiagnosticSeverityError,
+		Message:  "A volume could not be attached to the node. It may still be attached to another node.",
+	},
+	{
+		Reason:   "FailedMount",
+		Severity: DiagnosticSeverityWarning,
+		Message:  "A volume could not be mounted yet.",
+	},
+	{
+		Reason:   "Failed",
+		Contains: "pull",
+		Severity: DiagnosticSeverityError,
+		Message:  "The container image could not be pulled. Check the image name and that the registry credentials are correct.",
+	},
+	{
+		Reason:   "BackOff",
+		Contains: "restarting failed container",
+		Severity: DiagnosticSeverityError,
+		Message:  "A container keeps crashing after it starts. Check its logs, including the logs from before it restarted.",
+	},
+	{
+		Reason:   "Evicted",
+		Severity: DiagnosticSeverityError,
+		Message:  "The pod was evicted from its node.",
+	},
+}
+
+// containerFindingMessages explain the reasons a container can be waiting or terminated with
+var containerFindingMessages = map[string]string{
+	"ErrImagePull":               "The container image could not be pulled. Check the image name and that the registry credentials are correct.",
+	"ImagePullBackOff":           "The container image could not be pulled. Check the image name and that the registry credentials are correct.",
+	"InvalidImageName":           "The container image name is not valid.",
+	"CrashLoopBackOff":           "The container keeps crashing after it starts. Check its logs, including the logs from before it restarted.",
+	"CreateContainerConfigError": "The container could not be configured. A secret or config map it uses may be missing.",
+	"OOMKilled":                  "The container ran out of memory and was killed. Use a larger node or reduce its memory usage.",
+}
+
+// findingsFromEvents returns the findings for the events that match a known pattern
+func findingsFromEvents(events []*KubernetesEvent) []*DiagnosticFinding {
+	findings := make([]*DiagnosticFinding, 0)
+	for _, event := range events {
+		for _, pattern := range eventFindingPatterns {
+			if event.Reason != pattern.Reason {
+				continue
+			}
+			if pattern.Contains != "" && !strings.Contains(strings.ToLower(event.Message), strings.ToLower(pattern.Contains)) {
+				continue
+			}
+
+			findings = append(findings, &DiagnosticFinding{
+				Severity: pattern.Severity,
+				Reason:   event.Reason,
+				Message:  pattern.Message,
+				Object:   event.Kind + "/" + event.Name,
+			})
+			break
+		}
+	}
+
+	return findings
+}
+
+// findingsFromPod returns the findings for a pod's conditions and the states of its containers
+func findingsFromPod(pod *corev1.Pod) []*DiagnosticFinding {
+	findings := make([]*DiagnosticFinding, 0)
+	object := "Pod/" + pod.Name
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Message != "" {
+			findings = append(findings, findingsFromEvents([]*KubernetesEvent{{
+				Kind:    "Pod",
+				Name:    pod.Name,
+				Reason:  "FailedScheduling",
+				Message: condition.Message,
+			}})...)
+		}
+	}
+
+	statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		reason := ""
+		if status.State.Waiting != nil {
+			reason = status.State.Waiting.Reason
+		} else if status.State.Terminated != nil && status.State.Terminated.Reason == "OOMKilled" {
+			reason = status.State.Terminated.Reason
+		} else if status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.Reason == "OOMKilled" {
+			reason = status.LastTerminationState.Terminated.Reason
+		}
+
+		message, ok := containerFindingMessages[reason]
+		if !ok {
+			continue
+		}
+
+		findings = append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityError,
+			Reason:   reason,
+			Message:  fmt.Sprintf("Container %v: %v", status.Name, message),
+			Object:   object,
+		})
+	}
+
+	if pod.Status.Phase == corev1.PodFailed && pod.Status.Reason == "Evicted" {
+		findings = append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityError,
+			Reason:   pod.Status.Reason,
+			Message:  "The pod was evicted from its node: " + pod.Status.Message,
+			Object:   object,
+		})
+	}
+
+	return findings
+}
+
+// findingsFromPersistentVolumeClaim returns a finding if the claim is not bound
+func findingsFromPersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) []*DiagnosticFinding {
+	if pvc.Status.Phase == corev1.ClaimBound {
+		return nil
+	}
+
+	return []*DiagnosticFinding{{
+		Severity: DiagnosticSeverityWarning,
+		Reason:   "Unbound",
+		Message:  fmt.Sprintf("Volume %v is not bound to storage yet.", pvc.Name),
+		Object:   "PersistentVolumeClaim/" + pvc.Name,
+	}}
+}
+
+// uniqueFindings removes repeated findings, keeping the first of each
+func uniqueFindings(findings []*DiagnosticFinding) []*DiagnosticFinding {
+	seen := make(map[string]bool)
+	result := make([]*DiagnosticFinding, 0, len(findings))
+	for _, finding := range findings {
+		key := finding.Object + "/" + finding.Reason + "/" + finding.Message
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, finding)
+	}
+
+	return result
+}
+
+// podConditions converts the conditions of a pod
+func podConditions(pod *corev1.Pod) []*PodCondition {
+	conditions := make([]*PodCondition, 0, len(pod.Status.Conditions))
+	for _, condition := range pod.Status.Conditions {
+		conditions = append(conditions, &PodCondition{
+			Type:    string(condition.Type),
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+
+	return conditions
+}
+
+// summarizeWorkflowStatus returns the phase, message and failed nodes of an argo workflow
+func summarizeWorkflowStatus(wf *wfv1.Workflow) *WorkflowStatusSummary {
+	summary := &WorkflowStatusSummary{
+		UID:         wf.Name,
+		Phase:       string(wf.Status.Phase),
+		Message:     wf.Status.Message,
+		FailedNodes: make([]*WorkflowNodeFailure, 0),
+	}
+
+	if !wf.Status.StartedAt.IsZero() {
+		startedAt := wf.Status.StartedAt.UTC()
+		summary.StartedAt = &startedAt
+	}
+	if !wf.Status.FinishedAt.IsZero() {
+		finishedAt := wf.Status.FinishedAt.UTC()
+		summary.FinishedAt = &finishedAt
+	}
+
+	for _, node := range wf.Status.Nodes {
+		if node.Phase != wfv1.NodeFailed && node.Phase != wfv1.NodeError {
+			continue
+		}
+		// Only the nodes that failed themselves are interesting, not the steps and DAGs they are part of
+		if node.Type != wfv1.NodeTypePod && node.Type != wfv1.NodeTypeSuspend {
+			continue
+		}
+
+		summary.FailedNodes = append(summary.FailedNodes, &WorkflowNodeFailure{
+			ID:          node.ID,
+			DisplayName: node.DisplayName,
+			Phase:       string(node.Phase),
+			Message:     node.Message,
+		})
+	}
+
+	sort.Slice(summary.FailedNodes, func(i, j int) bool {
+		return summary.FailedNodes[i].ID < summary.FailedNodes[j].ID
+	})
+
+	return summary
+}
+
+// findingsFromWorkflowStatus returns a finding for each failed node of a workflow, or for the workflow itself if
+// it failed before running any nodes
+func findingsFromWorkflowStatus(summary *WorkflowStatusSummary) []*DiagnosticFinding {
+	findings := make([]*DiagnosticFinding, 0)
+	if summary.Phase != string(wfv1.NodeFailed) && summary.Phase != string(wfv1.NodeError) {
+		return findings
+	}
+
+	for _, node := range summary.FailedNodes {
+		findings = append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityError,
+			Reason:   "Workflow" + summary.Phase,
+			Message:  fmt.Sprintf("Step %v failed: %v", node.DisplayName, node.Message),
+			Object:   "Workflow/" + summary.UID,
+		})
+	}
+
+	if len(findings) == 0 {
+		findings = append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityError,
+			Reason:   "Workflow" + summary.Phase,
+			Message:  fmt.Sprintf("The workflow failed: %v", summary.Message),
+			Object:   "Workflow/" + summary.UID,
+		})
+	}
+
+	return findings
+}
+
+// podScheduled returns true if the pod has been assigned to a node
+func podScheduled(pod *corev1.Pod) bool {
+	if pod.Spec.NodeName != "" {
+		return true
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// podResourceRequests returns the sum of the resources requested by the containers of a pod.
+// Init containers run one at a time, so the largest request of an init container is used if it is larger.
+func podResourceRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range container.Resources.Requests {
+			total := requests[name]
+			total.Add(quantity)
+			requests[name] = total
+		}
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if total, ok := requests[name]; !ok || quantity.Cmp(total) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+
+	return requests
+}
+
+// untoleratedTaints returns the taints of a node that prevent the pod from being scheduled on it
+func untoleratedTaints(pod *corev1.Pod, node *corev1.Node) []corev1.Taint {
+	taints := make([]corev1.Taint, 0)
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule {
+			continue
+		}
+
+		tolerated := false
+		for j := range pod.Spec.Tolerations {
+			if pod.Spec.Tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			taints = append(taints, *taint)
+		}
+	}
+
+	return taints
+}
+
+// findingsFromNodePool explains why a pod that is not scheduled can't run on the nodes of the node pool it selected.
+// nodePoolLabel is the node label from the system config, nodePool the selected sys-node-pool option and nodes the
+// nodes that have the label set to the option's value.
+func findingsFromNodePool(pod *corev1.Pod, nodePoolLabel string, nodePool *NodePoolOption, nodes []corev1.Node) []*DiagnosticFinding {
+	findings := make([]*DiagnosticFinding, 0)
+	if podScheduled(pod) {
+		return findings
+	}
+
+	object := "Pod/" + pod.Name
+	nodePoolName := nodePool.Name
+	if nodePoolName == "" {
+		nodePoolName = nodePool.Value
+	}
+
+	if len(nodes) == 0 {
+		return append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityWarning,
+			Reason:   "NodePoolEmpty",
+			Message: fmt.Sprintf("No node has the label %v=%v of node pool %v. The pod will start once the node pool scales up.",
+				nodePoolLabel, nodePool.Value, nodePoolName),
+			Object: object,
+		})
+	}
+
+	requests := podResourceRequests(pod)
+	tainted := 0
+	tooSmall := make(map[corev1.ResourceName]int)
+	taints := make(map[string]bool)
+	for i := range nodes {
+		node := &nodes[i]
+
+		nodeTaints := untoleratedTaints(pod, node)
+		if len(nodeTaints) > 0 {
+			tainted++
+			for _, taint := range nodeTaints {
+				taints[taint.ToString()] = true
+			}
+		}
+
+		for name, quantity := range requests {
+			allocatable, ok := node.Status.Allocatable[name]
+			if !ok {
+				allocatable = resource.Quantity{}
+			}
+			if quantity.Cmp(allocatable) > 0 {
+				tooSmall[name]++
+			}
+		}
+	}
+
+	if tainted == len(nodes) {
+		names := make([]string, 0, len(taints))
+		for name := range taints {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		findings = append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityError,
+			Reason:   "NodePoolTainted",
+			Message: fmt.Sprintf("Every node of node pool %v has a taint the pod doesn't tolerate: %v.",
+				nodePoolName, strings.Join(names, ", ")),
+			Object: object,
+		})
+	}
+
+	resourceNames := make([]string, 0, len(tooSmall))
+	for name, count := range tooSmall {
+		if count == len(nodes) {
+			resourceNames = append(resourceNames, string(name))
+		}
+	}
+	sort.Strings(resourceNames)
+	for _, name := range resourceNames {
+		quantity := requests[corev1.ResourceName(name)]
+		findings = append(findings, &DiagnosticFinding{
+			Severity: DiagnosticSeverityError,
+			Reason:   "NodePoolTooSmall",
+			Message: fmt.Sprintf("The pod requests %v %v, more than any node of node pool %v has. Select a larger node pool.",
+				quantity.String(), name, nodePoolName),
+			Object: object,
+		})
+	}
+
+	return findings
+}
@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test_findingsFromEvents tests recognizing known problems in events
+func Test_findingsFromEvents(t *testing.T) {
+	events := []*KubernetesEvent{
+		{Kind: "Pod", Name: "ws-0", Reason: "FailedScheduling", Message: "0/3 nodes are available: 3 Insufficient nvidia.com/gpu."},
+		{Kind: "Pod", Name: "ws-0", Reason: "FailedScheduling", Message: "0/3 nodes are available: 3 node(s) had taints that the pod didn't tolerate."},
+		{Kind: "Pod", Name: "ws-0", Reason: "FailedScheduling", Message: "0/3 nodes are available: 3 node(s) had volume node affinity conflict."},
+		{Kind: "Pod", Name: "ws-0", Reason: "Failed", Message: "Failed to pull image \"onepanel/missing\": not found"},
+		{Kind: "Pod", Name: "ws-0", Reason: "Scheduled", Message: "Successfully assigned ws-0 to node-1"},
+	}
+
+	findings := findingsFromEvents(events)
+	assert.Len(t, findings, 4)
+
+	assert.Equal(t, DiagnosticSeverityWarning, findings[0].Severity)
+	assert.Contains(t, findings[0].Message, "GPU")
+	assert.Equal(t, "Pod/ws-0", findings[0].Object)
+
+	assert.Equal(t, "The available nodes have taints the pod doesn't tolerate.", findings[1].Message)
+	assert.Equal(t, "The pod can't be scheduled on any node yet.", findings[2].Message)
+
+	assert.Equal(t, DiagnosticSeverityError, findings[3].Severity)
+	assert.Contains(t, findings[3].Message, "image could not be pulled")
+}
+
+// Test_findingsFromPod tests recognizing known problems in pod conditions and container states
+func Test_findingsFromPod(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-0"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "jupyterlab", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}}},
+				{Name: "sidecar", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+				{
+					Name:                 "tensorboard",
+					State:                corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+					LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+				},
+			},
+		},
+	}
+
+	findings := findingsFromPod(pod)
+	assert.Len(t, findings, 2)
+
+	assert.Equal(t, "ImagePullBackOff", findings[0].Reason)
+	assert.Contains(t, findings[0].Message, "Container jupyterlab")
+	assert.Equal(t, "Pod/ws-0", findings[0].Object)
+
+	assert.Equal(t, "OOMKilled", findings[1].Reason)
+	assert.Contains(t, findings[1].Message, "Container tensorboard")
+}
+
+// Test_findingsFromPod_Unschedulable tests that an unschedulable pod is explained from its PodScheduled condition
+func Test_findingsFromPod_Unschedulable(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-0"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  "Unschedulable",
+					Message: "0/2 nodes are available: 2 pod has unbound immediate PersistentVolumeClaims.",
+				},
+			},
+		},
+	}
+
+	findings := findingsFromPod(pod)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "The pod is waiting for its volumes to be provisioned.", findings[0].Message)
+}
+
+// Test_findingsFromPersistentVolumeClaim tests that only unbound claims have findings
+func Test_findingsFromPersistentVolumeClaim(t *testing.T) {
+	bound := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-ws-0"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	assert.Empty(t, findingsFromPersistentVolumeClaim(bound))
+
+	pending := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "data-ws-0"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimPending},
+	}
+	findings := findingsFromPersistentVolumeClaim(pending)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "PersistentVolumeClaim/data-ws-0", findings[0].Object)
+}
+
+// Test_uniqueFindings tests removing repeated findings
+func Test_uniqueFindings(t *testing.T) {
+	finding := &DiagnosticFinding{Reason: "FailedScheduling", Message: "No GPU.", Object: "Pod/ws-0"}
+	other := &DiagnosticFinding{Reason: "FailedScheduling", Message: "No GPU.", Object: "Pod/ws-1"}
+
+	findings := uniqueFindings([]*DiagnosticFinding{finding, finding, other})
+	assert.Equal(t, []*DiagnosticFinding{finding, other}, findings)
+}
+
+// Test_summarizeWorkflowStatus tests that only the failed pod nodes of a workflow are summarized
+func Test_summarizeWorkflowStatus(t *testing.T) {
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "ws-action-abc"},
+		Status: wfv1.WorkflowStatus{
+			Phase:   wfv1.NodeFailed,
+			Message: "child 'ws-action-abc-1' failed",
+			Nodes: wfv1.Nodes{
+				"ws-action-abc":   {ID: "ws-action-abc", Type: wfv1.NodeTypeDAG, Phase: wfv1.NodeFailed},
+				"ws-action-abc-1": {ID: "ws-action-abc-1", DisplayName: "stateful-set", Type: wfv1.NodeTypePod, Phase: wfv1.NodeFailed, Message: "timed out"},
+				"ws-action-abc-2": {ID: "ws-action-abc-2", DisplayName: "service", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded},
+			},
+		},
+	}
+
+	summary := summarizeWorkflowStatus(wf)
+	assert.Equal(t, "ws-action-abc", summary.UID)
+	assert.Equal(t, "Failed", summary.Phase)
+	assert.Len(t, summary.FailedNodes, 1)
+	assert.Equal(t, "stateful-set", summary.FailedNodes[0].DisplayName)
+
+	findings := findingsFromWorkflowStatus(summary)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "Step stateful-set failed: timed out", findings[0].Message)
+	assert.Equal(t, "Workflow/ws-action-abc", findings[0].Object)
+}
+
+// Test_findingsFromNodePool tests explaining why a pod can't run on the nodes of its node pool
+func Test_findingsFromNodePool(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf-123"},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("8"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodScheduled, Status: corev1.ConditionFalse}},
+		},
+	}
+	nodePool := &NodePoolOption{ParameterOption: ParameterOption{Name: "CPU: 4, RAM: 16GB", Value: "Standard_D4s_v3"}}
+
+	findings := findingsFromNodePool(pod, "node.kubernetes.io/instance-type", nodePool, nil)
+	assert.Len(t, findings, 1)
+	assert.Equal(t, "NodePoolEmpty", findings[0].Reason)
+	assert.Contains(t, findings[0].Message, "node.kubernetes.io/instance-type=Standard_D4s_v3")
+
+	nodes := []corev1.Node{{
+		Spec: corev1.NodeSpec{
+			Taints: []corev1.Taint{
+				{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule},
+				{Key: "preferred", Effect: corev1.TaintEffectPreferNoSchedule},
+			},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("3920m"),
+				corev1.ResourceMemory: resource.MustParse("14Gi"),
+			},
+		},
+	}}
+
+	findings = findingsFromNodePool(pod, "node.kubernetes.io/instance-type", nodePool, nodes)
+	assert.Len(t, findings, 2)
+	assert.Equal(t, "NodePoolTainted", findings[0].Reason)
+	assert.Contains(t, findings[0].Message, "nvidia.com/gpu=present:NoSchedule")
+	assert.NotContains(t, findings[0].Message, "preferred")
+	assert.Equal(t, "NodePoolTooSmall", findings[1].Reason)
+	assert.Contains(t, findings[1].Message, "8 cpu")
+
+	pod.Spec.Tolerations = []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}}
+	pod.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU] = resource.MustParse("2")
+	assert.Empty(t, findingsFromNodePool(pod, "node.kubernetes.io/instance-type", nodePool, nodes))
+
+	pod.Spec.NodeName = "node-1"
+	assert.Empty(t, findingsFromNodePool(pod, "node.kubernetes.io/instance-type", nodePool, nil))
+}
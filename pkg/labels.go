@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"database/sql"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -151,7 +152,8 @@ func (c *Client) ReplaceLabels(namespace, resource, uid string, keyValues map[st
 		return fmt.Errorf("unknown resources '%v'", resource)
 	}
 
-	var whereCondition interface{} = nil
+	// Every resource is matched by its uid, without it the labels of every row in the table would be replaced
+	var whereCondition interface{} = sq.Eq{"uid": uid}
 	if resource == TypeWorkspace {
 		whereCondition = sq.And{
 			sq.Eq{"uid": uid},
@@ -165,9 +167,31 @@ func (c *Client) ReplaceLabels(namespace, resource, uid string, keyValues map[st
 			}
 	}
 
+	// Reserved labels are set by the system, so they are kept when the user's labels are replaced
+	existing := types.JSONLabels{}
+	err = sb.Select("labels").
+		From(tableName).
+		Where(whereCondition).
+		RunWith(tx).
+		QueryRow().
+		Scan(&existing)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	replacement := types.JSONLabels{}
+	for key, value := range existing {
+		if label.IsReserved(key) {
+			replacement[key] = value
+		}
+	}
+	for key, value := range keyValues {
+		replacement[key] = value
+	}
+
 	_, err = sb.Update(tableName).
 		SetMap(sq.Eq{
-			"labels": types.JSONLabels(keyValues),
+			"labels": replacement,
 		}).
 		Where(whereCondition).
 		RunWith(tx).
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// testWorkflowTemplateLabels returns the labels of the workflow template with uid, as stored in the database
+func testWorkflowTemplateLabels(t *testing.T, c *Client, uid string) types.JSONLabels {
+	labels := types.JSONLabels{}
+	err := c.DB.Getx(&labels, sb.Select("labels").From("workflow_templates").Where("uid = ?", uid))
+	assert.Nil(t, err)
+
+	return labels
+}
+
+// TestClient_ReplaceLabels tests that replacing the labels of a resource leaves the labels of other resources as they are
+func TestClient_ReplaceLabels(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	first, err := c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+		Name:     "first",
+		Manifest: defaultWorkflowTemplate,
+		Labels:   map[string]string{"team": "a"},
+	})
+	assert.Nil(t, err)
+	second, err := c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+		Name:     "second",
+		Manifest: defaultWorkflowTemplate,
+		Labels:   map[string]string{"team": "b"},
+	})
+	assert.Nil(t, err)
+
+	// The labels of the Kubernetes resources are replaced after the database, which has what is checked here
+	_ = c.ReplaceLabels(namespace, TypeWorkflowTemplate, first.UID, map[string]string{"team": "c"})
+
+	assert.Equal(t, types.JSONLabels{"team": "c"}, testWorkflowTemplateLabels(t, c, first.UID))
+	assert.Equal(t, types.JSONLabels{"team": "b"}, testWorkflowTemplateLabels(t, c, second.UID))
+}
@@ -0,0 +1,313 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// LogParser parses the content of a log entry into its level, logger, message and fields
+type LogParser interface {
+	// Parse sets the structured fields of entry from its content.
+	// It returns false, leaving entry unchanged, if the content is not in the parser's format.
+	Parse(entry *LogEntry) bool
+}
+
+// DefaultLogParsers are the parsers tried on every log entry, in order
+var DefaultLogParsers = []LogParser{
+	&JSONLogParser{},
+	&LogfmtLogParser{},
+	&PythonLogParser{},
+}
+
+// logLevelKeys, logLoggerKeys, logMessageKeys and logTimestampKeys are the field names commonly used
+// by logging libraries for the level, logger, message and timestamp of a structured log entry
+var (
+	logLevelKeys     = []string{"level", "lvl", "severity", "levelname"}
+	logLoggerKeys    = []string{"logger", "logger_name"}
+	logMessageKeys   = []string{"msg", "message"}
+	logTimestampKeys = []string{"time", "timestamp", "ts", "asctime"}
+)
+
+// logTimestampLayouts are the timestamp formats recognized in structured log entries
+var logTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05,000",
+	"2006-01-02 15:04:05.000",
+	"2006-01-02 15:04:05",
+}
+
+// normalizeLogLevel maps a level name to one of the LogLevel constants.
+// Unknown level names are returned in lower case.
+func normalizeLogLevel(level string) string {
+	level = strings.ToLower(level)
+	if normalized, ok := logLevelAliases[level]; ok {
+		return normalized
+	}
+
+	return level
+}
+
+// parseLogTimestamp parses a timestamp in any of logTimestampLayouts
+func parseLogTimestamp(value string) (time.Time, bool) {
+	for _, layout := range logTimestampLayouts {
+		if timestamp, err := time.Parse(layout, value); err == nil {
+			return timestamp, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// setLogEntryFields sets the structured fields of entry from the key/value pairs of a parsed log line.
+// Well known keys become the level, logger, message and timestamp, the rest are kept as fields.
+func setLogEntryFields(entry *LogEntry, values map[string]string) {
+	take := func(keys []string) string {
+		for _, key := range keys {
+			if value, ok := values[key]; ok {
+				delete(values, key)
+				return value
+			}
+		}
+		return ""
+	}
+
+	entry.Level = normalizeLogLevel(take(logLevelKeys))
+	entry.Logger = take(logLoggerKeys)
+	entry.Message = take(logMessageKeys)
+
+	if timestamp := take(logTimestampKeys); timestamp != "" {
+		parsed, ok := parseLogTimestamp(timestamp)
+		if ok && entry.Timestamp.IsZero() {
+			entry.Timestamp = parsed
+		} else if !ok {
+			values["time"] = timestamp
+		}
+	}
+
+	if len(values) > 0 {
+		entry.Fields = values
+	}
+}
+
+// JSONLogParser parses log entries that are JSON objects
+type JSONLogParser struct{}
+
+// Parse parses the content as a JSON object. Nested values are kept as JSON in the fields.
+func (p *JSONLogParser) Parse(entry *LogEntry) bool {
+	content := strings.TrimSpace(entry.Content)
+	if !strings.HasPrefix(content, "{") {
+		return false
+	}
+
+	raw := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(content), &raw); err != nil {
+		return false
+	}
+
+	values := make(map[string]string)
+	for key, value := range raw {
+		switch typed := value.(type) {
+		case string:
+			values[key] = typed
+		case nil:
+			values[key] = ""
+		case float64, bool:
+			values[key] = fmt.Sprint(typed)
+		default:
+			encoded, err := json.Marshal(typed)
+			if err != nil {
+				return false
+			}
+			values[key] = string(encoded)
+		}
+	}
+
+	setLogEntryFields(entry, values)
+
+	return true
+}
+
+// LogfmtLogParser parses log entries in logfmt, like level=info msg="starting epoch" epoch=1
+type LogfmtLogParser struct{}
+
+// Parse parses the content as logfmt. The content must be made up only of key=value pairs
+// and contain a level or message key, so plain text that happens to contain an = is not mistaken for logfmt.
+func (p *LogfmtLogParser) Parse(entry *LogEntry) bool {
+	values, ok := parseLogfmt(entry.Content)
+	if !ok {
+		return false
+	}
+
+	found := false
+	for _, key := range append(logLevelKeys, logMessageKeys...) {
+		if _, ok := values[key]; ok {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+
+	setLogEntryFields(entry, values)
+
+	return true
+}
+
+// parseLogfmt splits a logfmt line into its key/value pairs. Values may be double quoted.
+// It returns false if the line contains anything other than key=value pairs.
+func parseLogfmt(line string) (map[string]string, bool) {
+	values := make(map[string]string)
+
+	i := 0
+	for {
+		for i < len(line) && line[i] == ' ' {
+			i++
+		}
+		if i == len(line) {
+			break
+		}
+
+		keyStart := i
+		for i < len(line) && line[i] != '=' && line[i] != ' ' && line[i] != '"' {
+			i++
+		}
+		if i == keyStart || i == len(line) || line[i] != '=' {
+			return nil, false
+		}
+		key := line[keyStart:i]
+		i++
+
+		if i < len(line) && line[i] == '"' {
+			i++
+			value := strings.Builder{}
+			closed := false
+			for i < len(line) {
+				if line[i] == '\\' && i+1 < len(line) {
+					value.WriteByte(line[i+1])
+					i += 2
+					continue
+				}
+				if line[i] == '"' {
+					closed = true
+					i++
+					break
+				}
+				value.WriteByte(line[i])
+				i++
+			}
+			if !closed || (i < len(line) && line[i] != ' ') {
+				return nil, false
+			}
+			values[key] = value.String()
+			continue
+		}
+
+		valueStart := i
+		for i < len(line) && line[i] != ' ' {
+			i++
+		}
+		values[key] = line[valueStart:i]
+	}
+
+	return values, len(values) > 0
+}
+
+// pythonLogFormats are the formats of the python logging module's basicConfig default
+// and of the commonly used "%(asctime)s - %(name)s - %(levelname)s - %(message)s" format
+var pythonLogFormats = []*RegexLogParser{
+	MustNewRegexLogParser(`^(?P<level>DEBUG|INFO|WARNING|ERROR|CRITICAL):(?P<logger>[^:]*):(?P<message>.*)$`),
+	MustNewRegexLogParser(`^(?P<timestamp>\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}[,.]\d{3}) - (?P<logger>\S+) - (?P<level>DEBUG|INFO|WARNING|ERROR|CRITICAL) - (?P<message>.*)$`),
+}
+
+// PythonLogParser parses log entries written by the python logging module with its common formats
+type PythonLogParser struct{}
+
+// Parse parses the content with each of the python logging formats
+func (p *PythonLogParser) Parse(entry *LogEntry) bool {
+	for _, parser := range pythonLogFormats {
+		if parser.Parse(entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RegexLogParser parses log entries with a regular expression.
+// The named groups level, logger, message and timestamp set the matching properties of the entry,
+// any other named groups are kept as fields.
+type RegexLogParser struct {
+	pattern *regexp.Regexp
+}
+
+// NewRegexLogParser creates a RegexLogParser from a regular expression with at least one named group
+func NewRegexLogParser(expression string) (*RegexLogParser, error) {
+	pattern, err := regexp.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log format: %v", err)
+	}
+
+	named := false
+	for _, name := range pattern.SubexpNames() {
+		if name != "" {
+			named = true
+			break
+		}
+	}
+	if !named {
+		return nil, fmt.Errorf("log format '%v' has no named groups", expression)
+	}
+
+	return &RegexLogParser{pattern: pattern}, nil
+}
+
+// MustNewRegexLogParser is like NewRegexLogParser but panics if the expression is invalid
+func MustNewRegexLogParser(expression string) *RegexLogParser {
+	parser, err := NewRegexLogParser(expression)
+	if err != nil {
+		panic(err)
+	}
+
+	return parser
+}
+
+// Parse matches the content against the regular expression
+func (p *RegexLogParser) Parse(entry *LogEntry) bool {
+	match := p.pattern.FindStringSubmatch(entry.Content)
+	if match == nil {
+		return false
+	}
+
+	values := make(map[string]string)
+	for i, name := range p.pattern.SubexpNames() {
+		if name == "" || match[i] == "" {
+			continue
+		}
+
+		values[name] = strings.TrimRightFunc(match[i], unicode.IsSpace)
+	}
+
+	setLogEntryFields(entry, values)
+
+	return true
+}
+
+// GetLogParsers returns the parsers to use for a log stream. If logFormat, a regular expression, is not empty
+// it is tried before the default parsers. An invalid logFormat is ignored.
+func GetLogParsers(logFormat string) []LogParser {
+	if logFormat == "" {
+		return DefaultLogParsers
+	}
+
+	parser, err := NewRegexLogParser(logFormat)
+	if err != nil {
+		return DefaultLogParsers
+	}
+
+	return append([]LogParser{parser}, DefaultLogParsers...)
+}
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLogEntryFromLine_JSON tests parsing JSON log lines
+func TestLogEntryFromLine_JSON(t *testing.T) {
+	line := `2021-04-15T10:00:00Z {"level":"WARN","logger":"trainer","msg":"slow step","step":12,"tags":["a"]}`
+	entry := LogEntryFromLine(&line)
+
+	assert.Equal(t, time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC), entry.Timestamp)
+	assert.Equal(t, `{"level":"WARN","logger":"trainer","msg":"slow step","step":12,"tags":["a"]}`, entry.Content)
+	assert.Equal(t, LogLevelWarning, entry.Level)
+	assert.Equal(t, "trainer", entry.Logger)
+	assert.Equal(t, "slow step", entry.Message)
+	assert.Equal(t, map[string]string{"step": "12", "tags": `["a"]`}, entry.Fields)
+}
+
+// TestLogEntryFromLine_Logfmt tests parsing logfmt log lines
+func TestLogEntryFromLine_Logfmt(t *testing.T) {
+	line := `time="2021-04-15T10:00:00Z" level=info msg="epoch \"1\" done" loss=0.25`
+	entry := LogEntryFromLine(&line)
+
+	assert.Equal(t, time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC), entry.Timestamp)
+	assert.Equal(t, LogLevelInfo, entry.Level)
+	assert.Equal(t, `epoch "1" done`, entry.Message)
+	assert.Equal(t, map[string]string{"loss": "0.25"}, entry.Fields)
+
+	line = `loss=0.25 accuracy=0.9`
+	entry = LogEntryFromLine(&line)
+	assert.Equal(t, "", entry.Level)
+	assert.Nil(t, entry.Fields)
+
+	line = `level=info this is not logfmt`
+	entry = LogEntryFromLine(&line)
+	assert.Equal(t, "", entry.Level)
+}
+
+// TestLogEntryFromLine_Python tests parsing python logging lines
+func TestLogEntryFromLine_Python(t *testing.T) {
+	line := `2021-04-15T10:00:00Z ERROR:root:out of memory`
+	entry := LogEntryFromLine(&line)
+
+	assert.Equal(t, LogLevelError, entry.Level)
+	assert.Equal(t, "root", entry.Logger)
+	assert.Equal(t, "out of memory", entry.Message)
+
+	line = `2021-04-15 10:00:00,123 - trainer - CRITICAL - diverged`
+	entry = LogEntryFromLine(&line)
+
+	assert.Equal(t, LogLevelFatal, entry.Level)
+	assert.Equal(t, "trainer", entry.Logger)
+	assert.Equal(t, "diverged", entry.Message)
+	assert.Equal(t, time.Date(2021, 4, 15, 10, 0, 0, 123000000, time.UTC), entry.Timestamp)
+}
+
+// TestLogEntryFromLine_Plain tests that plain text lines are not parsed
+func TestLogEntryFromLine_Plain(t *testing.T) {
+	line := `2021-04-15T10:00:00Z Epoch 1/10`
+	entry := LogEntryFromLine(&line)
+
+	assert.Equal(t, "Epoch 1/10", entry.Content)
+	assert.Equal(t, "", entry.Level)
+	assert.Equal(t, "", entry.Message)
+	assert.Nil(t, entry.Fields)
+}
+
+// TestNewRegexLogParser tests parsing log lines with a custom format
+func TestNewRegexLogParser(t *testing.T) {
+	_, err := NewRegexLogParser(`(`)
+	assert.NotNil(t, err)
+
+	_, err = NewRegexLogParser(`^\w+ .*$`)
+	assert.NotNil(t, err)
+
+	parser, err := NewRegexLogParser(`^\[(?P<level>\w+)\] step=(?P<step>\d+) (?P<message>.*)$`)
+	assert.Nil(t, err)
+
+	line := `[warn] step=3 gradient overflow`
+	entry := LogEntryFromLine(&line, parser)
+	assert.Equal(t, LogLevelWarning, entry.Level)
+	assert.Equal(t, "gradient overflow", entry.Message)
+	assert.Equal(t, map[string]string{"step": "3"}, entry.Fields)
+
+	parsers := GetLogParsers(`^\[(?P<level>\w+)\] (?P<message>.*)$`)
+	assert.Len(t, parsers, len(DefaultLogParsers)+1)
+	assert.Equal(t, DefaultLogParsers, GetLogParsers(`(`))
+}
@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// streamLogEntries reads the log lines in stream and sends them in chunks on the returned channel as they are read.
+// Each entry is parsed with parsers and has its ContainerName set to containerName.
+// The channel is closed, and the stream with it, once the stream ends or ctx is done.
+func streamLogEntries(ctx context.Context, stream io.ReadCloser, containerName string, parsers []LogParser) <-chan []*LogEntry {
+	logWatcher := make(chan []*LogEntry)
+	finished := make(chan struct{})
+
+	newLogEntry := func(line *string) *LogEntry {
+		entry := LogEntryFromLine(line, parsers...)
+		if entry != nil {
+			entry.ContainerName = containerName
+		}
+		return entry
+	}
+
+	// Closing the stream unblocks a read of a followed stream that has no new lines
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-finished:
+		}
+		stream.Close()
+	}()
+
+	send := func(chunk []*LogEntry) bool {
+		select {
+		case logWatcher <- chunk:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	go func() {
+		defer close(finished)
+		defer close(logWatcher)
+
+		buffer := make([]byte, 4096)
+		reader := bufio.NewReader(stream)
+
+		lastChunkSent := -1
+		lastLine := ""
+		for {
+			bytesRead, err := reader.Read(buffer)
+			if err != nil && err.Error() != "EOF" {
+				break
+			}
+			content := lastLine + string(buffer[:bytesRead])
+			lastLine = ""
+
+			chunk := make([]*LogEntry, 0)
+			lines := strings.Split(content, "\n")
+			for lineIndex, line := range lines {
+				if lineIndex == len(lines)-1 {
+					lastLine = line
+					continue
+				}
+
+				entry := newLogEntry(&line)
+				if entry == nil {
+					continue
+				}
+
+				chunk = append(chunk, entry)
+			}
+
+			if lastChunkSent == 0 && lastLine != "" {
+				entry := newLogEntry(&lastLine)
+				if entry != nil {
+					chunk = append(chunk, entry)
+					lastLine = ""
+				}
+			}
+
+			if len(chunk) > 0 && !send(chunk) {
+				return
+			}
+			lastChunkSent = len(chunk)
+
+			if err != nil && err.Error() == "EOF" {
+				break
+			}
+		}
+
+		entry := newLogEntry(&lastLine)
+		if entry != nil {
+			send([]*LogEntry{entry})
+		}
+	}()
+
+	return logWatcher
+}
+
+// sortLogEntries sorts entries by timestamp, keeping the order of entries logged at the same time.
+// Entries without a timestamp are kept after the entry before them.
+func sortLogEntries(entries []*LogEntry) {
+	// Entries without a timestamp, like the empty line at the end of a stream, use the time of the previous entry
+	times := make(map[*LogEntry]time.Time, len(entries))
+	last := time.Time{}
+	for _, entry := range entries {
+		if !entry.Timestamp.IsZero() {
+			last = entry.Timestamp
+		}
+		times[entry] = last
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return times[entries[i]].Before(times[entries[j]])
+	})
+}
+
+// mergeLogEntries combines several log streams into one, ordered by timestamp.
+// Entries are buffered and sent every flushInterval, sorted by timestamp. If flushInterval is 0 the entries are
+// only sent once all of the streams end, so the whole result is in order.
+// The returned channel is closed once all of the streams end or ctx is done.
+func mergeLogEntries(ctx context.Context, watchers []<-chan []*LogEntry, flushInterval time.Duration) <-chan []*LogEntry {
+	merged := make(chan []*LogEntry)
+	received := make(chan []*LogEntry)
+	done := make(chan bool)
+
+	for _, watcher := range watchers {
+		go func(watcher <-chan []*LogEntry) {
+			for chunk := range watcher {
+				select {
+				case received <- chunk:
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case done <- true:
+			case <-ctx.Done():
+			}
+		}(watcher)
+	}
+
+	go func() {
+		defer close(merged)
+
+		var ticks <-chan time.Time
+		if flushInterval > 0 {
+			ticker := time.NewTicker(flushInterval)
+			defer ticker.Stop()
+			ticks = ticker.C
+		}
+
+		buffer := make([]*LogEntry, 0)
+		flush := func() bool {
+			if len(buffer) == 0 {
+				return true
+			}
+			sortLogEntries(buffer)
+			select {
+			case merged <- buffer:
+			case <-ctx.Done():
+				return false
+			}
+			buffer = make([]*LogEntry, 0)
+			return true
+		}
+
+		remaining := len(watchers)
+		for remaining > 0 {
+			select {
+			case chunk := <-received:
+				for _, entry := range chunk {
+					if entry.IsEmpty() {
+						continue
+					}
+					buffer = append(buffer, entry)
+				}
+			case <-done:
+				remaining--
+			case <-ticks:
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		flush()
+	}()
+
+	return merged
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Test_streamLogEntries tests reading a log stream into entries
+func Test_streamLogEntries(t *testing.T) {
+	stream := ioutil.NopCloser(strings.NewReader("2021-04-15T10:00:00Z first\n2021-04-15T10:00:01Z second\n"))
+
+	entries := make([]*LogEntry, 0)
+	for chunk := range streamLogEntries(context.Background(), stream, "main", DefaultLogParsers) {
+		entries = append(entries, chunk...)
+	}
+
+	assert.Len(t, entries, 3)
+	assert.Equal(t, "first", entries[0].Content)
+	assert.Equal(t, "main", entries[0].ContainerName)
+	assert.Equal(t, "second", entries[1].Content)
+	assert.True(t, entries[2].IsEmpty())
+}
+
+// testFollowedStream is a log stream that has no new lines until it is closed
+type testFollowedStream struct {
+	closed chan struct{}
+}
+
+func (s *testFollowedStream) Read(p []byte) (int, error) {
+	<-s.closed
+	return 0, io.EOF
+}
+
+func (s *testFollowedStream) Close() error {
+	close(s.closed)
+	return nil
+}
+
+// Test_streamLogEntries_Cancel tests that a followed stream is closed once the context is done
+func Test_streamLogEntries_Cancel(t *testing.T) {
+	stream := &testFollowedStream{closed: make(chan struct{})}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	watcher := mergeLogEntries(ctx, []<-chan []*LogEntry{
+		streamLogEntries(ctx, stream, "main", DefaultLogParsers),
+	}, time.Millisecond)
+	cancel()
+
+	select {
+	case _, ok := <-watcher:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		assert.Fail(t, "merged stream was not closed")
+	}
+
+	select {
+	case <-stream.closed:
+	case <-time.After(time.Second):
+		assert.Fail(t, "log stream was not closed")
+	}
+}
+
+// Test_sortLogEntries tests ordering log entries by timestamp
+func Test_sortLogEntries(t *testing.T) {
+	start := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	entries := []*LogEntry{
+		{Timestamp: start.Add(2 * time.Second), Content: "c"},
+		{Content: "c continued"},
+		{Timestamp: start, Content: "a"},
+		{Timestamp: start.Add(time.Second), Content: "b"},
+	}
+
+	sortLogEntries(entries)
+
+	contents := make([]string, len(entries))
+	for i, entry := range entries {
+		contents[i] = entry.Content
+	}
+	assert.Equal(t, []string{"a", "b", "c", "c continued"}, contents)
+}
+
+// Test_mergeLogEntries tests merging the logs of several containers
+func Test_mergeLogEntries(t *testing.T) {
+	first := ioutil.NopCloser(strings.NewReader("2021-04-15T10:00:00Z a\n2021-04-15T10:00:02Z c\n"))
+	second := ioutil.NopCloser(strings.NewReader("2021-04-15T10:00:01Z b\n2021-04-15T10:00:03Z d\n"))
+
+	merged := mergeLogEntries(context.Background(), []<-chan []*LogEntry{
+		streamLogEntries(context.Background(), first, "app", DefaultLogParsers),
+		streamLogEntries(context.Background(), second, "sidecar", DefaultLogParsers),
+	}, 0)
+
+	entries := make([]*LogEntry, 0)
+	for chunk := range merged {
+		entries = append(entries, chunk...)
+	}
+
+	assert.Len(t, entries, 4)
+	assert.Equal(t, "a", entries[0].Content)
+	assert.Equal(t, "app", entries[0].ContainerName)
+	assert.Equal(t, "b", entries[1].Content)
+	assert.Equal(t, "sidecar", entries[1].ContainerName)
+	assert.Equal(t, "c", entries[2].Content)
+	assert.Equal(t, "d", entries[3].Content)
+}
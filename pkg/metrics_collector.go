@@ -0,0 +1,94 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	runningWorkspacesDesc = prometheus.NewDesc(
+		"onepanel_workspaces_running",
+		"Number of running workspaces by namespace and workspace template.",
+		[]string{"namespace", "template"}, nil,
+	)
+
+	workflowExecutionsDesc = prometheus.NewDesc(
+		"onepanel_workflow_executions",
+		"Number of workflow executions that are not archived by namespace and phase.",
+		[]string{"namespace", "phase"}, nil,
+	)
+
+	cronWorkflowsDesc = prometheus.NewDesc(
+		"onepanel_cron_workflows",
+		"Number of cron workflows that are not archived by namespace.",
+		[]string{"namespace"}, nil,
+	)
+)
+
+// metricsCount is a count grouped by a namespace and, optionally, another value
+type metricsCount struct {
+	Namespace string
+	Value     string
+	Count     float64
+}
+
+// MetricsCollector is a prometheus collector for the number of workspaces, workflow executions and cron workflows.
+// The counts are queried from the database each time metrics are collected.
+type MetricsCollector struct {
+	db *DB
+}
+
+// NewMetricsCollector creates a MetricsCollector that queries db
+func NewMetricsCollector(db *DB) *MetricsCollector {
+	return &MetricsCollector{
+		db: db,
+	}
+}
+
+// Describe sends the descriptors of the metrics of the collector to ch
+func (m *MetricsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- runningWorkspacesDesc
+	ch <- workflowExecutionsDesc
+	ch <- cronWorkflowsDesc
+}
+
+// Collect queries the counts and sends them to ch. Counts that can't be queried are left out.
+func (m *MetricsCollector) Collect(ch chan<- prometheus.Metric) {
+	m.collect(ch, runningWorkspacesDesc, sb.Select("w.namespace", "wt.name AS value", "COUNT(*) AS count").
+		From("workspaces w").
+		Join("workspace_templates wt ON wt.id = w.workspace_template_id").
+		Where(sq.Eq{"w.phase": WorkspaceRunning}).
+		GroupBy("w.namespace", "wt.name"), true)
+
+	m.collect(ch, workflowExecutionsDesc, sb.Select("namespace", "COALESCE(phase, '') AS value", "COUNT(*) AS count").
+		From("workflow_executions").
+		Where(sq.Eq{"is_archived": false}).
+		GroupBy("namespace", "phase"), true)
+
+	m.collect(ch, cronWorkflowsDesc, sb.Select("namespace", "COUNT(*) AS count").
+		From("cron_workflows").
+		Where(sq.Eq{"is_archived": false}).
+		GroupBy("namespace"), false)
+}
+
+// collect runs a query for counts and sends them to ch as gauges of desc.
+// If hasValue is true, the value of each count is used as the label after the namespace.
+func (m *MetricsCollector) collect(ch chan<- prometheus.Metric, desc *prometheus.Desc, query sq.SelectBuilder, hasValue bool) {
+	counts := make([]*metricsCount, 0)
+	if err := m.db.Selectx(&counts, query); err != nil {
+		log.WithFields(log.Fields{
+			"Metric": desc.String(),
+			"Error":  err.Error(),
+		}).Error("Unable to collect metric.")
+		return
+	}
+
+	for _, count := range counts {
+		labels := []string{count.Namespace}
+		if hasValue {
+			labels = append(labels, count.Value)
+		}
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, count.Count, labels...)
+	}
+}
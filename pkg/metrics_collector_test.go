@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestMetricsCollector_Collect makes sure workflow executions are counted by namespace and phase
+func TestMetricsCollector_Collect(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	_, err := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "test"}, wt)
+	assert.Nil(t, err)
+
+	_, err = database.Exec("UPDATE workflow_executions SET phase = 'Running'")
+	assert.Nil(t, err)
+
+	expected := `
+		# HELP onepanel_workflow_executions Number of workflow executions that are not archived by namespace and phase.
+		# TYPE onepanel_workflow_executions gauge
+		onepanel_workflow_executions{namespace="onepanel",phase="Running"} 1
+	`
+	err = testutil.CollectAndCompare(NewMetricsCollector(c.DB), strings.NewReader(expected), "onepanel_workflow_executions")
+	assert.Nil(t, err)
+}
@@ -1,9 +1,18 @@
 package v1
 
 import (
+	goerrors "errors"
 	"fmt"
-	v1 "k8s.io/api/core/v1"
+	"strings"
+	"time"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -67,3 +76,540 @@ func (c *Client) CreateNamespace(name string) (namespace *Namespace, err error)
 
 	return
 }
+
+// isAlreadyExists returns true if err is a Kubernetes or user error for a resource that already exists
+func isAlreadyExists(err error) bool {
+	if errors.IsAlreadyExists(err) {
+		return true
+	}
+	var userErr *util.UserError
+
+	return goerrors.As(err, &userErr) && userErr.Code == codes.AlreadyExists
+}
+
+// BootstrapNamespace sets up the namespace with name so workflows and workspaces can run in it.
+// Resources that already exist are skipped, so it can be run again, e.g. after a failure.
+func (c *Client) BootstrapNamespace(name string, bootstrap *NamespaceBootstrap) (*NamespaceBootstrapResult, error) {
+	if err := bootstrap.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	if bootstrap.SourceNamespace == name {
+		return nil, util.NewUserError(codes.InvalidArgument, "A namespace can't be copied to itself.")
+	}
+
+	namespace, err := c.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, util.NewUserError(codes.NotFound, "Namespace not found.")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if namespace.Labels[onepanelEnabledLabelKey] != "true" {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Only Onepanel namespaces can be bootstrapped.")
+	}
+
+	result := &NamespaceBootstrapResult{
+		Created: make([]string, 0),
+		Skipped: make([]string, 0),
+	}
+	if bootstrap.SourceNamespace != "" {
+		if _, err := c.CoreV1().Namespaces().Get(bootstrap.SourceNamespace, metav1.GetOptions{}); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, util.NewUserError(codes.NotFound, "Source namespace not found.")
+			}
+			return nil, err
+		}
+
+		steps := []func(string, string, *NamespaceBootstrapResult) error{
+			c.copyNamespaceConfig,
+			func(source, namespace string, result *NamespaceBootstrapResult) error {
+				return c.copyNamespaceRBAC(source, namespace, bootstrap.CopyRoleBindingUsers, result)
+			},
+		}
+		if bootstrap.CopyTemplates {
+			steps = append(steps, c.copyNamespaceWorkflowTemplates, c.copyNamespaceWorkspaceTemplates)
+		}
+		for _, step := range steps {
+			if err := step(bootstrap.SourceNamespace, name, result); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace":       name,
+					"SourceNamespace": bootstrap.SourceNamespace,
+					"Error":           err.Error(),
+				}).Error("Could not bootstrap namespace.")
+				return nil, util.NewUserErrorWrap(err, "Namespace resource")
+			}
+		}
+	}
+
+	for _, binding := range bootstrap.RoleBindings {
+		_, err := c.CreateRoleBinding(name, binding)
+		if err != nil && !isAlreadyExists(err) {
+			return nil, err
+		}
+		result.add("rolebinding", binding.SubjectKind+":"+binding.SubjectName+":"+binding.Role, err == nil)
+	}
+
+	if len(bootstrap.ResourceQuota) > 0 {
+		if err := c.applyNamespaceResourceQuota(name, bootstrap, result); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// copyNamespaceConfig copies the onepanel config map and secret, which have the artifact repository configuration
+// and its credentials. The secret is always read from Kubernetes, like GetNamespaceConfig does.
+func (c *Client) copyNamespaceConfig(source, namespace string, result *NamespaceBootstrapResult) error {
+	configMap, err := c.CoreV1().ConfigMaps(source).Get(systemNamespace, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		_, err = c.CoreV1().ConfigMaps(namespace).Create(&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        configMap.Name,
+				Labels:      configMap.Labels,
+				Annotations: configMap.Annotations,
+			},
+			Data: configMap.Data,
+		})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		result.add("configmap", configMap.Name, err == nil)
+	}
+
+	secret, err := c.CoreV1().Secrets(source).Get(systemNamespace, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = c.CoreV1().Secrets(namespace).Create(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        secret.Name,
+			Labels:      secret.Labels,
+			Annotations: secret.Annotations,
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	result.add("secret", secret.Name, err == nil)
+
+	return nil
+}
+
+// copyNamespaceRBAC copies the Kubernetes roles and role bindings of source.
+// Subjects that are service accounts of source are bound to the service accounts of namespace instead.
+// Other subjects are only kept if copyUsers is set, role bindings left without subjects are not copied.
+// Resources owned by other resources are created by their owners, so they are not copied.
+func (c *Client) copyNamespaceRBAC(source, namespace string, copyUsers bool, result *NamespaceBootstrapResult) error {
+	roles, err := c.RbacV1().Roles(source).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, role := range roles.Items {
+		if len(role.OwnerReferences) > 0 {
+			continue
+		}
+		_, err := c.RbacV1().Roles(namespace).Create(&rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        role.Name,
+				Labels:      role.Labels,
+				Annotations: role.Annotations,
+			},
+			Rules: role.Rules,
+		})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		result.add("role", role.Name, err == nil)
+	}
+
+	roleBindings, err := c.RbacV1().RoleBindings(source).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, roleBinding := range roleBindings.Items {
+		if len(roleBinding.OwnerReferences) > 0 {
+			continue
+		}
+		subjects := make([]rbacv1.Subject, 0, len(roleBinding.Subjects))
+		for _, subject := range roleBinding.Subjects {
+			if subject.Kind == rbacv1.ServiceAccountKind && subject.Namespace == source {
+				subject.Namespace = namespace
+			} else if !copyUsers {
+				continue
+			}
+			subjects = append(subjects, subject)
+		}
+		if len(subjects) == 0 {
+			continue
+		}
+		_, err := c.RbacV1().RoleBindings(namespace).Create(&rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        roleBinding.Name,
+				Labels:      roleBinding.Labels,
+				Annotations: roleBinding.Annotations,
+			},
+			Subjects: subjects,
+			RoleRef:  roleBinding.RoleRef,
+		})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+		result.add("k8srolebinding", roleBinding.Name, err == nil)
+	}
+
+	return nil
+}
+
+// copyNamespaceWorkflowTemplates creates the latest versions of the workflow templates of source in namespace.
+// System workflow templates belong to workspace templates, which create their own.
+func (c *Client) copyNamespaceWorkflowTemplates(source, namespace string, result *NamespaceBootstrapResult) error {
+	query := sb.Select("wt.name", "wtv.manifest", "wtv.description", "wtv.labels").
+		From("workflow_template_versions wtv").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"wt.namespace":   source,
+			"wt.is_archived": false,
+			"wt.is_system":   false,
+			"wtv.is_latest":  true,
+		}).
+		OrderBy("wt.name")
+
+	workflowTemplates := make([]*WorkflowTemplate, 0)
+	if err := c.DB.Selectx(&workflowTemplates, query); err != nil {
+		return err
+	}
+
+	for _, workflowTemplate := range workflowTemplates {
+		_, err := c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+			Name:        workflowTemplate.Name,
+			Manifest:    workflowTemplate.Manifest,
+			Description: workflowTemplate.Description,
+			Labels:      workflowTemplate.Labels,
+		})
+		if err != nil && !isAlreadyExists(err) {
+			return err
+		}
+		result.add("workflowtemplate", workflowTemplate.Name, err == nil)
+	}
+
+	return nil
+}
+
+// copyNamespaceWorkspaceTemplates creates the latest versions of the workspace templates of source in namespace
+func (c *Client) copyNamespaceWorkspaceTemplates(source, namespace string, result *NamespaceBootstrapResult) error {
+	query := sb.Select("wt.name", "wt.description", "wtv.manifest", "wtv.labels").
+		From("workspace_template_versions wtv").
+		Join("workspace_templates wt ON wt.id = wtv.workspace_template_id").
+		Where(sq.Eq{
+			"wt.namespace":   source,
+			"wt.is_archived": false,
+			"wtv.is_latest":  true,
+		}).
+		OrderBy("wt.name")
+
+	workspaceTemplates := make([]*WorkspaceTemplate, 0)
+	if err := c.DB.Selectx(&workspaceTemplates, query); err != nil {
+		return err
+	}
+
+	for _, workspaceTemplate := range workspaceTemplates {
+		_, err := c.CreateWorkspaceTemplate(namespace, &WorkspaceTemplate{
+			Name:        workspaceTemplate.Name,
+			Manifest:    workspaceTemplate.Manifest,
+			Description: workspaceTemplate.Description,
+			Labels:      workspaceTemplate.Labels,
+		})
+		if err != nil && !isAlreadyExists(err) {
+			return err
+		}
+		result.add("workspacetemplate", workspaceTemplate.Name, err == nil)
+	}
+
+	return nil
+}
+
+// applyNamespaceResourceQuota creates the onepanel resource quota of namespace, or updates its limits
+func (c *Client) applyNamespaceResourceQuota(namespace string, bootstrap *NamespaceBootstrap, result *NamespaceBootstrapResult) error {
+	hard, err := bootstrap.resourceQuotaHard()
+	if err != nil {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	resourceQuotas := c.CoreV1().ResourceQuotas(namespace)
+	resourceQuota, err := resourceQuotas.Get(systemNamespace, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = resourceQuotas.Create(&v1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: systemNamespace,
+			},
+			Spec: v1.ResourceQuotaSpec{
+				Hard: hard,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		result.add("resourcequota", systemNamespace, true)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	resourceQuota.Spec.Hard = hard
+	if _, err := resourceQuotas.Update(resourceQuota); err != nil {
+		return err
+	}
+	result.add("resourcequota", systemNamespace, true)
+
+	return nil
+}
+
+// DeleteNamespace deletes the namespace with name, its Kubernetes resources and volumes, and its database records.
+// If dryRun is true nothing is deleted, and the report has what would be.
+// If the namespace is already gone, its remaining database records are deleted, e.g. after a failed deletion.
+func (c *Client) DeleteNamespace(name string, dryRun bool) (*NamespaceDeletionReport, error) {
+	if name == systemNamespace || strings.HasPrefix(name, "kube-") || name == "default" {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("The %v namespace can't be deleted.", name))
+	}
+
+	exists := true
+	namespace, err := c.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		exists = false
+	} else if err != nil {
+		return nil, err
+	} else if namespace.Labels[onepanelEnabledLabelKey] != "true" {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Only Onepanel namespaces can be deleted.")
+	}
+
+	report := newNamespaceDeletionReport(name, dryRun)
+	if exists {
+		if err := c.countNamespaceResources(name, report); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.countNamespaceRecords(name, report); err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	// The namespace is deleted first, so its records are only gone once nothing can use them anymore
+	if exists {
+		propagation := metav1.DeletePropagationForeground
+		err := c.CoreV1().Namespaces().Delete(name, &metav1.DeleteOptions{
+			PropagationPolicy: &propagation,
+		})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+
+	if err := c.deleteNamespaceRecords(name); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": name,
+			"Error":     err.Error(),
+		}).Error("Could not delete namespace records.")
+		return nil, util.NewUserError(codes.Unknown, "Could not delete namespace records, delete the namespace again to retry.")
+	}
+
+	return report, nil
+}
+
+// countNamespaceResources adds the Kubernetes resources of namespace to report
+func (c *Client) countNamespaceResources(namespace string, report *NamespaceDeletionReport) error {
+	listOptions := metav1.ListOptions{}
+
+	claims, err := c.CoreV1().PersistentVolumeClaims(namespace).List(listOptions)
+	if err != nil {
+		return err
+	}
+	report.addVolumes(claims.Items)
+
+	counts := []struct {
+		kind  string
+		count func() (int, error)
+	}{
+		{"pods", func() (int, error) {
+			list, err := c.CoreV1().Pods(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"services", func() (int, error) {
+			list, err := c.CoreV1().Services(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"configmaps", func() (int, error) {
+			list, err := c.CoreV1().ConfigMaps(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"secrets", func() (int, error) {
+			list, err := c.CoreV1().Secrets(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"statefulsets", func() (int, error) {
+			list, err := c.AppsV1().StatefulSets(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"deployments", func() (int, error) {
+			list, err := c.AppsV1().Deployments(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"workflows", func() (int, error) {
+			list, err := c.ArgoprojV1alpha1().Workflows(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"cronworkflows", func() (int, error) {
+			list, err := c.ArgoprojV1alpha1().CronWorkflows(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+		{"workflowtemplates", func() (int, error) {
+			list, err := c.ArgoprojV1alpha1().WorkflowTemplates(namespace).List(listOptions)
+			if err != nil {
+				return 0, err
+			}
+			return len(list.Items), nil
+		}},
+	}
+	for _, count := range counts {
+		n, err := count.count()
+		if err != nil {
+			return err
+		}
+		report.Resources[count.kind] = int64(n)
+	}
+
+	return nil
+}
+
+// namespaceRecordCounts are the queries that count the database records of a namespace, by table
+func namespaceRecordCounts(namespace string) map[string]sq.SelectBuilder {
+	count := func(table string) sq.SelectBuilder {
+		return sb.Select("COUNT(*)").
+			From(table).
+			Where(sq.Eq{"namespace": namespace})
+	}
+
+	return map[string]sq.SelectBuilder{
+		"workflow_executions": count("workflow_executions"),
+		"workflow_execution_approvals": sb.Select("COUNT(*)").
+			From("workflow_execution_approvals wea").
+			Join("workflow_executions we ON we.id = wea.workflow_execution_id").
+			Where(sq.Eq{"we.namespace": namespace}),
+		"cron_workflows":      count("cron_workflows"),
+		"workspaces":          count("workspaces"),
+		"workspace_templates": count("workspace_templates"),
+		"workspace_template_versions": sb.Select("COUNT(*)").
+			From("workspace_template_versions wtv").
+			Join("workspace_templates wt ON wt.id = wtv.workspace_template_id").
+			Where(sq.Eq{"wt.namespace": namespace}),
+		"workflow_templates": count("workflow_templates"),
+		"workflow_template_versions": sb.Select("COUNT(*)").
+			From("workflow_template_versions wtv").
+			Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+			Where(sq.Eq{"wt.namespace": namespace}),
+		"role_bindings": count("role_bindings"),
+		"api_tokens": sb.Select("COUNT(*)").
+			From("api_tokens").
+			Where(sq.Expr("? = ANY(namespaces)", namespace)).
+			Where(sq.Eq{"revoked_at": nil}),
+	}
+}
+
+// countNamespaceRecords adds the database records of namespace to report
+func (c *Client) countNamespaceRecords(namespace string, report *NamespaceDeletionReport) error {
+	for table, query := range namespaceRecordCounts(namespace) {
+		count := int64(0)
+		if err := c.DB.Getx(&count, query); err != nil {
+			return err
+		}
+		report.Records[table] = count
+	}
+
+	return nil
+}
+
+// deleteNamespaceRecords deletes the database records of namespace in one transaction.
+// Versions and approvals are deleted with their templates and executions. Audit events are kept.
+// API tokens lose access to the namespace, and are revoked if it was the only one they could access.
+func (c *Client) deleteNamespaceRecords(namespace string) error {
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// In order of their references: executions reference cron workflows, which reference template versions
+	tables := []string{
+		"workflow_executions",
+		"cron_workflows",
+		"workspaces",
+		"workspace_templates",
+		"workflow_templates",
+		"role_bindings",
+	}
+	for _, table := range tables {
+		_, err := sb.Delete(table).
+			Where(sq.Eq{"namespace": namespace}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = sb.Update("api_tokens").
+		Set("namespaces", sq.Expr("array_remove(namespaces, ?)", namespace)).
+		Where(sq.Expr("? = ANY(namespaces)", namespace)).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("api_tokens").
+		Set("revoked_at", time.Now().UTC()).
+		Where(sq.Expr("cardinality(namespaces) = 0")).
+		Where(sq.Eq{"revoked_at": nil}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,449 @@
+package v1
+
+import (
+	goerrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// copyCronWorkflow is a cron workflow of the source namespace with the uid of its workflow template
+type copyCronWorkflow struct {
+	CronWorkflow
+	WorkflowTemplateUID string `db:"workflow_template_uid"`
+}
+
+// copyConflict checks whether name is used by a resource of kind in namespace
+type copyConflict func(namespace, name string) (bool, error)
+
+// copyCheck is a name to check for conflicts before anything is copied
+type copyCheck struct {
+	kind     string
+	name     string
+	conflict copyConflict
+}
+
+// CopyResources copies the selected resources of sourceNamespace to targetNamespace with the same create methods
+// users call, so they are validated and their Argo resources are created the same way.
+// Resources that fail to copy are reported, and the others are still copied.
+func (c *Client) CopyResources(sourceNamespace, targetNamespace string, selection *CopySelection) (*CopyReport, error) {
+	if err := selection.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+	if sourceNamespace == targetNamespace {
+		return nil, util.NewUserError(codes.InvalidArgument, "Resources can't be copied to the namespace they are in.")
+	}
+	for _, namespace := range []string{sourceNamespace, targetNamespace} {
+		if _, err := c.CoreV1().Namespaces().Get(namespace, metav1.GetOptions{}); err != nil {
+			if errors.IsNotFound(err) {
+				return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Namespace '%v' not found.", namespace))
+			}
+			return nil, err
+		}
+	}
+
+	workflowTemplates, err := c.getCopyWorkflowTemplates(sourceNamespace, selection)
+	if err != nil {
+		return nil, err
+	}
+	workspaceTemplates, err := c.getCopyWorkspaceTemplates(sourceNamespace, selection)
+	if err != nil {
+		return nil, err
+	}
+
+	if selection.OnConflict == CopyConflictFail {
+		if err := c.checkCopyConflicts(targetNamespace, selection.Secrets, workflowTemplates, workspaceTemplates); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &CopyReport{
+		SourceNamespace: sourceNamespace,
+		TargetNamespace: targetNamespace,
+		Results:         make([]*CopyResult, 0),
+	}
+
+	// Secrets come first, templates and cron workflows may reference them
+	for _, name := range selection.Secrets {
+		c.copySecret(sourceNamespace, targetNamespace, name, selection, report)
+	}
+
+	// uids of the copied workflow templates, to link the copied cron workflows to them
+	workflowTemplateUIDs := make(map[string]string)
+	for _, versions := range workflowTemplates {
+		if uid := c.copyWorkflowTemplate(targetNamespace, versions, selection, report); uid != "" {
+			workflowTemplateUIDs[versions[0].UID] = uid
+		}
+	}
+
+	for _, versions := range workspaceTemplates {
+		c.copyWorkspaceTemplate(targetNamespace, versions, selection, report)
+	}
+
+	for _, uid := range selection.CronWorkflows {
+		c.copyCronWorkflow(sourceNamespace, targetNamespace, uid, workflowTemplateUIDs, selection, report)
+	}
+
+	return report, nil
+}
+
+// getCopyWorkflowTemplates returns the versions of the selected workflow templates, oldest first.
+// Only the latest version is returned unless all versions are selected.
+func (c *Client) getCopyWorkflowTemplates(namespace string, selection *CopySelection) ([][]*WorkflowTemplate, error) {
+	workflowTemplates := make([][]*WorkflowTemplate, 0)
+	for _, uid := range selection.WorkflowTemplates {
+		versions, err := c.ListWorkflowTemplateVersions(namespace, uid)
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 || versions[0].IsArchived {
+			return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Workflow template '%v' not found.", uid))
+		}
+
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].Version < versions[j].Version
+		})
+		for _, version := range versions {
+			version.UID = uid
+		}
+		if !selection.AllVersions {
+			versions = versions[len(versions)-1:]
+		}
+		workflowTemplates = append(workflowTemplates, versions)
+	}
+
+	return workflowTemplates, nil
+}
+
+// getCopyWorkspaceTemplates returns the versions of the selected workspace templates, oldest first.
+// Only the latest version is returned unless all versions are selected.
+func (c *Client) getCopyWorkspaceTemplates(namespace string, selection *CopySelection) ([][]*WorkspaceTemplate, error) {
+	workspaceTemplates := make([][]*WorkspaceTemplate, 0)
+	for _, uid := range selection.WorkspaceTemplates {
+		versions, err := c.ListWorkspaceTemplateVersions(namespace, uid)
+		if err != nil {
+			return nil, err
+		}
+		if len(versions) == 0 {
+			return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Workspace template '%v' not found.", uid))
+		}
+
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].Version < versions[j].Version
+		})
+		if !selection.AllVersions {
+			versions = versions[len(versions)-1:]
+		}
+		workspaceTemplates = append(workspaceTemplates, versions)
+	}
+
+	return workspaceTemplates, nil
+}
+
+// checkCopyConflicts returns an error with the names that are used in namespace, if there are any
+func (c *Client) checkCopyConflicts(namespace string, secrets []string, workflowTemplates [][]*WorkflowTemplate, workspaceTemplates [][]*WorkspaceTemplate) error {
+	checks := make([]copyCheck, 0)
+	for _, name := range secrets {
+		checks = append(checks, copyCheck{CopyKindSecret, name, c.secretNameUsed})
+	}
+	for _, versions := range workflowTemplates {
+		checks = append(checks, copyCheck{CopyKindWorkflowTemplate, versions[0].Name, c.workflowTemplateNameUsed})
+	}
+	for _, versions := range workspaceTemplates {
+		checks = append(checks, copyCheck{CopyKindWorkspaceTemplate, versions[0].Name, c.workspaceTemplateNameUsed})
+	}
+
+	conflicts := make([]string, 0)
+	for _, check := range checks {
+		used, err := check.conflict(namespace, check.name)
+		if err != nil {
+			return err
+		}
+		if used {
+			conflicts = append(conflicts, check.kind+"/"+check.name)
+		}
+	}
+	if len(conflicts) > 0 {
+		return util.NewUserError(codes.AlreadyExists, fmt.Sprintf("Nothing was copied, these names are used: %v.", strings.Join(conflicts, ", ")))
+	}
+
+	return nil
+}
+
+// secretNameUsed returns true if the secret backend of namespace has a secret with name
+func (c *Client) secretNameUsed(namespace, name string) (bool, error) {
+	exists, err := c.SecretExists(namespace, name)
+	var userErr *util.UserError
+	if goerrors.As(err, &userErr) && userErr.Code == codes.NotFound {
+		return false, nil
+	}
+
+	return exists, err
+}
+
+// workflowTemplateNameUsed returns true if a workflow template in namespace has name
+func (c *Client) workflowTemplateNameUsed(namespace, name string) (bool, error) {
+	archived := false
+	count, err := c.CountWorkflowTemplatesByName(namespace, name, &archived)
+
+	return count > 0, err
+}
+
+// workspaceTemplateNameUsed returns true if a workspace template in namespace has name
+func (c *Client) workspaceTemplateNameUsed(namespace, name string) (bool, error) {
+	workspaceTemplate, err := c.getWorkspaceTemplateByName(namespace, name)
+
+	return workspaceTemplate != nil, err
+}
+
+// copyTargetName returns the name a resource is copied to, and its status.
+// If the name is used and the resource is not renamed, the name is empty.
+func copyTargetName(namespace, name, onConflict string, conflict copyConflict) (string, string, error) {
+	used, err := conflict(namespace, name)
+	if err != nil {
+		return "", "", err
+	}
+	if !used {
+		return name, CopyStatusCopied, nil
+	}
+	if onConflict != CopyConflictRename {
+		return "", CopyStatusSkipped, nil
+	}
+
+	for n := 1; n <= maxCopyNames; n++ {
+		candidate := copyName(name, n)
+		used, err := conflict(namespace, candidate)
+		if err != nil {
+			return "", "", err
+		}
+		if !used {
+			return candidate, CopyStatusRenamed, nil
+		}
+	}
+
+	return "", "", fmt.Errorf("no unused name was found for a copy of '%v'", name)
+}
+
+// copySecret copies the secret with name, with the values it has in the source namespace
+func (c *Client) copySecret(sourceNamespace, targetNamespace, name string, selection *CopySelection, report *CopyReport) {
+	target, status, err := copyTargetName(targetNamespace, name, selection.OnConflict, c.secretNameUsed)
+	if err != nil {
+		report.add(CopyKindSecret, name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	if target == "" {
+		report.add(CopyKindSecret, name, "", status, "A secret with this name exists.")
+		return
+	}
+
+	secret, err := c.GetSecret(sourceNamespace, name)
+	if err != nil {
+		report.add(CopyKindSecret, name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	data, err := decodeSecretData(secret.Data)
+	if err != nil {
+		report.add(CopyKindSecret, name, "", CopyStatusFailed, err.Error())
+		return
+	}
+
+	err = c.CreateSecret(targetNamespace, &Secret{
+		Name: target,
+		Type: secret.Type,
+		Data: data,
+	})
+	if err != nil {
+		report.add(CopyKindSecret, name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	report.add(CopyKindSecret, name, target, status, "")
+}
+
+// copyWorkflowTemplate creates the versions of a workflow template in namespace and returns the uid of the copy.
+// The uid is empty if it was not copied.
+func (c *Client) copyWorkflowTemplate(namespace string, versions []*WorkflowTemplate, selection *CopySelection, report *CopyReport) string {
+	name := versions[0].Name
+	target, status, err := copyTargetName(namespace, name, selection.OnConflict, c.workflowTemplateNameUsed)
+	if err != nil {
+		report.add(CopyKindWorkflowTemplate, name, "", CopyStatusFailed, err.Error())
+		return ""
+	}
+	if target == "" {
+		report.add(CopyKindWorkflowTemplate, name, "", status, "A workflow template with this name exists.")
+		return ""
+	}
+
+	var created *WorkflowTemplate
+	for _, version := range versions {
+		workflowTemplate := &WorkflowTemplate{
+			Name:        target,
+			Manifest:    version.Manifest,
+			Description: version.Description,
+		}
+		if selection.Labels {
+			workflowTemplate.Labels = version.Labels
+		}
+
+		if created == nil {
+			created, err = c.CreateWorkflowTemplate(namespace, workflowTemplate)
+		} else {
+			workflowTemplate.UID = created.UID
+			_, err = c.CreateWorkflowTemplateVersion(namespace, workflowTemplate)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      target,
+				"Version":   version.Version,
+				"Error":     err.Error(),
+			}).Error("Could not copy workflow template.")
+			break
+		}
+	}
+	if created == nil {
+		report.add(CopyKindWorkflowTemplate, name, "", CopyStatusFailed, err.Error())
+		return ""
+	}
+	if err != nil {
+		// The versions that were created are kept, the report has the version that failed
+		report.add(CopyKindWorkflowTemplate, name, target, CopyStatusFailed, "Not all versions were copied: "+err.Error())
+		return created.UID
+	}
+	report.add(CopyKindWorkflowTemplate, name, target, status, "")
+
+	return created.UID
+}
+
+// copyWorkspaceTemplate creates the versions of a workspace template in namespace
+func (c *Client) copyWorkspaceTemplate(namespace string, versions []*WorkspaceTemplate, selection *CopySelection, report *CopyReport) {
+	name := versions[0].Name
+	target, status, err := copyTargetName(namespace, name, selection.OnConflict, c.workspaceTemplateNameUsed)
+	if err != nil {
+		report.add(CopyKindWorkspaceTemplate, name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	if target == "" {
+		report.add(CopyKindWorkspaceTemplate, name, "", status, "A workspace template with this name exists.")
+		return
+	}
+
+	var created *WorkspaceTemplate
+	for _, version := range versions {
+		workspaceTemplate := &WorkspaceTemplate{
+			Name:        target,
+			Manifest:    version.Manifest,
+			Description: version.Description,
+		}
+		if selection.Labels {
+			workspaceTemplate.Labels = version.Labels
+		}
+
+		if created == nil {
+			created, err = c.CreateWorkspaceTemplate(namespace, workspaceTemplate)
+		} else {
+			workspaceTemplate.UID = created.UID
+			_, err = c.UpdateWorkspaceTemplate(namespace, workspaceTemplate)
+		}
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      target,
+				"Version":   version.Version,
+				"Error":     err.Error(),
+			}).Error("Could not copy workspace template.")
+			break
+		}
+	}
+	if created == nil {
+		report.add(CopyKindWorkspaceTemplate, name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	if err != nil {
+		report.add(CopyKindWorkspaceTemplate, name, target, CopyStatusFailed, "Not all versions were copied: "+err.Error())
+		return
+	}
+	report.add(CopyKindWorkspaceTemplate, name, target, status, "")
+}
+
+// getCopyCronWorkflow returns the cron workflow with uid and the uid of its workflow template
+func (c *Client) getCopyCronWorkflow(namespace, uid string) (*copyCronWorkflow, error) {
+	query := sb.Select(getCronWorkflowColumns("cw")...).
+		Column("wt.uid workflow_template_uid").
+		From("cron_workflows cw").
+		Join("workflow_template_versions wtv ON wtv.id = cw.workflow_template_version_id").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"cw.namespace":   namespace,
+			"cw.uid":         uid,
+			"cw.is_archived": false,
+		})
+
+	cronWorkflow := &copyCronWorkflow{}
+	if err := c.DB.Getx(cronWorkflow, query); err != nil {
+		return nil, err
+	}
+
+	return cronWorkflow, nil
+}
+
+// copyCronWorkflow creates a suspended copy of the cron workflow with uid in targetNamespace.
+// It runs the latest version of the copy of its workflow template, or of the workflow template with the same uid.
+func (c *Client) copyCronWorkflow(sourceNamespace, targetNamespace, uid string, workflowTemplateUIDs map[string]string, selection *CopySelection, report *CopyReport) {
+	source, err := c.getCopyCronWorkflow(sourceNamespace, uid)
+	if err != nil {
+		report.add(CopyKindCronWorkflow, uid, "", CopyStatusFailed, "Cron workflow not found.")
+		return
+	}
+
+	workflowTemplateUID, ok := workflowTemplateUIDs[source.WorkflowTemplateUID]
+	if !ok {
+		workflowTemplateUID = source.WorkflowTemplateUID
+	}
+
+	parameters, err := source.GetParametersFromWorkflowSpec()
+	if err != nil {
+		report.add(CopyKindCronWorkflow, source.Name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	manifest, err := suspendCronManifest(source.Manifest)
+	if err != nil {
+		report.add(CopyKindCronWorkflow, source.Name, "", CopyStatusFailed, err.Error())
+		return
+	}
+
+	cronWorkflow := &CronWorkflow{
+		Manifest:  manifest,
+		Namespace: targetNamespace,
+		WorkflowExecution: &WorkflowExecution{
+			WorkflowTemplate: &WorkflowTemplate{
+				UID: workflowTemplateUID,
+			},
+			Parameters: parameters,
+		},
+		Ownership: Ownership{
+			Owner:      selection.Owner,
+			Visibility: VisibilityPrivate,
+		},
+	}
+	// Users and groups it is shared with may not have access to the target namespace
+	if source.Visibility == VisibilityNamespace {
+		cronWorkflow.Visibility = VisibilityNamespace
+	}
+	if selection.Labels {
+		cronWorkflow.Labels = source.Labels
+	}
+
+	created, err := c.CreateCronWorkflow(targetNamespace, cronWorkflow)
+	if err != nil {
+		report.add(CopyKindCronWorkflow, source.Name, "", CopyStatusFailed, err.Error())
+		return
+	}
+	report.add(CopyKindCronWorkflow, source.Name, created.Name, CopyStatusCopied, "Created suspended.")
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClient_CopyResources_Secrets(t *testing.T) {
+	c := DefaultTestClient()
+
+	testCreateNamespace(c)
+	assert.Nil(t, testCreateSecretData(c, "namespace-0", "aws", map[string]string{"accessKey": "key"}))
+	assert.Nil(t, testCreateSecretData(c, "namespace-0", "gcs", map[string]string{"serviceAccountKey": "{}"}))
+	assert.Nil(t, testCreateSecretData(c, "namespace-1", "gcs", map[string]string{"serviceAccountKey": "{}"}))
+
+	// Fail copies nothing when a name is used
+	_, err := c.CopyResources("namespace-0", "namespace-1", &CopySelection{Secrets: []string{"aws", "gcs"}, OnConflict: CopyConflictFail})
+	assert.NotNil(t, err)
+	_, err = c.CoreV1().Secrets("namespace-1").Get("aws", metav1.GetOptions{})
+	assert.NotNil(t, err)
+
+	report, err := c.CopyResources("namespace-0", "namespace-1", &CopySelection{Secrets: []string{"aws", "gcs"}})
+	assert.Nil(t, err)
+	assert.Len(t, report.Results, 2)
+	assert.Equal(t, CopyStatusCopied, report.Results[0].Status)
+	assert.Equal(t, CopyStatusSkipped, report.Results[1].Status)
+
+	secret, err := c.CoreV1().Secrets("namespace-1").Get("aws", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "key", secret.StringData["accessKey"])
+
+	report, err = c.CopyResources("namespace-0", "namespace-1", &CopySelection{Secrets: []string{"gcs"}, OnConflict: CopyConflictRename})
+	assert.Nil(t, err)
+	assert.Equal(t, CopyStatusRenamed, report.Results[0].Status)
+	assert.Equal(t, "gcs-copy", report.Results[0].Target)
+
+	_, err = c.CopyResources("namespace-0", "namespace-0", &CopySelection{Secrets: []string{"aws"}})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onepanelio/core/pkg/util/mapping"
+)
+
+// CopyResources settle names that are already used in the target namespace with one of these
+const (
+	// CopyConflictSkip doesn't copy resources whose names are used, it is the default
+	CopyConflictSkip = "skip"
+	// CopyConflictRename copies resources whose names are used with a -copy suffix, e.g. train-copy-2
+	CopyConflictRename = "rename"
+	// CopyConflictFail copies nothing if any name is used
+	CopyConflictFail = "fail"
+)
+
+// Statuses of the resources in a CopyReport
+const (
+	CopyStatusCopied  = "copied"
+	CopyStatusRenamed = "renamed"
+	CopyStatusSkipped = "skipped"
+	CopyStatusFailed  = "failed"
+)
+
+// Kinds of the resources in a CopyReport
+const (
+	CopyKindSecret            = "secret"
+	CopyKindWorkflowTemplate  = "workflowtemplate"
+	CopyKindWorkspaceTemplate = "workspacetemplate"
+	CopyKindCronWorkflow      = "cronworkflow"
+)
+
+// maxCopyNames is how many -copy names are tried before a rename gives up
+const maxCopyNames = 100
+
+// CopySelection are the resources CopyResources copies from the source namespace
+type CopySelection struct {
+	// WorkflowTemplates are uids, their latest versions are copied unless AllVersions is set
+	WorkflowTemplates []string
+	// AllVersions copies every version of the workflow and workspace templates, oldest first
+	AllVersions bool
+	// WorkspaceTemplates are uids
+	WorkspaceTemplates []string
+	// CronWorkflows are uids. They are created suspended, and their workflow templates must be copied with them
+	// or already be in the target namespace with the same uid.
+	CronWorkflows []string
+	// Secrets are names. Secrets are only copied if they are listed here.
+	Secrets []string
+	// Labels copies the labels of the resources
+	Labels bool
+	// OnConflict is skip, rename or fail
+	OnConflict string
+	// Owner owns the copied cron workflows
+	Owner string
+}
+
+// Validate defaults the conflict strategy and returns an error if it is unknown or nothing is selected
+func (s *CopySelection) Validate() error {
+	s.OnConflict = strings.ToLower(strings.TrimSpace(s.OnConflict))
+	if s.OnConflict == "" {
+		s.OnConflict = CopyConflictSkip
+	}
+	switch s.OnConflict {
+	case CopyConflictSkip, CopyConflictRename, CopyConflictFail:
+	default:
+		return fmt.Errorf("unknown conflict strategy '%v', it must be skip, rename or fail", s.OnConflict)
+	}
+
+	if len(s.WorkflowTemplates)+len(s.WorkspaceTemplates)+len(s.CronWorkflows)+len(s.Secrets) == 0 {
+		return fmt.Errorf("nothing is selected to copy")
+	}
+
+	return nil
+}
+
+// CopyResult is what happened to one of the resources of a copy.
+// Source is its name in the source namespace and Target its name in the target namespace, if it was copied.
+type CopyResult struct {
+	Kind    string
+	Source  string
+	Target  string
+	Status  string
+	Message string
+}
+
+// CopyReport is what CopyResources did, in the order resources were copied
+type CopyReport struct {
+	SourceNamespace string
+	TargetNamespace string
+	Results         []*CopyResult
+}
+
+// add records the result of copying the resource named source
+func (r *CopyReport) add(kind, source, target, status, message string) {
+	r.Results = append(r.Results, &CopyResult{
+		Kind:    kind,
+		Source:  source,
+		Target:  target,
+		Status:  status,
+		Message: message,
+	})
+}
+
+// copyName returns the nth name for a copy of name, e.g. train-copy, train-copy-2
+func copyName(name string, n int) string {
+	if n == 1 {
+		return name + "-copy"
+	}
+
+	return fmt.Sprintf("%v-copy-%v", name, n)
+}
+
+// suspendCronManifest returns manifest with the schedule suspended and without its workflow spec,
+// which is generated again from the workflow template in the target namespace
+func suspendCronManifest(manifest string) (string, error) {
+	m, err := mapping.NewFromYamlString(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	m["suspend"] = true
+	delete(m, "workflowSpec")
+
+	result, err := m.ToYamlBytes()
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopySelection_Validate(t *testing.T) {
+	selection := &CopySelection{Secrets: []string{"aws"}}
+	assert.Nil(t, selection.Validate())
+	assert.Equal(t, CopyConflictSkip, selection.OnConflict)
+
+	selection = &CopySelection{WorkflowTemplates: []string{"train"}, OnConflict: " Rename "}
+	assert.Nil(t, selection.Validate())
+	assert.Equal(t, CopyConflictRename, selection.OnConflict)
+
+	assert.NotNil(t, (&CopySelection{}).Validate())
+	assert.NotNil(t, (&CopySelection{Secrets: []string{"aws"}, OnConflict: "overwrite"}).Validate())
+}
+
+func Test_copyName(t *testing.T) {
+	assert.Equal(t, "train-copy", copyName("train", 1))
+	assert.Equal(t, "train-copy-2", copyName("train", 2))
+}
+
+func Test_copyTargetName(t *testing.T) {
+	used := map[string]bool{"train": true, "train-copy": true}
+	conflict := func(namespace, name string) (bool, error) {
+		return used[name], nil
+	}
+
+	name, status, err := copyTargetName("team-a", "infer", CopyConflictSkip, conflict)
+	assert.Nil(t, err)
+	assert.Equal(t, "infer", name)
+	assert.Equal(t, CopyStatusCopied, status)
+
+	name, status, err = copyTargetName("team-a", "train", CopyConflictSkip, conflict)
+	assert.Nil(t, err)
+	assert.Equal(t, "", name)
+	assert.Equal(t, CopyStatusSkipped, status)
+
+	name, status, err = copyTargetName("team-a", "train", CopyConflictRename, conflict)
+	assert.Nil(t, err)
+	assert.Equal(t, "train-copy-2", name)
+	assert.Equal(t, CopyStatusRenamed, status)
+}
+
+func Test_suspendCronManifest(t *testing.T) {
+	manifest, err := suspendCronManifest(`schedule: "0 * * * *"
+suspend: false
+workflowSpec:
+  entrypoint: main
+`)
+	assert.Nil(t, err)
+	assert.Contains(t, manifest, "suspend: true")
+	assert.Contains(t, manifest, "schedule: 0 * * * *")
+	assert.False(t, strings.Contains(manifest, "workflowSpec"))
+}
+
+func Test_decodeSecretData(t *testing.T) {
+	data, err := decodeSecretData(map[string]string{"key": "dmFsdWU="})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"key": "value"}, data)
+
+	_, err = decodeSecretData(map[string]string{"key": "not base64"})
+	assert.NotNil(t, err)
+}
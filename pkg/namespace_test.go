@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -32,3 +33,120 @@ func TestClient_ListNamespace(t *testing.T) {
 	assert.NotEmpty(t, n)
 	assert.Equal(t, len(n), 5)
 }
+
+func TestClient_BootstrapNamespace(t *testing.T) {
+	c := DefaultTestClient()
+
+	testCreateNamespace(c)
+	_, err := c.CoreV1().ConfigMaps("namespace-0").Create(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "onepanel"},
+		Data:       map[string]string{"artifactRepository": "s3: {}"},
+	})
+	assert.Nil(t, err)
+	_, err = c.CoreV1().Secrets("namespace-0").Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "onepanel"},
+		Data:       map[string][]byte{"artifactRepositoryS3AccessKey": []byte("key")},
+	})
+	assert.Nil(t, err)
+	_, err = c.RbacV1().RoleBindings("namespace-0").Create(&rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "onepanel"},
+		Subjects: []rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Name: "default", Namespace: "namespace-0"},
+			{Kind: rbacv1.UserKind, Name: "alice"},
+		},
+		RoleRef: rbacv1.RoleRef{Kind: "ClusterRole", Name: "onepanel"},
+	})
+	assert.Nil(t, err)
+	_, err = c.RbacV1().RoleBindings("namespace-0").Create(&rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "users"},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.GroupKind, Name: "admins"}},
+		RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "admin"},
+	})
+	assert.Nil(t, err)
+
+	bootstrap := &NamespaceBootstrap{
+		SourceNamespace: "namespace-0",
+		ResourceQuota:   map[string]string{"requests.nvidia.com/gpu": "4"},
+	}
+	result, err := c.BootstrapNamespace("namespace-1", bootstrap)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"configmap/onepanel", "secret/onepanel", "k8srolebinding/onepanel", "resourcequota/onepanel"}, result.Created)
+	assert.Empty(t, result.Skipped)
+
+	secret, err := c.CoreV1().Secrets("namespace-1").Get("onepanel", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("key"), secret.Data["artifactRepositoryS3AccessKey"])
+
+	roleBinding, err := c.RbacV1().RoleBindings("namespace-1").Get("onepanel", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, roleBinding.Subjects, 1)
+	assert.Equal(t, "namespace-1", roleBinding.Subjects[0].Namespace)
+	_, err = c.RbacV1().RoleBindings("namespace-1").Get("users", metav1.GetOptions{})
+	assert.NotNil(t, err)
+
+	quota, err := c.CoreV1().ResourceQuotas("namespace-1").Get("onepanel", metav1.GetOptions{})
+	assert.Nil(t, err)
+	gpus := quota.Spec.Hard["requests.nvidia.com/gpu"]
+	assert.Equal(t, "4", gpus.String())
+
+	// Bootstrapping again skips what exists
+	result, err = c.BootstrapNamespace("namespace-1", bootstrap)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"resourcequota/onepanel"}, result.Created)
+	assert.Equal(t, []string{"configmap/onepanel", "secret/onepanel", "k8srolebinding/onepanel"}, result.Skipped)
+
+	// Users and groups are only bound if asked for
+	result, err = c.BootstrapNamespace("namespace-2", &NamespaceBootstrap{SourceNamespace: "namespace-0", CopyRoleBindingUsers: true})
+	assert.Nil(t, err)
+	assert.Contains(t, result.Created, "k8srolebinding/users")
+	roleBinding, err = c.RbacV1().RoleBindings("namespace-2").Get("onepanel", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Len(t, roleBinding.Subjects, 2)
+	assert.Equal(t, "alice", roleBinding.Subjects[1].Name)
+
+	_, err = c.BootstrapNamespace("namespace-1", &NamespaceBootstrap{SourceNamespace: "namespace-1"})
+	assert.NotNil(t, err)
+	_, err = c.BootstrapNamespace("missing", &NamespaceBootstrap{})
+	assert.NotNil(t, err)
+}
+
+func TestClient_DeleteNamespace_Refused(t *testing.T) {
+	c := DefaultTestClient()
+
+	_, err := c.CoreV1().Namespaces().Create(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "not-onepanel"},
+	})
+	assert.Nil(t, err)
+
+	for _, name := range []string{"onepanel", "default", "kube-system", "not-onepanel"} {
+		_, err := c.DeleteNamespace(name, true)
+		assert.NotNil(t, err, name)
+	}
+
+	_, err = c.CoreV1().Namespaces().Get("not-onepanel", metav1.GetOptions{})
+	assert.Nil(t, err)
+}
+
+func TestClient_DeleteNamespace(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	testCreateNamespace(c)
+	_, err := c.CreateRoleBinding("namespace-2", &RoleBinding{SubjectKind: RoleSubjectUser, SubjectName: "alice", Role: "admin"})
+	assert.Nil(t, err)
+
+	report, err := c.DeleteNamespace("namespace-2", true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), report.Records["role_bindings"])
+	_, err = c.CoreV1().Namespaces().Get("namespace-2", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	_, err = c.DeleteNamespace("namespace-2", false)
+	assert.Nil(t, err)
+	_, err = c.CoreV1().Namespaces().Get("namespace-2", metav1.GetOptions{})
+	assert.NotNil(t, err)
+
+	bindings, err := c.ListRoleBindings("namespace-2")
+	assert.Nil(t, err)
+	assert.Empty(t, bindings)
+}
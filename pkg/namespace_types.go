@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// systemNamespace has the configuration of Onepanel itself, it can't be deleted
+const systemNamespace = "onepanel"
+
+// NamespaceBootstrap is what a namespace is set up with so workflows and workspaces can run in it
+type NamespaceBootstrap struct {
+	// SourceNamespace is copied from: its onepanel config map and secret, which have the artifact repository and
+	// its credentials, and its Kubernetes roles and role bindings. Nothing is copied if it is empty.
+	SourceNamespace string
+	// CopyTemplates copies the latest versions of the workflow and workspace templates of SourceNamespace
+	CopyTemplates bool
+	// CopyRoleBindingUsers keeps the users, groups and other namespaces' service accounts that the copied role bindings
+	// bind. By default only the service accounts of SourceNamespace are bound, to the service accounts of the namespace.
+	CopyRoleBindingUsers bool
+	// RoleBindings are Onepanel roles to bind in the namespace, e.g. admin to its owner
+	RoleBindings []*RoleBinding
+	// ResourceQuota are the hard limits of the namespace, e.g. requests.nvidia.com/gpu: 4
+	ResourceQuota map[string]string
+}
+
+// NamespaceBootstrapResult are the resources a bootstrap created, and the ones that already existed.
+// Resources are kind/name, e.g. workflowtemplate/train.
+type NamespaceBootstrapResult struct {
+	Created []string
+	Skipped []string
+}
+
+// add records that the resource was created, or that it was skipped because it exists
+func (r *NamespaceBootstrapResult) add(kind, name string, created bool) {
+	if created {
+		r.Created = append(r.Created, kind+"/"+name)
+	} else {
+		r.Skipped = append(r.Skipped, kind+"/"+name)
+	}
+}
+
+// Validate returns an error if the source is the system namespace, or a quota is not a valid quantity
+func (b *NamespaceBootstrap) Validate() error {
+	if b.SourceNamespace == systemNamespace {
+		return fmt.Errorf("the %v namespace can't be copied", systemNamespace)
+	}
+	if _, err := b.resourceQuotaHard(); err != nil {
+		return err
+	}
+	for _, binding := range b.RoleBindings {
+		if err := binding.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceQuotaHard returns the limits of the resource quota
+func (b *NamespaceBootstrap) resourceQuotaHard() (corev1.ResourceList, error) {
+	hard := corev1.ResourceList{}
+	for name, value := range b.ResourceQuota {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("quota of %v is not a valid quantity: %v", name, value)
+		}
+		hard[corev1.ResourceName(name)] = quantity
+	}
+
+	return hard, nil
+}
+
+// NamespaceDeletionReport is what deleting a namespace destroys.
+// Audit events are kept, and API tokens lose access to the namespace.
+type NamespaceDeletionReport struct {
+	Namespace string
+	DryRun    bool
+	// Resources are the number of Kubernetes resources deleted with the namespace, by kind
+	Resources map[string]int64
+	// Volumes are the persistent volume claims deleted with the namespace and their sizes, their data is lost
+	Volumes []string
+	// Records are the number of database rows deleted, by table. api_tokens are the tokens that lose access.
+	Records map[string]int64
+}
+
+// newNamespaceDeletionReport returns an empty report
+func newNamespaceDeletionReport(namespace string, dryRun bool) *NamespaceDeletionReport {
+	return &NamespaceDeletionReport{
+		Namespace: namespace,
+		DryRun:    dryRun,
+		Resources: make(map[string]int64),
+		Volumes:   make([]string, 0),
+		Records:   make(map[string]int64),
+	}
+}
+
+// addVolumes records the persistent volume claims and their requested sizes, in order
+func (r *NamespaceDeletionReport) addVolumes(claims []corev1.PersistentVolumeClaim) {
+	for _, claim := range claims {
+		size := claim.Spec.Resources.Requests[corev1.ResourceStorage]
+		r.Volumes = append(r.Volumes, fmt.Sprintf("%v (%v)", claim.Name, size.String()))
+	}
+	sort.Strings(r.Volumes)
+	r.Resources["persistentvolumeclaims"] = int64(len(claims))
+}
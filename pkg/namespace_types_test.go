@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceBootstrap_Validate(t *testing.T) {
+	assert.Nil(t, (&NamespaceBootstrap{}).Validate())
+	assert.Nil(t, (&NamespaceBootstrap{
+		SourceNamespace: "team-a",
+		ResourceQuota:   map[string]string{"requests.nvidia.com/gpu": "4", "requests.memory": "64Gi"},
+		RoleBindings:    []*RoleBinding{{SubjectKind: RoleSubjectUser, SubjectName: "alice", Role: "admin"}},
+	}).Validate())
+
+	assert.NotNil(t, (&NamespaceBootstrap{SourceNamespace: systemNamespace}).Validate())
+	assert.NotNil(t, (&NamespaceBootstrap{ResourceQuota: map[string]string{"requests.cpu": "a lot"}}).Validate())
+	assert.NotNil(t, (&NamespaceBootstrap{
+		RoleBindings: []*RoleBinding{{SubjectKind: RoleSubjectUser, SubjectName: "alice", Role: "owner"}},
+	}).Validate())
+}
+
+func TestNamespaceBootstrapResult_add(t *testing.T) {
+	result := &NamespaceBootstrapResult{}
+	result.add("configmap", "onepanel", true)
+	result.add("secret", "onepanel", false)
+
+	assert.Equal(t, []string{"configmap/onepanel"}, result.Created)
+	assert.Equal(t, []string{"secret/onepanel"}, result.Skipped)
+}
+
+func TestNamespaceDeletionReport_addVolumes(t *testing.T) {
+	report := newNamespaceDeletionReport("team-a", true)
+	report.addVolumes([]corev1.PersistentVolumeClaim{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "data-jupyter-0"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("20Gi")},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "cache"},
+		},
+	})
+
+	assert.Equal(t, []string{"cache (0)", "data-jupyter-0 (20Gi)"}, report.Volumes)
+	assert.Equal(t, int64(2), report.Resources["persistentvolumeclaims"])
+}
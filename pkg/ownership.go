@@ -0,0 +1,92 @@
+package v1
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+)
+
+// OwnedResources are the tables of the resources that have owners, by the resource of their API methods
+var OwnedResources = map[string]string{
+	"workspaces":    "workspaces",
+	"workflows":     "workflow_executions",
+	"cronworkflows": "cron_workflows",
+}
+
+// ownedResource is the ownership of a resource and the id of its row
+type ownedResource struct {
+	ID uint64
+	Ownership
+}
+
+// getOwnedResource returns the latest resource with uid, workspace uids can be reused once they are terminated
+func (c *Client) getOwnedResource(resource, namespace, uid string) (*ownedResource, error) {
+	table, ok := OwnedResources[resource]
+	if !ok {
+		return nil, fmt.Errorf("resource '%v' does not have owners", resource)
+	}
+
+	query := sb.Select(append([]string{"id"}, ownershipColumns...)...).
+		From(table).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		OrderBy("id DESC").
+		Limit(1)
+
+	result := &ownedResource{}
+	if err := c.DB.Getx(result, query); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, util.NewUserError(codes.NotFound, "Resource not found.")
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetOwnership returns the ownership of the resource, e.g. workspaces, with uid
+func (c *Client) GetOwnership(resource, namespace, uid string) (*Ownership, error) {
+	result, err := c.getOwnedResource(resource, namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Ownership, nil
+}
+
+// UpdateSharing changes the visibility, and the users and groups, of the resource with uid.
+// The owner can't be changed.
+func (c *Client) UpdateSharing(resource, namespace, uid string, ownership *Ownership) (*Ownership, error) {
+	if err := ownership.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := c.getOwnedResource(resource, namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = sb.Update(OwnedResources[resource]).
+		SetMap(sq.Eq{
+			"visibility":    ownership.Visibility,
+			"shared_users":  ownership.SharedUsers,
+			"shared_groups": ownership.SharedGroups,
+		}).
+		Where(sq.Eq{"id": result.ID}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	result.Visibility = ownership.Visibility
+	result.SharedUsers = ownership.SharedUsers
+	result.SharedGroups = ownership.SharedGroups
+
+	return &result.Ownership, nil
+}
@@ -0,0 +1,176 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+const (
+	// VisibilityPrivate resources can only be seen and changed by their owner, and namespace admins
+	VisibilityPrivate = "private"
+	// VisibilityShared resources can also be seen and changed by the users and groups they are shared with
+	VisibilityShared = "shared"
+	// VisibilityNamespace resources can be seen and changed by anyone that can access the namespace.
+	// Resources created before they had owners have this visibility.
+	VisibilityNamespace = "namespace"
+
+	// OwnershipFilterMine lists the resources owned by the caller
+	OwnershipFilterMine = "mine"
+	// OwnershipFilterShared lists the resources other users have shared with the caller, or one of their groups
+	OwnershipFilterShared = "shared"
+)
+
+// Ownership is the user that created a resource and who else can see and change it
+type Ownership struct {
+	Owner        string
+	Visibility   string
+	SharedUsers  pq.StringArray `db:"shared_users"`
+	SharedGroups pq.StringArray `db:"shared_groups"`
+}
+
+// ownershipColumns are the columns of Ownership in the tables of resources that have owners
+var ownershipColumns = []string{"owner", "visibility", "shared_users", "shared_groups"}
+
+// normalize defaults the visibility to namespace and removes blank and duplicate users and groups
+func (o *Ownership) normalize() {
+	o.Visibility = strings.ToLower(strings.TrimSpace(o.Visibility))
+	if o.Visibility == "" {
+		o.Visibility = VisibilityNamespace
+	}
+
+	o.SharedUsers = uniqueStrings(o.SharedUsers)
+	o.SharedGroups = uniqueStrings(o.SharedGroups)
+}
+
+// Validate defaults the visibility and returns an error if it is unknown, or the users and groups don't match it.
+// Only shared resources have users and groups, and they must have at least one.
+func (o *Ownership) Validate() error {
+	o.normalize()
+
+	switch o.Visibility {
+	case VisibilityPrivate, VisibilityNamespace:
+		if len(o.SharedUsers) != 0 || len(o.SharedGroups) != 0 {
+			return fmt.Errorf("only shared resources can be shared with users or groups")
+		}
+	case VisibilityShared:
+		if len(o.SharedUsers) == 0 && len(o.SharedGroups) == 0 {
+			return fmt.Errorf("shared resources must be shared with at least one user or group")
+		}
+	default:
+		return fmt.Errorf("unknown visibility '%v', it must be private, shared or namespace", o.Visibility)
+	}
+
+	return nil
+}
+
+// IsOwner returns true if username owns the resource
+func (o *Ownership) IsOwner(username string) bool {
+	return o.Owner != "" && o.Owner == username
+}
+
+// CanAccess returns true if the user, or one of their groups, can see and change the resource
+func (o *Ownership) CanAccess(username string, groups []string) bool {
+	switch o.Visibility {
+	case VisibilityPrivate:
+		return o.IsOwner(username)
+	case VisibilityShared:
+		if o.IsOwner(username) || containsString(o.SharedUsers, username) {
+			return true
+		}
+		for _, group := range groups {
+			if containsString(o.SharedGroups, group) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// OwnershipFilter limits a list of resources to the ones the user can access
+type OwnershipFilter struct {
+	Username string
+	Groups   []string
+	// Mine only includes the resources owned by the user
+	Mine bool
+	// SharedWithMe only includes the resources other users shared with the user, or one of their groups
+	SharedWithMe bool
+	// Admin includes the private resources of other users, namespace admins can access all resources
+	Admin bool
+}
+
+// NewOwnershipFilter returns a filter for the user from an API filter value, which is empty, mine or shared
+func NewOwnershipFilter(value, username string, groups []string, admin bool) (*OwnershipFilter, error) {
+	filter := &OwnershipFilter{
+		Username: username,
+		Groups:   groups,
+		Admin:    admin,
+	}
+
+	switch value {
+	case "":
+	case OwnershipFilterMine:
+		filter.Mine = true
+	case OwnershipFilterShared:
+		filter.SharedWithMe = true
+	default:
+		return nil, fmt.Errorf("unknown ownership filter '%v', it must be mine or shared", value)
+	}
+
+	return filter, nil
+}
+
+// apply adds the conditions of the filter to sb, for the table with alias
+func (f *OwnershipFilter) apply(sb sq.SelectBuilder, alias string) sq.SelectBuilder {
+	column := func(name string) string {
+		return alias + "." + name
+	}
+
+	groups := pq.StringArray(f.Groups)
+	if groups == nil {
+		groups = pq.StringArray{}
+	}
+	sharedWithUser := sq.And{
+		sq.Eq{column("visibility"): VisibilityShared},
+		sq.Or{
+			sq.Expr("? = ANY("+column("shared_users")+")", f.Username),
+			sq.Expr(column("shared_groups")+" && ?", groups),
+		},
+	}
+
+	if f.Mine {
+		sb = sb.Where(sq.Eq{column("owner"): f.Username})
+	}
+	if f.SharedWithMe {
+		sb = sb.Where(sq.And{
+			sq.NotEq{column("owner"): f.Username},
+			sharedWithUser,
+		})
+	}
+	if !f.Admin {
+		sb = sb.Where(sq.Or{
+			sq.Eq{column("visibility"): VisibilityNamespace},
+			sq.Eq{column("owner"): f.Username},
+			sharedWithUser,
+		})
+	}
+
+	return sb
+}
+
+// uniqueStrings returns the non-blank values, trimmed, without duplicates and in their original order
+func uniqueStrings(values []string) []string {
+	result := make([]string, 0)
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !containsString(result, value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
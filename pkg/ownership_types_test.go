@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnership_Validate(t *testing.T) {
+	ownership := &Ownership{Owner: "jane"}
+	assert.Nil(t, ownership.Validate())
+	assert.Equal(t, VisibilityNamespace, ownership.Visibility)
+
+	ownership = &Ownership{Owner: "jane", Visibility: " Shared ", SharedUsers: []string{"bob", " bob", ""}}
+	assert.Nil(t, ownership.Validate())
+	assert.Equal(t, VisibilityShared, ownership.Visibility)
+	assert.Equal(t, []string{"bob"}, []string(ownership.SharedUsers))
+	assert.Equal(t, []string{}, []string(ownership.SharedGroups))
+
+	assert.NotNil(t, (&Ownership{Visibility: VisibilityShared}).Validate())
+	assert.NotNil(t, (&Ownership{Visibility: VisibilityPrivate, SharedGroups: []string{"team"}}).Validate())
+	assert.NotNil(t, (&Ownership{Visibility: "public"}).Validate())
+}
+
+func TestOwnership_CanAccess(t *testing.T) {
+	private := &Ownership{Owner: "jane", Visibility: VisibilityPrivate}
+	assert.True(t, private.CanAccess("jane", nil))
+	assert.False(t, private.CanAccess("bob", nil))
+	assert.False(t, (&Ownership{Visibility: VisibilityPrivate}).CanAccess("", nil))
+
+	shared := &Ownership{Owner: "jane", Visibility: VisibilityShared, SharedUsers: []string{"bob"}, SharedGroups: []string{"team"}}
+	assert.True(t, shared.CanAccess("jane", nil))
+	assert.True(t, shared.CanAccess("bob", nil))
+	assert.True(t, shared.CanAccess("alice", []string{"other", "team"}))
+	assert.False(t, shared.CanAccess("alice", []string{"other"}))
+
+	assert.True(t, (&Ownership{Owner: "jane", Visibility: VisibilityNamespace}).CanAccess("bob", nil))
+}
+
+func TestNewOwnershipFilter(t *testing.T) {
+	filter, err := NewOwnershipFilter("", "jane", nil, false)
+	assert.Nil(t, err)
+	assert.False(t, filter.Mine)
+	assert.False(t, filter.SharedWithMe)
+
+	filter, err = NewOwnershipFilter(OwnershipFilterMine, "jane", nil, false)
+	assert.Nil(t, err)
+	assert.True(t, filter.Mine)
+
+	filter, err = NewOwnershipFilter(OwnershipFilterShared, "jane", nil, true)
+	assert.Nil(t, err)
+	assert.True(t, filter.SharedWithMe)
+	assert.True(t, filter.Admin)
+
+	_, err = NewOwnershipFilter("everything", "jane", nil, false)
+	assert.NotNil(t, err)
+}
+
+func TestOwnershipFilter_apply(t *testing.T) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).Select("w.id").From("workspaces w")
+
+	sql, args, err := (&OwnershipFilter{Username: "jane", Admin: true}).apply(query, "w").ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT w.id FROM workspaces w", sql)
+	assert.Empty(t, args)
+
+	sql, _, err = (&OwnershipFilter{Username: "jane"}).apply(query, "w").ToSql()
+	assert.Nil(t, err)
+	assert.Contains(t, sql, "w.visibility = $1 OR w.owner = $2")
+	assert.Contains(t, sql, "$4 = ANY(w.shared_users) OR w.shared_groups && $5")
+
+	sql, args, err = (&OwnershipFilter{Username: "jane", Mine: true, Admin: true}).apply(query, "w").ToSql()
+	assert.Nil(t, err)
+	assert.Equal(t, "SELECT w.id FROM workspaces w WHERE w.owner = $1", sql)
+	assert.Equal(t, []interface{}{"jane"}, args)
+
+	sql, _, err = (&OwnershipFilter{Username: "jane", SharedWithMe: true, Admin: true}).apply(query, "w").ToSql()
+	assert.Nil(t, err)
+	assert.Contains(t, sql, "w.owner <> $1")
+}
@@ -0,0 +1,231 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+
+	sq "github.com/Masterminds/squirrel"
+	log "github.com/sirupsen/logrus"
+)
+
+// defaultParameterSourceLimit is the maximum number of options loaded for a dynamic parameter if the source has no limit
+const defaultParameterSourceLimit = 100
+
+// replaceDynamicParameterOptions fills in the options of dynamic select parameters, like select.secret,
+// from their source and returns the new parameters with the change.
+// workflowTemplateUID is the template the parameters belong to, it may be empty.
+//
+// Failing to load options for a parameter does not fail the whole operation, the parameter is left without options
+// so the template can still be viewed and edited.
+func (c *Client) replaceDynamicParameterOptions(namespace, workflowTemplateUID string, parameters []Parameter) (result []Parameter) {
+	for i := range parameters {
+		param := parameters[i]
+		if !IsDynamicParameterType(param.Type) {
+			result = append(result, param)
+			continue
+		}
+
+		options, err := c.getDynamicParameterOptions(namespace, workflowTemplateUID, &param)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Parameter": param.Name,
+				"Type":      param.Type,
+				"Error":     err.Error(),
+			}).Error("Unable to load parameter options.")
+			options = make([]*ParameterOption, 0)
+		}
+
+		param.Options = options
+
+		result = append(result, param)
+	}
+
+	return
+}
+
+// ResolveWorkflowTemplateParameterOptions fills in the options of the dynamic select parameters of workflowTemplate.
+// It looks up secrets, artifacts and executions, so it is only meant for templates that are shown to users,
+// not for the ones executions are created from.
+func (c *Client) ResolveWorkflowTemplateParameterOptions(namespace string, workflowTemplate *WorkflowTemplate) {
+	workflowTemplate.Parameters = c.replaceDynamicParameterOptions(namespace, workflowTemplate.UID, workflowTemplate.Parameters)
+}
+
+// ResolveWorkspaceTemplateParameterOptions fills in the options of the dynamic select parameters of workspaceTemplate,
+// like ResolveWorkflowTemplateParameterOptions.
+func (c *Client) ResolveWorkspaceTemplateParameterOptions(namespace string, workspaceTemplate *WorkspaceTemplate) error {
+	return workspaceTemplate.UpdateParameters(func(parameters []Parameter) []Parameter {
+		return c.replaceDynamicParameterOptions(namespace, "", parameters)
+	})
+}
+
+// getDynamicParameterOptions loads the options for a single dynamic parameter based on its type
+func (c *Client) getDynamicParameterOptions(namespace, workflowTemplateUID string, param *Parameter) ([]*ParameterOption, error) {
+	source := param.Source
+	if source == nil {
+		source = &ParameterSource{}
+	}
+
+	limit := source.Limit
+	if limit == 0 {
+		limit = defaultParameterSourceLimit
+	}
+
+	switch param.Type {
+	case ParameterTypeSelectSecret:
+		return c.getSecretParameterOptions(namespace, limit)
+	case ParameterTypeSelectWorkflowExecution:
+		uid := source.WorkflowTemplateUID
+		if uid == "" {
+			uid = workflowTemplateUID
+		}
+		if uid == "" {
+			return nil, fmt.Errorf("parameter '%v' has no workflow template to list executions from", param.Name)
+		}
+		return c.getWorkflowExecutionParameterOptions(namespace, uid, source.Phase, limit)
+	case ParameterTypeSelectArtifact:
+		return c.getArtifactParameterOptions(namespace, source.Prefix, limit)
+	case ParameterTypeSelectLabelValue:
+		return c.getLabelValueParameterOptions(namespace, source.Resource, source.Key, limit)
+	}
+
+	return nil, fmt.Errorf("unsupported dynamic parameter type '%v'", param.Type)
+}
+
+// getSecretParameterOptions returns the names of the secrets in the namespace as options
+func (c *Client) getSecretParameterOptions(namespace string, limit uint64) ([]*ParameterOption, error) {
+	secrets, err := c.ListSecrets(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*ParameterOption, 0)
+	for _, secret := range secrets {
+		options = append(options, &ParameterOption{
+			Name:  secret.Name,
+			Value: secret.Name,
+		})
+	}
+
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Name < options[j].Name
+	})
+
+	if uint64(len(options)) > limit {
+		options = options[:limit]
+	}
+
+	return options, nil
+}
+
+// getWorkflowExecutionParameterOptions returns the most recent executions of a workflow template as options.
+// The option value is the uid of the execution.
+func (c *Client) getWorkflowExecutionParameterOptions(namespace, workflowTemplateUID, phase string, limit uint64) ([]*ParameterOption, error) {
+	sb := sb.Select("we.uid", "we.name").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON we.workflow_template_version_id = wtv.id").
+		Join("workflow_templates wt ON wtv.workflow_template_id = wt.id").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.is_archived": false,
+			"wt.uid":         workflowTemplateUID,
+		}).
+		OrderBy("we.created_at DESC").
+		Limit(limit)
+
+	if phase != "" {
+		sb = sb.Where(sq.Eq{"we.phase": phase})
+	}
+
+	executions := make([]*WorkflowExecution, 0)
+	if err := c.DB.Selectx(&executions, sb); err != nil {
+		return nil, err
+	}
+
+	options := make([]*ParameterOption, 0)
+	for _, execution := range executions {
+		options = append(options, &ParameterOption{
+			Name:  execution.Name,
+			Value: execution.UID,
+		})
+	}
+
+	return options, nil
+}
+
+// getArtifactParameterOptions returns the files and directories directly under prefix in the namespace's
+// artifact repository as options. The option value is the full key of the file.
+func (c *Client) getArtifactParameterOptions(namespace, prefix string, limit uint64) ([]*ParameterOption, error) {
+	files, err := c.ListFiles(namespace, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*ParameterOption, 0)
+	for _, file := range files {
+		if uint64(len(options)) >= limit {
+			break
+		}
+
+		name := file.Name
+		if file.Directory {
+			name += "/"
+		}
+
+		options = append(options, &ParameterOption{
+			Name:  name,
+			Value: file.Path,
+		})
+	}
+
+	return options, nil
+}
+
+// getLabelValueParameterOptions returns the distinct values of the label key on the resource as options
+func (c *Client) getLabelValueParameterOptions(namespace, resource, key string, limit uint64) ([]*ParameterOption, error) {
+	if key == "" {
+		return nil, fmt.Errorf("label key is required")
+	}
+
+	if resource == "" {
+		resource = TypeWorkflowExecution
+	}
+
+	switch resource {
+	case TypeWorkflowTemplate, TypeWorkflowExecution, TypeCronWorkflow, TypeWorkspaceTemplate, TypeWorkspace:
+	default:
+		return nil, fmt.Errorf("unsupported label resource '%v'", resource)
+	}
+
+	labels, err := c.ListAvailableLabels(&SelectLabelsQuery{
+		Table:     TypeToTableName(resource),
+		Alias:     "l",
+		Namespace: namespace,
+		KeyLike:   key,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*ParameterOption, 0)
+	for _, label := range labels {
+		if label.Key != key {
+			continue
+		}
+
+		options = append(options, &ParameterOption{
+			Name:  label.Value,
+			Value: label.Value,
+		})
+	}
+
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Name < options[j].Name
+	})
+
+	if uint64(len(options)) > limit {
+		options = options[:limit]
+	}
+
+	return options, nil
+}
@@ -0,0 +1,391 @@
+package v1
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DefaultResourceUsageSampleInterval is how often the resource usage of running pods is sampled
+const DefaultResourceUsageSampleInterval = 30 * time.Second
+
+const (
+	resourceUsageWorkflowLabel      = "workflows.argoproj.io/workflow"
+	resourceUsageWorkspaceSelector  = "onepanel.io/entity-type=Workspace"
+	resourceUsageWorkspaceUIDLabel  = "onepanel.io/entity-uid"
+	resourceUsageRunningPodSelector = "status.phase=Running"
+	resourceUsagePodMetricsPath     = "/apis/metrics.k8s.io/v1beta1/pods"
+)
+
+// resourceUsageOwner is the workflow execution or workspace that a sampled pod belongs to
+type resourceUsageOwner struct {
+	Namespace string
+	UID       string
+}
+
+// resourceUsagePodSample is a sample of a pod's resource usage, along with the pod
+type resourceUsagePodSample struct {
+	Pod    *corev1.Pod
+	Sample *ResourceUsageSample
+}
+
+// listPodMetrics returns the CPU and memory usage of the pods in all namespaces from the metrics API
+func (c *Client) listPodMetrics() ([]podMetrics, error) {
+	data, err := c.Discovery().RESTClient().Get().
+		AbsPath(resourceUsagePodMetricsPath).
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	list := &podMetricsList{}
+	if err := json.Unmarshal(data, list); err != nil {
+		return nil, err
+	}
+
+	return list.Items, nil
+}
+
+// getNodeStatsSummary returns the stats summary of the kubelet of a node, through the API server's node proxy.
+// The kubelet reports the usage of NVIDIA GPUs per container, which the metrics API does not.
+func (c *Client) getNodeStatsSummary(nodeName string) (*kubeletStatsSummary, error) {
+	data, err := c.CoreV1().RESTClient().Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix("stats/summary").
+		DoRaw()
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &kubeletStatsSummary{}
+	if err := json.Unmarshal(data, summary); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// listResourceUsagePods returns the running pods of workflow executions and workspaces in all namespaces
+func (c *Client) listResourceUsagePods() ([]corev1.Pod, error) {
+	pods := make([]corev1.Pod, 0)
+	for _, selector := range []string{resourceUsageWorkflowLabel, resourceUsageWorkspaceSelector} {
+		list, err := c.CoreV1().Pods(metav1.NamespaceAll).List(metav1.ListOptions{
+			LabelSelector: selector,
+			FieldSelector: resourceUsageRunningPodSelector,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, list.Items...)
+	}
+
+	return pods, nil
+}
+
+// resourceUsageSamples matches pods to their metrics, and to their stats in the summaries of the nodes they run on,
+// keyed by node name. Pods without metrics, like those that just started, are left out.
+func resourceUsageSamples(pods []corev1.Pod, metrics []podMetrics, summaries map[string]*kubeletStatsSummary, timestamp time.Time) []*resourceUsagePodSample {
+	metricsByPod := make(map[string]*podMetrics, len(metrics))
+	for i := range metrics {
+		metricsByPod[metrics[i].Metadata.Namespace+"/"+metrics[i].Metadata.Name] = &metrics[i]
+	}
+
+	samples := make([]*resourceUsagePodSample, 0)
+	for i := range pods {
+		pod := &pods[i]
+		podMetrics, ok := metricsByPod[pod.Namespace+"/"+pod.Name]
+		if !ok {
+			continue
+		}
+
+		sample := resourceUsageSampleFromPodMetrics(podMetrics, timestamp)
+		if summary := summaries[pod.Spec.NodeName]; summary != nil {
+			for j := range summary.Pods {
+				stats := &summary.Pods[j]
+				if stats.PodRef.Namespace == pod.Namespace && stats.PodRef.Name == pod.Name {
+					addPodStatsGPUUsage(sample, stats)
+					break
+				}
+			}
+		}
+
+		samples = append(samples, &resourceUsagePodSample{
+			Pod:    pod,
+			Sample: sample,
+		})
+	}
+
+	return samples
+}
+
+// addWorkflowExecutionResourceUsageSamples adds the samples of the pods of a workflow execution to its usage.
+// Argo names the pod of a node after the node id.
+func addWorkflowExecutionResourceUsageSamples(usage WorkflowExecutionResourceUsage, samples []*resourceUsagePodSample) {
+	for _, sample := range samples {
+		nodeUsage, ok := usage[sample.Pod.Name]
+		if !ok {
+			nodeUsage = &ResourceUsage{}
+			usage[sample.Pod.Name] = nodeUsage
+		}
+
+		nodeUsage.SetResources(sample.Pod)
+		nodeUsage.AddSample(sample.Sample)
+	}
+}
+
+// addWorkspaceResourceUsageSamples adds the samples of the pods of a workspace to its usage.
+// The pods are summed, so the usage is that of the whole workspace.
+func addWorkspaceResourceUsageSamples(usage *ResourceUsage, samples []*resourceUsagePodSample) {
+	if len(samples) == 0 {
+		return
+	}
+
+	total := &ResourceUsageSample{
+		Timestamp: samples[0].Sample.Timestamp,
+	}
+	gpuUtilization := 0.0
+	gpuSamples := 0
+	for _, sample := range samples {
+		total.CPU += sample.Sample.CPU
+		total.Memory += sample.Sample.Memory
+		total.GPUMemory += sample.Sample.GPUMemory
+		if sample.Sample.GPUUtilization != nil {
+			gpuUtilization += *sample.Sample.GPUUtilization
+			gpuSamples++
+		}
+	}
+	if gpuSamples > 0 {
+		gpuUtilization /= float64(gpuSamples)
+		total.GPUUtilization = &gpuUtilization
+	}
+
+	usage.SetResources(samples[0].Pod)
+	usage.AddSample(total)
+}
+
+// SampleResourceUsage samples the CPU, memory and GPU usage of the running pods of workflow executions and workspaces,
+// and adds the samples to their stored usage
+func (c *Client) SampleResourceUsage() error {
+	pods, err := c.listResourceUsagePods()
+	if err != nil {
+		return err
+	}
+
+	metrics, err := c.listPodMetrics()
+	if err != nil {
+		return err
+	}
+
+	// The metrics API doesn't report GPU usage, so the kubelet is asked for it, only for nodes running pods with GPUs.
+	// GPU usage is left out if the node proxy can't be used.
+	summaries := make(map[string]*kubeletStatsSummary)
+	for i := range pods {
+		pod := &pods[i]
+		if _, ok := summaries[pod.Spec.NodeName]; ok || pod.Spec.NodeName == "" || !podRequestsGPU(pod) {
+			continue
+		}
+
+		summary, err := c.getNodeStatsSummary(pod.Spec.NodeName)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Node":  pod.Spec.NodeName,
+				"Error": err.Error(),
+			}).Error("Unable to get node stats summary.")
+		}
+		summaries[pod.Spec.NodeName] = summary
+	}
+
+	workflowSamples := make(map[resourceUsageOwner][]*resourceUsagePodSample)
+	workspaceSamples := make(map[resourceUsageOwner][]*resourceUsagePodSample)
+	for _, sample := range resourceUsageSamples(pods, metrics, summaries, time.Now()) {
+		if uid, ok := sample.Pod.Labels[resourceUsageWorkflowLabel]; ok {
+			owner := resourceUsageOwner{Namespace: sample.Pod.Namespace, UID: uid}
+			workflowSamples[owner] = append(workflowSamples[owner], sample)
+		} else if uid, ok := sample.Pod.Labels[resourceUsageWorkspaceUIDLabel]; ok {
+			owner := resourceUsageOwner{Namespace: sample.Pod.Namespace, UID: uid}
+			workspaceSamples[owner] = append(workspaceSamples[owner], sample)
+		}
+	}
+
+	for owner, samples := range workflowSamples {
+		if err := c.addWorkflowExecutionResourceUsage(owner, samples); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": owner.Namespace,
+				"UID":       owner.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to store workflow execution resource usage.")
+		}
+	}
+
+	for owner, samples := range workspaceSamples {
+		if err := c.addWorkspaceResourceUsage(owner, samples); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": owner.Namespace,
+				"UID":       owner.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to store workspace resource usage.")
+		}
+	}
+
+	return nil
+}
+
+// SampleResourceUsageEvery samples resource usage every interval until stopCh is closed
+func (c *Client) SampleResourceUsageEvery(interval time.Duration, stopCh <-chan struct{}) {
+	wait.Until(func() {
+		if err := c.SampleResourceUsage(); err != nil {
+			log.WithFields(log.Fields{
+				"Error": err.Error(),
+			}).Error("Unable to sample resource usage.")
+		}
+	}, interval, stopCh)
+}
+
+// addWorkflowExecutionResourceUsage adds samples to the stored usage of a workflow execution.
+// Workflows that are not workflow executions, and so have no row, are skipped.
+func (c *Client) addWorkflowExecutionResourceUsage(owner resourceUsageOwner, samples []*resourceUsagePodSample) error {
+	whereEq := sq.Eq{
+		"namespace": owner.Namespace,
+		"uid":       owner.UID,
+	}
+
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The row is locked until the samples are stored, so samples added at the same time are not lost
+	usage := make(WorkflowExecutionResourceUsage)
+	err = sb.Select("COALESCE(resource_usage, '{}')").
+		From("workflow_executions").
+		Where(whereEq).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&usage)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if usage == nil {
+		usage = make(WorkflowExecutionResourceUsage)
+	}
+	addWorkflowExecutionResourceUsageSamples(usage, samples)
+
+	_, err = sb.Update("workflow_executions").
+		Set("resource_usage", usage).
+		Where(whereEq).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// addWorkspaceResourceUsage adds samples to the stored usage of a workspace
+func (c *Client) addWorkspaceResourceUsage(owner resourceUsageOwner, samples []*resourceUsagePodSample) error {
+	where := sq.And{
+		sq.Eq{
+			"namespace": owner.Namespace,
+			"uid":       owner.UID,
+		},
+		sq.NotEq{"phase": WorkspaceTerminated},
+	}
+
+	tx, err := c.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The row is locked until the samples are stored, so samples added at the same time are not lost
+	usage := &ResourceUsage{}
+	err = sb.Select("COALESCE(resource_usage, '{}')").
+		From("workspaces").
+		Where(where).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(usage)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	addWorkspaceResourceUsageSamples(usage, samples)
+
+	_, err = sb.Update("workspaces").
+		Set("resource_usage", usage).
+		Where(where).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetWorkflowExecutionResourceUsage returns the resource usage of a node of a workflow execution,
+// or nil if it hasn't been sampled
+func (c *Client) GetWorkflowExecutionResourceUsage(namespace, uid, nodeID string) (*ResourceUsage, error) {
+	rows := make([]WorkflowExecutionResourceUsage, 0)
+	query := sb.Select("COALESCE(resource_usage, '{}')").
+		From("workflow_executions").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		})
+	if err := c.DB.Selectx(&rows, query); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	return rows[0][nodeID], nil
+}
+
+// GetWorkspaceResourceUsage returns the resource usage of a workspace across all the times it has run,
+// or nil if it hasn't been sampled
+func (c *Client) GetWorkspaceResourceUsage(namespace, uid string) (*ResourceUsage, error) {
+	rows := make([]ResourceUsage, 0)
+	query := sb.Select("COALESCE(resource_usage, '{}')").
+		From("workspaces").
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{"phase": WorkspaceTerminated},
+		})
+	if err := c.DB.Selectx(&rows, query); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+	if rows[0].Samples == 0 {
+		return nil, nil
+	}
+
+	return &rows[0], nil
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Test_resourceUsageSamples tests matching pods to their metrics and the stats of the nodes they run on
+func Test_resourceUsageSamples(t *testing.T) {
+	pods := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "onepanel", Name: "wf-a"}, Spec: corev1.PodSpec{NodeName: "node-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "onepanel", Name: "wf-b"}, Spec: corev1.PodSpec{NodeName: "node-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "onepanel", Name: "wf-c"}, Spec: corev1.PodSpec{NodeName: "node-2"}},
+	}
+
+	metricsFor := func(namespace, name string) podMetrics {
+		metrics := podMetrics{}
+		metrics.Metadata.Namespace = namespace
+		metrics.Metadata.Name = name
+		return metrics
+	}
+	metrics := []podMetrics{
+		metricsFor("other", "wf-b"),
+		metricsFor("onepanel", "wf-a"),
+		metricsFor("onepanel", "wf-c"),
+	}
+
+	stats := kubeletPodStats{}
+	data := `{"podRef": {"name": "wf-a", "namespace": "onepanel"}, "containers": [{"accelerators": [{"dutyCycle": 50}]}]}`
+	assert.Nil(t, json.Unmarshal([]byte(data), &stats))
+	summaries := map[string]*kubeletStatsSummary{
+		"node-1": {Pods: []kubeletPodStats{stats}},
+		"node-2": nil,
+	}
+
+	samples := resourceUsageSamples(pods, metrics, summaries, time.Now())
+	if assert.Len(t, samples, 2) {
+		assert.Equal(t, "wf-a", samples[0].Pod.Name)
+		assert.NotNil(t, samples[0].Sample.GPUUtilization)
+		assert.Equal(t, "wf-c", samples[1].Pod.Name)
+		assert.Nil(t, samples[1].Sample.GPUUtilization)
+	}
+}
+
+// Test_addWorkflowExecutionResourceUsageSamples tests adding samples to the usage of each node
+func Test_addWorkflowExecutionResourceUsageSamples(t *testing.T) {
+	timestamp := time.Date(2021, 4, 19, 10, 0, 0, 0, time.UTC)
+	usage := WorkflowExecutionResourceUsage{
+		"wf-a": {Samples: 1, CPUAverage: 1, CPUPeak: 1},
+	}
+
+	addWorkflowExecutionResourceUsageSamples(usage, []*resourceUsagePodSample{
+		{Pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "wf-a"}}, Sample: &ResourceUsageSample{Timestamp: timestamp, CPU: 3}},
+		{Pod: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "wf-b"}}, Sample: &ResourceUsageSample{Timestamp: timestamp, CPU: 1}},
+	})
+
+	assert.Len(t, usage, 2)
+	assert.Equal(t, 2, usage["wf-a"].Samples)
+	assert.Equal(t, 2.0, usage["wf-a"].CPUAverage)
+	assert.Equal(t, 3.0, usage["wf-a"].CPUPeak)
+	assert.Equal(t, 1, usage["wf-b"].Samples)
+}
+
+// Test_addWorkspaceResourceUsageSamples tests that the pods of a workspace are summed into one sample
+func Test_addWorkspaceResourceUsageSamples(t *testing.T) {
+	timestamp := time.Date(2021, 4, 19, 10, 0, 0, 0, time.UTC)
+	gpuUtilization := 60.0
+	pod := &corev1.Pod{}
+
+	usage := &ResourceUsage{}
+	addWorkspaceResourceUsageSamples(usage, nil)
+	assert.Equal(t, 0, usage.Samples)
+
+	addWorkspaceResourceUsageSamples(usage, []*resourceUsagePodSample{
+		{Pod: pod, Sample: &ResourceUsageSample{Timestamp: timestamp, CPU: 1, Memory: 100, GPUUtilization: &gpuUtilization, GPUMemory: 10}},
+		{Pod: pod, Sample: &ResourceUsageSample{Timestamp: timestamp, CPU: 0.5, Memory: 50}},
+	})
+
+	assert.Equal(t, 1, usage.Samples)
+	assert.Equal(t, 1.5, usage.CPUPeak)
+	assert.Equal(t, int64(150), usage.MemoryPeak)
+	assert.Equal(t, 1, usage.GPUSamples)
+	assert.Equal(t, 60.0, usage.GPUUtilizationPeak)
+	assert.Equal(t, int64(10), usage.GPUMemoryPeak)
+}
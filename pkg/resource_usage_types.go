@@ -0,0 +1,249 @@
+package v1
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// resourceNameGPU is the resource requested by pods that use NVIDIA GPUs
+const resourceNameGPU corev1.ResourceName = "nvidia.com/gpu"
+
+// ResourceUsageSample is the resource usage of a pod at a point in time
+type ResourceUsageSample struct {
+	Timestamp time.Time
+	// CPU is in cores, Memory and GPUMemory in bytes
+	CPU    float64
+	Memory int64
+	// GPUUtilization is the average percentage of time the pod's GPUs were busy, if it has any
+	GPUUtilization *float64
+	GPUMemory      int64
+}
+
+// ResourceUsage summarizes the samples of the resource usage of a pod, along with what it requested.
+// CPU is in cores and memory in bytes.
+type ResourceUsage struct {
+	Samples        int        `json:"samples"`
+	FirstSampledAt *time.Time `json:"firstSampledAt,omitempty"`
+	LastSampledAt  *time.Time `json:"lastSampledAt,omitempty"`
+	CPUAverage     float64    `json:"cpuAverage"`
+	CPUPeak        float64    `json:"cpuPeak"`
+	MemoryAverage  int64      `json:"memoryAverage"`
+	MemoryPeak     int64      `json:"memoryPeak"`
+	// MemoryTotal is the sum of the samples, the average is computed from it so it doesn't drift as samples are added
+	MemoryTotal int64 `json:"memoryTotal"`
+	// GPUSamples are the samples that had GPU usage, GPUUtilization is a percentage
+	GPUSamples            int     `json:"gpuSamples"`
+	GPUUtilizationAverage float64 `json:"gpuUtilizationAverage"`
+	GPUUtilizationPeak    float64 `json:"gpuUtilizationPeak"`
+	GPUMemoryPeak         int64   `json:"gpuMemoryPeak"`
+	CPURequest            float64 `json:"cpuRequest"`
+	CPULimit              float64 `json:"cpuLimit"`
+	MemoryRequest         int64   `json:"memoryRequest"`
+	MemoryLimit           int64   `json:"memoryLimit"`
+	GPURequest            int64   `json:"gpuRequest"`
+}
+
+// AddSample adds a sample to the averages and peaks of the usage
+func (u *ResourceUsage) AddSample(sample *ResourceUsageSample) {
+	timestamp := sample.Timestamp.UTC()
+	if u.FirstSampledAt == nil {
+		u.FirstSampledAt = &timestamp
+	}
+	u.LastSampledAt = &timestamp
+
+	// Usage stored before the total was kept only has the average
+	if u.MemoryTotal == 0 {
+		u.MemoryTotal = u.MemoryAverage * int64(u.Samples)
+	}
+
+	u.Samples++
+	u.CPUAverage += (sample.CPU - u.CPUAverage) / float64(u.Samples)
+	u.MemoryTotal += sample.Memory
+	u.MemoryAverage = u.MemoryTotal / int64(u.Samples)
+	if sample.CPU > u.CPUPeak {
+		u.CPUPeak = sample.CPU
+	}
+	if sample.Memory > u.MemoryPeak {
+		u.MemoryPeak = sample.Memory
+	}
+
+	if sample.GPUUtilization != nil {
+		u.GPUSamples++
+		u.GPUUtilizationAverage += (*sample.GPUUtilization - u.GPUUtilizationAverage) / float64(u.GPUSamples)
+		if *sample.GPUUtilization > u.GPUUtilizationPeak {
+			u.GPUUtilizationPeak = *sample.GPUUtilization
+		}
+		if sample.GPUMemory > u.GPUMemoryPeak {
+			u.GPUMemoryPeak = sample.GPUMemory
+		}
+	}
+}
+
+// SetResources sets what the pod requested and is limited to
+func (u *ResourceUsage) SetResources(pod *corev1.Pod) {
+	requests := podResourceRequests(pod)
+	limits := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, quantity := range container.Resources.Limits {
+			total := limits[name]
+			total.Add(quantity)
+			limits[name] = total
+		}
+	}
+
+	u.CPURequest = float64(requests.Cpu().MilliValue()) / 1000
+	u.CPULimit = float64(limits.Cpu().MilliValue()) / 1000
+	u.MemoryRequest = requests.Memory().Value()
+	u.MemoryLimit = limits.Memory().Value()
+
+	gpus := limits[resourceNameGPU]
+	if requested, ok := requests[resourceNameGPU]; ok && requested.Cmp(gpus) > 0 {
+		gpus = requested
+	}
+	u.GPURequest = gpus.Value()
+}
+
+// podRequestsGPU returns true if any of the containers of a pod requested or is limited to GPUs
+func podRequestsGPU(pod *corev1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if _, ok := container.Resources.Requests[resourceNameGPU]; ok {
+			return true
+		}
+		if _, ok := container.Resources.Limits[resourceNameGPU]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Value returns u as a value.
+// This is to support ResourceUsage working with JSONB column types in sql
+func (u ResourceUsage) Value() (driver.Value, error) {
+	return json.Marshal(u)
+}
+
+// Scan scans a JSONB column into u.
+// This is to support ResourceUsage working with JSONB column types in sql
+func (u *ResourceUsage) Scan(src interface{}) error {
+	source, err := jsonbSource(src, "ResourceUsage")
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(source, u)
+}
+
+// WorkflowExecutionResourceUsage is the resource usage of the pods of a workflow execution, keyed by node id
+type WorkflowExecutionResourceUsage map[string]*ResourceUsage
+
+// Value returns u as a value.
+// This is to support WorkflowExecutionResourceUsage working with JSONB column types in sql
+func (u WorkflowExecutionResourceUsage) Value() (driver.Value, error) {
+	if u == nil {
+		return json.Marshal(map[string]*ResourceUsage{})
+	}
+
+	return json.Marshal(map[string]*ResourceUsage(u))
+}
+
+// Scan scans a JSONB column into u.
+// This is to support WorkflowExecutionResourceUsage working with JSONB column types in sql
+func (u *WorkflowExecutionResourceUsage) Scan(src interface{}) error {
+	source, err := jsonbSource(src, "WorkflowExecutionResourceUsage")
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(source, u)
+}
+
+// jsonbSource returns the bytes of a JSONB column value
+func jsonbSource(src interface{}, typeName string) ([]byte, error) {
+	switch typed := src.(type) {
+	case string:
+		return []byte(typed), nil
+	case []byte:
+		return typed, nil
+	}
+
+	return nil, errors.New("incompatible type for " + typeName)
+}
+
+// podMetricsList is the part of the metrics API's list of pod metrics used to sample resource usage
+type podMetricsList struct {
+	Items []podMetrics `json:"items"`
+}
+
+// podMetrics are the CPU and memory usage of the containers of a pod reported by the metrics API
+type podMetrics struct {
+	Metadata struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"metadata"`
+	Containers []struct {
+		Name  string              `json:"name"`
+		Usage corev1.ResourceList `json:"usage"`
+	} `json:"containers"`
+}
+
+// resourceUsageSampleFromPodMetrics sums the CPU and memory usage of the containers of a pod into a sample
+func resourceUsageSampleFromPodMetrics(metrics *podMetrics, timestamp time.Time) *ResourceUsageSample {
+	sample := &ResourceUsageSample{
+		Timestamp: timestamp,
+	}
+
+	for _, container := range metrics.Containers {
+		sample.CPU += float64(container.Usage.Cpu().ScaledValue(resource.Nano)) / 1e9
+		sample.Memory += container.Usage.Memory().Value()
+	}
+
+	return sample
+}
+
+// kubeletStatsSummary is the part of the kubelet's stats summary used to sample the GPU usage of pods
+type kubeletStatsSummary struct {
+	Pods []kubeletPodStats `json:"pods"`
+}
+
+// kubeletPodStats are the stats of a pod in the kubelet's stats summary
+type kubeletPodStats struct {
+	PodRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace"`
+	} `json:"podRef"`
+	Containers []kubeletContainerStats `json:"containers"`
+}
+
+// kubeletContainerStats are the stats of a container in the kubelet's stats summary.
+// Accelerators are only reported for NVIDIA GPUs.
+type kubeletContainerStats struct {
+	Name         string `json:"name"`
+	Accelerators []struct {
+		MemoryUsed uint64 `json:"memoryUsed"`
+		DutyCycle  uint64 `json:"dutyCycle"`
+	} `json:"accelerators"`
+}
+
+// addPodStatsGPUUsage adds the GPU usage of the containers of a pod in the kubelet's stats summary to sample
+func addPodStatsGPUUsage(sample *ResourceUsageSample, stats *kubeletPodStats) {
+	dutyCycle := uint64(0)
+	accelerators := 0
+	for _, container := range stats.Containers {
+		for _, accelerator := range container.Accelerators {
+			accelerators++
+			dutyCycle += accelerator.DutyCycle
+			sample.GPUMemory += int64(accelerator.MemoryUsed)
+		}
+	}
+
+	if accelerators > 0 {
+		utilization := float64(dutyCycle) / float64(accelerators)
+		sample.GPUUtilization = &utilization
+	}
+}
@@ -0,0 +1,153 @@
+package v1
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TestResourceUsage_AddSample tests the averages and peaks of samples
+func TestResourceUsage_AddSample(t *testing.T) {
+	start := time.Date(2021, 4, 19, 10, 0, 0, 0, time.UTC)
+	gpuUtilization := 80.0
+
+	usage := &ResourceUsage{}
+	usage.AddSample(&ResourceUsageSample{Timestamp: start, CPU: 1, Memory: 100})
+	usage.AddSample(&ResourceUsageSample{Timestamp: start.Add(time.Minute), CPU: 3, Memory: 300, GPUUtilization: &gpuUtilization, GPUMemory: 50})
+	usage.AddSample(&ResourceUsageSample{Timestamp: start.Add(2 * time.Minute), CPU: 2, Memory: 200})
+
+	assert.Equal(t, 3, usage.Samples)
+	assert.Equal(t, start, *usage.FirstSampledAt)
+	assert.Equal(t, start.Add(2*time.Minute), *usage.LastSampledAt)
+	assert.InDelta(t, 2, usage.CPUAverage, 0.0001)
+	assert.Equal(t, 3.0, usage.CPUPeak)
+	assert.Equal(t, int64(200), usage.MemoryAverage)
+	assert.Equal(t, int64(300), usage.MemoryPeak)
+	assert.Equal(t, 1, usage.GPUSamples)
+	assert.Equal(t, 80.0, usage.GPUUtilizationAverage)
+	assert.Equal(t, 80.0, usage.GPUUtilizationPeak)
+	assert.Equal(t, int64(50), usage.GPUMemoryPeak)
+}
+
+// TestResourceUsage_AddSample_MemoryAverage tests that the memory average doesn't drift as samples are added
+func TestResourceUsage_AddSample_MemoryAverage(t *testing.T) {
+	usage := &ResourceUsage{}
+	for i := 0; i < 100; i++ {
+		usage.AddSample(&ResourceUsageSample{Memory: 100})
+		usage.AddSample(&ResourceUsageSample{Memory: 101})
+	}
+	assert.Equal(t, int64(100), usage.MemoryAverage)
+	assert.Equal(t, int64(20100), usage.MemoryTotal)
+
+	// Usage stored without a total starts from its average
+	stored := &ResourceUsage{Samples: 2, MemoryAverage: 150}
+	stored.AddSample(&ResourceUsageSample{Memory: 300})
+	assert.Equal(t, int64(200), stored.MemoryAverage)
+}
+
+// TestResourceUsage_SetResources tests summing the requests and limits of containers
+func TestResourceUsage_SetResources(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("1500m"),
+							corev1.ResourceMemory: resource.MustParse("1Gi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("2"),
+							corev1.ResourceMemory: resource.MustParse("2Gi"),
+							resourceNameGPU:       resource.MustParse("1"),
+						},
+					},
+				},
+				{
+					Name: "wait",
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU: resource.MustParse("500m"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	usage := &ResourceUsage{}
+	usage.SetResources(pod)
+
+	assert.Equal(t, 2.0, usage.CPURequest)
+	assert.Equal(t, 2.0, usage.CPULimit)
+	assert.Equal(t, int64(1<<30), usage.MemoryRequest)
+	assert.Equal(t, int64(2<<30), usage.MemoryLimit)
+	assert.Equal(t, int64(1), usage.GPURequest)
+}
+
+// TestWorkflowExecutionResourceUsage_Scan tests reading the usage of nodes from JSONB
+func TestWorkflowExecutionResourceUsage_Scan(t *testing.T) {
+	usage := WorkflowExecutionResourceUsage{
+		"wf-a": {Samples: 2, CPUPeak: 1.5, MemoryPeak: 1024},
+	}
+	value, err := usage.Value()
+	assert.Nil(t, err)
+
+	scanned := WorkflowExecutionResourceUsage{}
+	assert.Nil(t, scanned.Scan(value))
+	assert.Equal(t, usage, scanned)
+
+	assert.NotNil(t, scanned.Scan(1))
+}
+
+// Test_resourceUsageSampleFromPodMetrics tests summing the containers of a pod from the metrics API
+func Test_resourceUsageSampleFromPodMetrics(t *testing.T) {
+	data := `{
+		"metadata": {"name": "wf-a", "namespace": "onepanel"},
+		"containers": [
+			{"name": "main", "usage": {"cpu": "1500000000n", "memory": "1000"}},
+			{"name": "wait", "usage": {"cpu": "500m", "memory": "24"}}
+		]
+	}`
+	metrics := &podMetrics{}
+	assert.Nil(t, json.Unmarshal([]byte(data), metrics))
+
+	timestamp := time.Date(2021, 4, 19, 10, 0, 0, 0, time.UTC)
+	sample := resourceUsageSampleFromPodMetrics(metrics, timestamp)
+
+	assert.Equal(t, timestamp, sample.Timestamp)
+	assert.InDelta(t, 2, sample.CPU, 0.0001)
+	assert.Equal(t, int64(1024), sample.Memory)
+	assert.Nil(t, sample.GPUUtilization)
+}
+
+// Test_addPodStatsGPUUsage tests summing the GPUs of the containers of a pod from the kubelet's stats summary
+func Test_addPodStatsGPUUsage(t *testing.T) {
+	data := `{
+		"podRef": {"name": "wf-a", "namespace": "onepanel"},
+		"containers": [
+			{"name": "main", "accelerators": [{"memoryUsed": 100, "dutyCycle": 90}, {"memoryUsed": 200, "dutyCycle": 50}]},
+			{"name": "wait"}
+		]
+	}`
+	stats := &kubeletPodStats{}
+	assert.Nil(t, json.Unmarshal([]byte(data), stats))
+
+	sample := &ResourceUsageSample{}
+	addPodStatsGPUUsage(sample, stats)
+
+	assert.Equal(t, int64(300), sample.GPUMemory)
+	if assert.NotNil(t, sample.GPUUtilization) {
+		assert.Equal(t, 70.0, *sample.GPUUtilization)
+	}
+
+	sample = &ResourceUsageSample{}
+	stats.Containers = stats.Containers[1:]
+	addPodStatsGPUUsage(sample, stats)
+	assert.Nil(t, sample.GPUUtilization)
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/onepanelio/core/pkg/util"
+	"google.golang.org/grpc/codes"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// roleImpersonationName is the name of the Role and RoleBinding of RoleImpersonationGroup
+const roleImpersonationName = "onepanel-roles"
+
+var roleBindingColumns = []string{"id", "uid", "namespace", "subject_kind", "subject_name", "role", "created_at"}
+
+// CreateRoleBinding binds a role to a user or group in namespace
+func (c *Client) CreateRoleBinding(namespace string, binding *RoleBinding) (*RoleBinding, error) {
+	if err := binding.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	binding.UID = uuid.New().String()
+	binding.Namespace = namespace
+	binding.CreatedAt = time.Now().UTC()
+
+	err := sb.Insert("role_bindings").
+		SetMap(sq.Eq{
+			"uid":          binding.UID,
+			"namespace":    binding.Namespace,
+			"subject_kind": binding.SubjectKind,
+			"subject_name": binding.SubjectName,
+			"role":         binding.Role,
+			"created_at":   binding.CreatedAt,
+		}).
+		Suffix("ON CONFLICT DO NOTHING RETURNING id").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&binding.ID)
+	if err == sql.ErrNoRows {
+		return nil, util.NewUserError(codes.AlreadyExists, "The role is already bound to the subject.")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return binding, nil
+}
+
+// ListRoleBindings returns the role bindings of namespace
+func (c *Client) ListRoleBindings(namespace string) (bindings []*RoleBinding, err error) {
+	query := sb.Select(roleBindingColumns...).
+		From("role_bindings").
+		Where(sq.Eq{"namespace": namespace}).
+		OrderBy("subject_kind", "subject_name", "role")
+
+	bindings = make([]*RoleBinding, 0)
+	err = c.DB.Selectx(&bindings, query)
+
+	return
+}
+
+// DeleteRoleBinding deletes a role binding of namespace
+func (c *Client) DeleteRoleBinding(namespace, uid string) error {
+	result, err := sb.Delete("role_bindings").
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return util.NewUserError(codes.NotFound, "Role binding not found.")
+	}
+
+	return nil
+}
+
+// GetWorkspaceTemplateUID returns the uid of the template of a workspace, or an empty string if there is no workspace
+func (c *Client) GetWorkspaceTemplateUID(namespace, uid string) (templateUID string, err error) {
+	query := sb.Select("wt.uid").
+		From("workspaces w").
+		Join("workspace_templates wt ON wt.id = w.workspace_template_id").
+		Where(sq.Eq{
+			"w.namespace": namespace,
+			"w.uid":       uid,
+		}).
+		Limit(1)
+
+	err = c.DB.Getx(&templateUID, query)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	return
+}
+
+// RoleImpersonationGroup is the Kubernetes group users impersonate in namespace when their roles allow a request.
+// It is only bound in namespace, so the request can't act on other namespaces.
+func RoleImpersonationGroup(namespace string) string {
+	return "onepanel-roles:" + namespace
+}
+
+// EnsureRoleImpersonation creates the Role and RoleBinding that let RoleImpersonationGroup manage the resources
+// Onepanel uses in namespace, if they don't exist. Kubernetes RBAC is not included, roles can't grant it.
+func (c *Client) EnsureRoleImpersonation(namespace string) error {
+	_, err := c.RbacV1().RoleBindings(namespace).Get(roleImpersonationName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	_, err = c.RbacV1().Roles(namespace).Create(&rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleImpersonationName,
+			Namespace: namespace,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"", "apps", "argoproj.io", "networking.istio.io", "onepanel.io"},
+				Resources: []string{"*"},
+				Verbs:     []string{"*"},
+			},
+		},
+	})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	_, err = c.RbacV1().RoleBindings(namespace).Create(&rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      roleImpersonationName,
+			Namespace: namespace,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:     rbacv1.GroupKind,
+				APIGroup: rbacv1.GroupName,
+				Name:     RoleImpersonationGroup(namespace),
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     roleImpersonationName,
+		},
+	})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClient_CreateRoleBinding(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	binding, err := c.CreateRoleBinding("onepanel", &RoleBinding{SubjectKind: RoleSubjectUser, SubjectName: "jane", Role: "viewer"})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, binding.UID)
+
+	_, err = c.CreateRoleBinding("onepanel", &RoleBinding{SubjectKind: RoleSubjectUser, SubjectName: "jane", Role: "viewer"})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, codes.AlreadyExists, err.(*util.UserError).Code)
+	}
+
+	_, err = c.CreateRoleBinding("onepanel", &RoleBinding{SubjectKind: RoleSubjectUser, SubjectName: "jane", Role: "owner"})
+	assert.NotNil(t, err)
+
+	_, err = c.CreateRoleBinding("other", &RoleBinding{SubjectKind: RoleSubjectGroup, SubjectName: "team", Role: "admin"})
+	assert.Nil(t, err)
+
+	bindings, err := c.ListRoleBindings("onepanel")
+	assert.Nil(t, err)
+	if assert.Len(t, bindings, 1) {
+		assert.Equal(t, "jane", bindings[0].SubjectName)
+	}
+
+	assert.Nil(t, c.DeleteRoleBinding("onepanel", binding.UID))
+	assert.NotNil(t, c.DeleteRoleBinding("onepanel", binding.UID))
+
+	bindings, err = c.ListRoleBindings("onepanel")
+	assert.Nil(t, err)
+	assert.Empty(t, bindings)
+}
+
+func TestClient_GetWorkspaceTemplateUID(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	templateUID, err := c.GetWorkspaceTemplateUID("onepanel", "missing")
+	assert.Nil(t, err)
+	assert.Equal(t, "", templateUID)
+}
+
+func TestClient_EnsureRoleImpersonation(t *testing.T) {
+	c := DefaultTestClient()
+
+	assert.Nil(t, c.EnsureRoleImpersonation("team-a"))
+	assert.Nil(t, c.EnsureRoleImpersonation("team-a"))
+
+	roleBinding, err := c.RbacV1().RoleBindings("team-a").Get(roleImpersonationName, metav1.GetOptions{})
+	if assert.Nil(t, err) && assert.Len(t, roleBinding.Subjects, 1) {
+		assert.Equal(t, RoleImpersonationGroup("team-a"), roleBinding.Subjects[0].Name)
+		assert.Equal(t, "Role", roleBinding.RoleRef.Kind)
+	}
+
+	role, err := c.RbacV1().Roles("team-a").Get(roleImpersonationName, metav1.GetOptions{})
+	if assert.Nil(t, err) {
+		for _, rule := range role.Rules {
+			assert.NotContains(t, rule.APIGroups, "*")
+			assert.NotContains(t, rule.APIGroups, rbacv1.GroupName)
+		}
+	}
+
+	_, err = c.RbacV1().RoleBindings("team-b").Get(roleImpersonationName, metav1.GetOptions{})
+	assert.NotNil(t, err)
+}
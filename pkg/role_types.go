@@ -0,0 +1,197 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// RoleSubjectUser binds a role to a user, e.g. oidc:jane@example.com or system:serviceaccount:onepanel:admin
+	RoleSubjectUser = "User"
+	// RoleSubjectGroup binds a role to the members of a group
+	RoleSubjectGroup = "Group"
+)
+
+// RoleRule allows actions on resources. Resources are the resources of API methods, e.g. workflows or secrets,
+// and actions are read, create, update, delete or approve. Either may be *.
+type RoleRule struct {
+	Resources []string `json:"resources"`
+	Actions   []string `json:"actions"`
+	// Methods limits the rule to API methods, by name, e.g. PauseWorkspace
+	Methods []string `json:"methods,omitempty"`
+	// WorkspaceTemplates limits the rule to workspaces whose template has one of these uids
+	WorkspaceTemplates []string `json:"workspaceTemplates,omitempty"`
+}
+
+// Role is a set of rules that can be bound to users and groups in a namespace
+type Role struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Rules       []RoleRule `json:"rules"`
+}
+
+// RoleRequest is an API call that is authorized with roles
+type RoleRequest struct {
+	Resource string
+	Action   string
+	Method   string
+	// WorkspaceTemplate returns the uid of the template of the workspace the call is for, if there is one
+	WorkspaceTemplate func() (string, error)
+}
+
+// cvatWorkspaceTemplateUID is the uid of the CVAT workspace template that is added to every namespace
+const cvatWorkspaceTemplateUID = "cvat"
+
+// readResources are the resources any role can read, except annotators
+var readResources = []string{"workflows", "workflowtemplates", "cronworkflows", "workspaces", "workspacetemplates",
+	"labels", "config", "services"}
+
+// Roles are the roles that can be bound in namespaces
+var Roles = []*Role{
+	{
+		Name:        "viewer",
+		Description: "Can view workflows, workspaces, templates and their logs, but not secrets.",
+		Rules: []RoleRule{
+			{Resources: readResources, Actions: []string{"read"}},
+		},
+	},
+	{
+		Name:        "annotator",
+		Description: "Can view workspaces and pause or resume CVAT workspaces.",
+		Rules: []RoleRule{
+			{Resources: []string{"workspaces", "workspacetemplates", "config", "services"}, Actions: []string{"read"}},
+			{
+				Resources:          []string{"workspaces"},
+				Actions:            []string{"update"},
+				Methods:            []string{"PauseWorkspace", "ResumeWorkspace"},
+				WorkspaceTemplates: []string{cvatWorkspaceTemplateUID},
+			},
+		},
+	},
+	{
+		Name:        "approver",
+		Description: "Can view workflows, workspaces and templates, and approve the approval gates of workflows.",
+		Rules: []RoleRule{
+			{Resources: readResources, Actions: []string{"read"}},
+			{Resources: []string{"workflows"}, Actions: []string{"approve"}},
+		},
+	},
+	{
+		Name:        "developer",
+		Description: "Can manage workflows, workspaces, templates and labels, and read secrets. Can't approve gates.",
+		Rules: []RoleRule{
+			{Resources: []string{"workflows", "workflowtemplates", "cronworkflows", "workspaces", "workspacetemplates", "labels"}, Actions: []string{"read", "create", "update", "delete"}},
+			{Resources: []string{"secrets", "config", "services"}, Actions: []string{"read"}},
+		},
+	},
+	{
+		Name:        "admin",
+		Description: "Can do anything in the namespace, including managing secrets and role bindings.",
+		Rules: []RoleRule{
+			{Resources: []string{"*"}, Actions: []string{"*"}},
+		},
+	},
+}
+
+// GetRole returns the role with name, or nil if there is none
+func GetRole(name string) *Role {
+	for _, role := range Roles {
+		if role.Name == name {
+			return role
+		}
+	}
+
+	return nil
+}
+
+// matches returns true if values has value or *
+func matches(values []string, value string) bool {
+	return containsString(values, "*") || containsString(values, value)
+}
+
+// Allows returns true if the rule allows req
+func (r *RoleRule) Allows(req *RoleRequest) (bool, error) {
+	if !matches(r.Resources, req.Resource) || !matches(r.Actions, req.Action) {
+		return false, nil
+	}
+	if len(r.Methods) > 0 && !containsString(r.Methods, req.Method) {
+		return false, nil
+	}
+
+	if len(r.WorkspaceTemplates) > 0 {
+		if req.WorkspaceTemplate == nil {
+			return false, nil
+		}
+		templateUID, err := req.WorkspaceTemplate()
+		if err != nil {
+			return false, err
+		}
+
+		return templateUID != "" && containsString(r.WorkspaceTemplates, templateUID), nil
+	}
+
+	return true, nil
+}
+
+// Allows returns true if one of the role's rules allows req
+func (r *Role) Allows(req *RoleRequest) (bool, error) {
+	for i := range r.Rules {
+		allowed, err := r.Rules[i].Allows(req)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	return false, nil
+}
+
+// RoleBinding gives a user, or the members of a group, a role in a namespace
+type RoleBinding struct {
+	ID          uint64
+	UID         string
+	Namespace   string
+	SubjectKind string    `db:"subject_kind"`
+	SubjectName string    `db:"subject_name"`
+	Role        string    `db:"role"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// Validate returns an error if the binding's subject or role is not valid
+func (b *RoleBinding) Validate() error {
+	if b.SubjectKind != RoleSubjectUser && b.SubjectKind != RoleSubjectGroup {
+		return fmt.Errorf("subject kind must be %v or %v", RoleSubjectUser, RoleSubjectGroup)
+	}
+	if strings.TrimSpace(b.SubjectName) == "" {
+		return fmt.Errorf("subject name is required")
+	}
+	if GetRole(b.Role) == nil {
+		return fmt.Errorf("unknown role '%v'", b.Role)
+	}
+
+	return nil
+}
+
+// AppliesTo returns true if the binding is for the user or one of their groups
+func (b *RoleBinding) AppliesTo(username string, groups []string) bool {
+	if b.SubjectKind == RoleSubjectUser {
+		return b.SubjectName == username
+	}
+
+	return containsString(groups, b.SubjectName)
+}
+
+// BoundRoles returns the roles of the bindings that are for the user or one of their groups
+func BoundRoles(bindings []*RoleBinding, username string, groups []string) []*Role {
+	roles := make([]*Role, 0)
+	for _, binding := range bindings {
+		if !binding.AppliesTo(username, groups) {
+			continue
+		}
+		if role := GetRole(binding.Role); role != nil {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func roleAllows(t *testing.T, role, resource, action, method, workspaceTemplateUID string) bool {
+	req := &RoleRequest{
+		Resource: resource,
+		Action:   action,
+		Method:   method,
+	}
+	if workspaceTemplateUID != "" {
+		req.WorkspaceTemplate = func() (string, error) {
+			return workspaceTemplateUID, nil
+		}
+	}
+
+	allowed, err := GetRole(role).Allows(req)
+	assert.Nil(t, err)
+
+	return allowed
+}
+
+func TestRole_Allows(t *testing.T) {
+	assert.True(t, roleAllows(t, "viewer", "workflows", "read", "GetWorkflowExecutionLogs", ""))
+	assert.False(t, roleAllows(t, "viewer", "secrets", "read", "GetSecret", ""))
+	assert.False(t, roleAllows(t, "viewer", "workflows", "create", "CreateWorkflowExecution", ""))
+
+	assert.True(t, roleAllows(t, "annotator", "workspaces", "update", "PauseWorkspace", "cvat"))
+	assert.True(t, roleAllows(t, "annotator", "workspaces", "update", "ResumeWorkspace", "cvat"))
+	assert.False(t, roleAllows(t, "annotator", "workspaces", "update", "ResumeWorkspace", "my-cvat-copy"))
+	assert.False(t, roleAllows(t, "annotator", "workspaces", "update", "PauseWorkspace", "jupyterlab"))
+	assert.False(t, roleAllows(t, "annotator", "workspaces", "update", "PauseWorkspace", ""))
+	assert.False(t, roleAllows(t, "annotator", "workspaces", "delete", "DeleteWorkspace", "cvat"))
+	assert.False(t, roleAllows(t, "annotator", "workflows", "read", "GetWorkflowExecution", ""))
+
+	assert.True(t, roleAllows(t, "approver", "workflows", "approve", "ApproveWorkflowNode", ""))
+	assert.False(t, roleAllows(t, "approver", "workflows", "update", "ResumeWorkflowExecution", ""))
+
+	assert.True(t, roleAllows(t, "developer", "workflows", "delete", "TerminateWorkflowExecution", ""))
+	assert.False(t, roleAllows(t, "developer", "workflows", "approve", "ApproveWorkflowNode", ""))
+	assert.True(t, roleAllows(t, "developer", "secrets", "read", "GetSecret", ""))
+	assert.False(t, roleAllows(t, "developer", "secrets", "update", "UpdateSecretKeyValue", ""))
+	assert.False(t, roleAllows(t, "developer", "roles", "create", "CreateRoleBinding", ""))
+
+	assert.True(t, roleAllows(t, "admin", "roles", "create", "CreateRoleBinding", ""))
+	assert.True(t, roleAllows(t, "admin", "secrets", "delete", "DeleteSecret", ""))
+	assert.True(t, roleAllows(t, "admin", "workflows", "approve", "ApproveWorkflowNode", ""))
+}
+
+func TestBoundRoles(t *testing.T) {
+	bindings := []*RoleBinding{
+		{SubjectKind: RoleSubjectUser, SubjectName: "jane", Role: "viewer"},
+		{SubjectKind: RoleSubjectGroup, SubjectName: "annotators", Role: "annotator"},
+		{SubjectKind: RoleSubjectUser, SubjectName: "john", Role: "admin"},
+	}
+
+	roles := BoundRoles(bindings, "jane", []string{"annotators"})
+	if assert.Len(t, roles, 2) {
+		assert.Equal(t, "viewer", roles[0].Name)
+		assert.Equal(t, "annotator", roles[1].Name)
+	}
+
+	assert.Empty(t, BoundRoles(bindings, "other", nil))
+}
+
+func TestRoleBinding_Validate(t *testing.T) {
+	assert.Nil(t, (&RoleBinding{SubjectKind: RoleSubjectGroup, SubjectName: "team", Role: "developer"}).Validate())
+	assert.NotNil(t, (&RoleBinding{SubjectKind: "ServiceAccount", SubjectName: "team", Role: "developer"}).Validate())
+	assert.NotNil(t, (&RoleBinding{SubjectKind: RoleSubjectUser, SubjectName: " ", Role: "developer"}).Validate())
+	assert.NotNil(t, (&RoleBinding{SubjectKind: RoleSubjectUser, SubjectName: "jane", Role: "owner"}).Validate())
+}
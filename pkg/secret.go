@@ -2,366 +2,211 @@ package v1
 
 import (
 	"encoding/base64"
-	"encoding/json"
-	goerrors "errors"
-	"strings"
+	"fmt"
+	"sort"
 
 	"github.com/onepanelio/core/pkg/util"
-	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
-	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 )
 
+// CreateSecret creates a secret in the secret backend of the namespace. The values of its data are not encoded.
 func (c *Client) CreateSecret(namespace string, secret *Secret) (err error) {
-	_, err = c.CoreV1().Secrets(namespace).Create(&corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: secret.Name,
-		},
-		StringData: secret.Data,
-	})
+	backend, err := c.SecretBackend(namespace)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Secret":    secret,
-			"Error":     err.Error(),
-		}).Error("Error creating secret.")
-		return util.NewUserError(codes.Unknown, "Secret was not created.")
+		return err
 	}
-	return
+
+	return backend.CreateSecret(namespace, secret)
 }
 
+// SecretExists returns true if the secret backend of the namespace has a secret with name
 func (c *Client) SecretExists(namespace string, name string) (exists bool, err error) {
-	foundSecret, err := c.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+	backend, err := c.SecretBackend(namespace)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Name":      name,
-			"Error":     err.Error(),
-		}).Error("Secret Exists error.")
-
-		var statusError *errors.StatusError
-		if goerrors.As(err, &statusError) {
-			if statusError.ErrStatus.Reason == "NotFound" {
-				return false, util.NewUserError(codes.NotFound, "Secret Not Found.")
-			}
-			return false, util.NewUserError(codes.Unknown, "Error when checking existence of secret.")
-		}
-		return false, util.NewUserError(codes.Unknown, "Error when checking existence of secret.")
+		return false, err
 	}
-	if foundSecret.Name == "" {
-		return false, nil
+
+	if _, err := backend.GetSecret(namespace, name); err != nil {
+		return false, err
 	}
+
 	return true, nil
 }
 
-func encodeSecretData(secretData map[string][]byte) (encodedData map[string]string) {
-	encodedData = make(map[string]string)
-	for key, value := range secretData {
-		encodedData[key] = base64.StdEncoding.EncodeToString([]byte(value))
+// GetSecret returns a secret from the secret backend of the namespace. The values of its data are base64 encoded.
+func (c *Client) GetSecret(namespace, name string) (secret *Secret, err error) {
+	backend, err := c.SecretBackend(namespace)
+	if err != nil {
+		return nil, err
 	}
-	return encodedData
+
+	return backend.GetSecret(namespace, name)
 }
 
-func (c *Client) GetSecret(namespace, name string) (secret *Secret, err error) {
-	s, err := c.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+// ListSecrets returns the secrets in the secret backend of the namespace, without their data
+func (c *Client) ListSecrets(namespace string) (secrets []*Secret, err error) {
+	backend, err := c.SecretBackend(namespace)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Name":      name,
-			"Error":     err.Error(),
-		}).Error("Secret not found error.")
-
-		var statusError *errors.StatusError
-		if goerrors.As(err, &statusError) {
-			if statusError.ErrStatus.Reason == "NotFound" {
-				return nil, util.NewUserError(codes.NotFound, "Secret Not Found.")
-			}
-			return nil, util.NewUserError(codes.Unknown, "Error when getting secret.")
-		}
-		return nil, util.NewUserError(codes.Unknown, "Error when getting secret.")
-	}
-	if s == nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Name":      name,
-			"Error":     "Secret is nil.",
-		}).Error("Error getting secret.")
-		return nil, util.NewUserError(codes.Unknown, "Error when getting secret.")
+		return nil, err
 	}
 
-	secret = &Secret{
-		Name: s.Name,
-		Data: encodeSecretData(s.Data),
-	}
-	return
+	return backend.ListSecrets(namespace)
 }
 
-func (c *Client) ListSecrets(namespace string) (secrets []*Secret, err error) {
-	secretsList, err := c.CoreV1().Secrets(namespace).List(metav1.ListOptions{})
+// DeleteSecret deletes a secret, and its previous versions, from the secret backend of the namespace.
+// Secrets that templates, cron workflows or workspaces reference are only deleted if force is true.
+func (c *Client) DeleteSecret(namespace string, name string, force bool) (deleted bool, err error) {
+	backend, err := c.SecretBackend(namespace)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Error":     err.Error(),
-		}).Error("No secrets were found.")
-		return nil, util.NewUserError(codes.NotFound, "No secrets were found.")
+		return false, err
 	}
 
-	for _, s := range secretsList.Items {
-		secret := Secret{
-			Name: s.Name,
+	if !force {
+		usage, err := c.GetSecretUsage(namespace, name)
+		if err != nil {
+			return false, err
+		}
+		if usage.InUse() {
+			return false, util.NewUserError(codes.FailedPrecondition, usage.Summary()+" Delete it with force to delete it anyway.")
 		}
-		secrets = append(secrets, &secret)
 	}
 
-	return
+	return backend.DeleteSecret(namespace, name)
 }
 
-func (c *Client) DeleteSecret(namespace string, name string) (deleted bool, err error) {
-	err = c.CoreV1().Secrets(namespace).Delete(name, &metav1.DeleteOptions{})
+// PatchSecret upserts and deletes many keys of a secret at once. Either all of the changes are made or none are.
+// The previous version of the secret is kept so it can be rolled back to.
+func (c *Client) PatchSecret(namespace, name string, patch *SecretPatch) (*SecretVersion, error) {
+	if err := patch.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	backend, err := c.SecretBackend(namespace)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Name":      name,
-			"Error":     err.Error(),
-		}).Error("Unable to delete a secret.")
-		return false, util.NewUserError(codes.Unknown, "Secret unable to be deleted.")
+		return nil, err
 	}
-	return true, nil
+
+	return backend.PatchSecret(namespace, name, patch)
 }
 
-func (c *Client) DeleteSecretKey(namespace string, secret *Secret) (deleted bool, err error) {
-	if len(secret.Data) == 0 {
-		return false, util.NewUserError(codes.InvalidArgument, "Data cannot be empty")
-	}
-	//Currently, support for 1 key only
-	key := ""
-	for dataKey := range secret.Data {
-		key = dataKey
-		break
+// ListSecretVersions returns the current and previous versions of a secret, newest first. Values are not included.
+func (c *Client) ListSecretVersions(namespace, name string) ([]*SecretVersion, error) {
+	backend, err := c.SecretBackend(namespace)
+	if err != nil {
+		return nil, err
 	}
-	//Check if the secret has the key to delete
-	secretFound, err := c.GetSecret(namespace, secret.Name)
+
+	return backend.ListSecretVersions(namespace, name)
+}
+
+// RollbackSecret replaces the keys and values of a secret with those of a previous version.
+// The rollback is a new version, so it can be undone too.
+func (c *Client) RollbackSecret(namespace, name string, version int) (*SecretVersion, error) {
+	backend, err := c.SecretBackend(namespace)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Secret":    secret,
-			"Error":     err.Error(),
-		}).Error("Error with getting a secret.")
-		return false, util.NewUserError(codes.NotFound, "Secret not found.")
+		return nil, err
 	}
-	secretDataKeyExists := false
 
-	for secretDataKey := range secretFound.Data {
-		if secretDataKey == key {
-			secretDataKeyExists = true
-			break
-		}
+	return backend.RollbackSecret(namespace, name, version)
+}
+
+func encodeSecretData(secretData map[string][]byte) (encodedData map[string]string) {
+	encodedData = make(map[string]string)
+	for key, value := range secretData {
+		encodedData[key] = base64.StdEncoding.EncodeToString([]byte(value))
 	}
+	return encodedData
+}
 
-	if secretDataKeyExists {
-		//  patchStringPath specifies a patch operation for a secret key.
-		type patchStringPath struct {
-			Op   string `json:"op"`
-			Path string `json:"path"`
-		}
-		payload := []patchStringPath{{
-			Op:   "remove",
-			Path: "/data/" + key,
-		}}
-		payloadBytes, _ := json.Marshal(payload)
-		_, err = c.CoreV1().Secrets(namespace).Patch(secret.Name, types.JSONPatchType, payloadBytes)
+// decodeSecretData returns the plain values of data returned by GetSecret, as CreateSecret expects them
+func decodeSecretData(data map[string]string) (map[string]string, error) {
+	decoded := make(map[string]string)
+	for key, value := range data {
+		bytes, err := base64.StdEncoding.DecodeString(value)
 		if err != nil {
-			log.WithFields(log.Fields{
-				"Namespace": namespace,
-				"Secret":    secret,
-				"Error":     err.Error(),
-			}).Error("Unable to a key from a secret.")
-			return false, util.NewUserError(codes.Unknown, "Unable to delete key from Secret.")
+			return nil, fmt.Errorf("value of key '%v' is not base64 encoded", key)
 		}
-		return true, nil
+		decoded[key] = string(bytes)
+	}
 
+	return decoded, nil
+}
+
+// sortedKeys returns the keys of data in order, so errors about them are predictable
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
 	}
-	return false, util.NewUserError(codes.NotFound, "Key not found in Secret.")
+	sort.Strings(keys)
+
+	return keys
 }
 
-func (c *Client) AddSecretKeyValue(namespace string, secret *Secret) (inserted bool, err error) {
+// DeleteSecretKey deletes the keys in secret.Data from the secret, their values are ignored
+func (c *Client) DeleteSecretKey(namespace string, secret *Secret) (deleted bool, err error) {
 	if len(secret.Data) == 0 {
 		return false, util.NewUserError(codes.InvalidArgument, "Data cannot be empty")
 	}
-	//Currently, support for 1 key only
-
-	var (
-		key   string
-		value []byte
-	)
-	for dataKey, dataValue := range secret.Data {
-		key = dataKey
-		value = []byte(dataValue)
-		break
-	}
 
-	secretFound, err := c.GetSecret(namespace, secret.Name)
+	_, err = c.PatchSecret(namespace, secret.Name, &SecretPatch{
+		Delete: sortedKeys(secret.Data),
+	})
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Secret":    secret,
-			"Error":     err.Error(),
-		}).Error("Unable to find the secret.")
-		return false, util.NewUserError(codes.NotFound, "Secret not found.")
+		return false, err
 	}
 
-	if secretFound == nil {
-		return false, util.NewUserError(codes.NotFound, "Secret not found.")
-	}
-	//Check if the secret has the key already
-	if len(secretFound.Data) > 0 {
-		secretDataKeyExists := false
-		for secretDataKey := range secretFound.Data {
-			if secretDataKey == key {
-				secretDataKeyExists = true
-				break
-			}
-		}
-		if secretDataKeyExists {
-			errorMsg := "Key '" + key + "' already exists"
-			return false, util.NewUserError(codes.AlreadyExists, errorMsg)
-		}
+	return true, nil
+}
+
+// AddSecretKeyValue adds the keys and values in secret.Data to the secret. None of the keys can exist already.
+func (c *Client) AddSecretKeyValue(namespace string, secret *Secret) (inserted bool, err error) {
+	if len(secret.Data) == 0 {
+		return false, util.NewUserError(codes.InvalidArgument, "Data cannot be empty")
 	}
 
-	//  patchStringPathAddNode specifies an add operation for a node
-	type patchStringPathAddNode struct {
-		Op    string            `json:"op"`
-		Path  string            `json:"path"`
-		Value map[string]string `json:"value"`
+	secretFound, err := c.GetSecret(namespace, secret.Name)
+	if err != nil {
+		return false, util.NewUserError(codes.NotFound, "Secret not found.")
 	}
-	var payload []byte
-	// "/data" may not exist due to 0 items. Create it with our new key and value
-	if len(secretFound.Data) == 0 {
-		valMap := make(map[string]string)
-		valueEnc := base64.StdEncoding.EncodeToString([]byte(value))
-		valMap[key] = valueEnc
-		payloadAddNode := []patchStringPathAddNode{{
-			Op:    "add",
-			Path:  "/data",
-			Value: valMap,
-		}}
-		payload, err = json.Marshal(payloadAddNode)
-		if err != nil {
-			log.WithFields(log.Fields{
-				"Namespace": namespace,
-				"Secret":    secret,
-				"Error":     err.Error(),
-			}).Error("Error building JSON.")
-			return false, util.NewUserError(codes.InvalidArgument, "Error building JSON.")
-		}
-	} else {
-		//  patchStringPathAddKeyValue specifies an add operation, a key and value
-		type patchStringPathAddKeyValue struct {
-			Op    string `json:"op"`
-			Path  string `json:"path"`
-			Value string `json:"value"`
-		}
-		valueEnc := base64.StdEncoding.EncodeToString([]byte(value))
-		payloadAddData := []patchStringPathAddKeyValue{{
-			Op:    "add",
-			Path:  "/data/" + key,
-			Value: valueEnc,
-		}}
-		payload, err = json.Marshal(payloadAddData)
-		if err != nil {
-			log.WithFields(log.Fields{
-				"Namespace": namespace,
-				"Secret":    secret,
-				"Error":     err.Error(),
-			}).Error("Error building JSON.")
-			return false, util.NewUserError(codes.InvalidArgument, "Error building JSON.")
+	for _, key := range sortedKeys(secret.Data) {
+		if _, ok := secretFound.Data[key]; ok {
+			return false, util.NewUserError(codes.AlreadyExists, "Key '"+key+"' already exists")
 		}
 	}
-	_, err = c.CoreV1().Secrets(namespace).Patch(secret.Name, types.JSONPatchType, payload)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Secret":    secret.Name,
-			"Error":     err.Error(),
-		}).Error("Error adding key and value to Secret.")
-
-		if strings.Contains(err.Error(), "Request entity too large:") ||
-			strings.Contains(err.Error(), "Too long: must have at most") {
-			return false, util.NewUserError(codes.InvalidArgument, "Value is too long")
-		}
 
-		return false, util.NewUserError(codes.Unknown, "Error adding key and value to Secret.")
+	_, err = c.PatchSecret(namespace, secret.Name, &SecretPatch{
+		Upsert: secret.Data,
+	})
+	if err != nil {
+		return false, err
 	}
+
 	return true, nil
 }
 
+// UpdateSecretKeyValue replaces the values of the keys in secret.Data. All of the keys must exist.
 func (c *Client) UpdateSecretKeyValue(namespace string, secret *Secret) (updated bool, err error) {
 	if len(secret.Data) == 0 {
 		return false, util.NewUserError(codes.InvalidArgument, "data cannot be empty.")
 	}
-	//Currently, support for 1 key only
-	var (
-		key   string
-		value []byte
-	)
-	for dataKey, dataValue := range secret.Data {
-		key = dataKey
-		value = []byte(dataValue)
-		break
-	}
 
-	//Check if the secret has the key to update
 	secretFound, err := c.GetSecret(namespace, secret.Name)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Secret":    secret.Name,
-			"Error":     err.Error(),
-		}).Error("Unable to find secret.")
-
 		return false, util.NewUserError(codes.NotFound, "Unable to find secret.")
 	}
-	secretDataKeyExists := false
-	for secretDataKey := range secretFound.Data {
-		if secretDataKey == key {
-			secretDataKeyExists = true
-			break
+	for _, key := range sortedKeys(secret.Data) {
+		if _, ok := secretFound.Data[key]; !ok {
+			return false, util.NewUserError(codes.NotFound, "Key: "+key+" not found in secret.")
 		}
 	}
-	if !secretDataKeyExists {
-		errorMsg := "Key: " + key + " not found in secret."
-		return false, util.NewUserError(codes.NotFound, errorMsg)
-	}
-	//  patchStringPath specifies a patch operation for a secret key.
-	type patchStringPathWithValue struct {
-		Op    string `json:"op"`
-		Path  string `json:"path"`
-		Value string `json:"value"`
-	}
-	valueEnc := base64.StdEncoding.EncodeToString([]byte(value))
-	payload := []patchStringPathWithValue{{
-		Op:    "replace",
-		Path:  "/data/" + key,
-		Value: valueEnc,
-	}}
-	payloadBytes, _ := json.Marshal(payload)
-	_, err = c.CoreV1().Secrets(namespace).Patch(secret.Name, types.JSONPatchType, payloadBytes)
-	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace": namespace,
-			"Secret":    secret.Name,
-			"Error":     err.Error(),
-		}).Error("Unable to update secret key value.")
-
-		if strings.Contains(err.Error(), "Request entity too large:") ||
-			strings.Contains(err.Error(), "Too long: must have at most") {
-			return false, util.NewUserError(codes.InvalidArgument, "Value is too long")
-		}
 
-		return false, util.NewUserError(codes.Unknown, "Unable to update secret key value.")
+	_, err = c.PatchSecret(namespace, secret.Name, &SecretPatch{
+		Upsert: secret.Data,
+	})
+	if err != nil {
+		return false, err
 	}
+
 	return true, nil
 }
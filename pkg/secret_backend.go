@@ -0,0 +1,306 @@
+package v1
+
+import (
+	"encoding/base64"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/tracing"
+	"github.com/onepanelio/core/pkg/util/vault"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretBackend stores the secrets of a namespace.
+// Secrets are created with plain values and returned with base64 encoded values, like Kubernetes secrets.
+type SecretBackend interface {
+	CreateSecret(namespace string, secret *Secret) error
+	GetSecret(namespace, name string) (*Secret, error)
+	ListSecrets(namespace string) ([]*Secret, error)
+	DeleteSecret(namespace, name string) (bool, error)
+	PatchSecret(namespace, name string, patch *SecretPatch) (*SecretVersion, error)
+	ListSecretVersions(namespace, name string) ([]*SecretVersion, error)
+	RollbackSecret(namespace, name string, version int) (*SecretVersion, error)
+}
+
+// GetSecretBackendConfig returns the secret backend of the namespace.
+// Namespaces without a secretBackend in their onepanel config map use Kubernetes secrets.
+func (c *Client) GetSecretBackendConfig(namespace string) (*SecretBackendConfig, error) {
+	configMap, err := c.getConfigMap(namespace, "onepanel")
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &SecretBackendConfig{}, nil
+		}
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Error":     err.Error(),
+		}).Error("Unable to get the secret backend config.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get the secret backend of the namespace.")
+	}
+
+	config, err := ParseSecretBackendConfig(namespace, configMap.Data[secretBackendConfigKey])
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Error":     err.Error(),
+		}).Error("Invalid secret backend config.")
+		return nil, util.NewUserError(codes.FailedPrecondition, "The secret backend of the namespace is misconfigured.")
+	}
+
+	return config, nil
+}
+
+// SecretBackend returns the backend that stores the secrets of the namespace
+func (c *Client) SecretBackend(namespace string) (SecretBackend, error) {
+	config, err := c.GetSecretBackendConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Vault == nil {
+		return c.kubernetesSecrets(), nil
+	}
+
+	// The token is a system secret, so it is always a Kubernetes secret
+	tokenSecret, err := c.kubernetesSecrets().GetSecret(namespace, config.Vault.TokenSecret.Name)
+	if err != nil {
+		return nil, err
+	}
+	token, err := base64.StdEncoding.DecodeString(tokenSecret.Data[config.Vault.TokenSecret.Key])
+	if err != nil || len(token) == 0 {
+		return nil, util.NewUserError(codes.FailedPrecondition, "The Vault token of the namespace is missing.")
+	}
+
+	vaultClient, err := vault.NewClient(vault.Config{
+		Address:       config.Vault.Address,
+		Token:         string(token),
+		Mount:         config.Vault.Mount,
+		Namespace:     config.Vault.Namespace,
+		WrapTransport: tracing.WrapTransport(c.ctx, "vault"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &vaultSecretBackend{
+		Client: c,
+		vault:  vaultClient,
+		path:   config.Vault.Path,
+	}, nil
+}
+
+// materializeSecrets copies the secrets a workflow spec references from the secret backend of the namespace
+// to Kubernetes secrets, so its pods can use them. Secrets the backend doesn't have, e.g. the onepanel secret,
+// are left to Kubernetes. Namespaces with Kubernetes secrets have nothing to copy.
+// The copies are deleted once the workflows that use them complete, see releaseMaterializedSecrets.
+func (c *Client) materializeSecrets(namespace string, spec *wfv1.WorkflowSpec) error {
+	backend, err := c.SecretBackend(namespace)
+	if err != nil {
+		return err
+	}
+	if _, ok := backend.(*kubernetesSecretBackend); ok {
+		return nil
+	}
+
+	names, err := workflowSecretNames(spec)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		secret, err := backend.GetSecret(namespace, name)
+		if err != nil {
+			if userErr, ok := err.(*util.UserError); ok && userErr.Code == codes.NotFound {
+				continue
+			}
+			return err
+		}
+
+		if err := c.kubernetesSecrets().materializeSecret(namespace, secret); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// materializeSecret creates or updates the Kubernetes copy of a secret from another backend.
+// Kubernetes secrets that are not copies are not replaced.
+func (c *kubernetesSecretBackend) materializeSecret(namespace string, secret *Secret) error {
+	data := make(map[string][]byte)
+	for key, value := range secret.Data {
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return err
+		}
+		data[key] = decoded
+	}
+	secretType := corev1.SecretType(secret.Type)
+	if secretType == "" {
+		secretType = corev1.SecretTypeOpaque
+	}
+	materializedAt := time.Now().UTC().Format(time.RFC3339)
+
+	copied := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: secret.Name,
+			Labels: map[string]string{
+				secretMaterializedLabel: "true",
+			},
+			Annotations: map[string]string{
+				secretMaterializedAtAnnotation: materializedAt,
+			},
+		},
+		Type: secretType,
+		Data: data,
+	}
+
+	existing, err := c.CoreV1().Secrets(namespace).Get(secret.Name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		_, err = c.CoreV1().Secrets(namespace).Create(copied)
+	case err != nil:
+	case existing.Labels[secretMaterializedLabel] != "true":
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      secret.Name,
+		}).Warn("A Kubernetes secret has the name of a secret in the secret backend, it is used instead.")
+		return nil
+	case existing.Type != secretType:
+		// The type of a secret can't be changed
+		if err = c.CoreV1().Secrets(namespace).Delete(secret.Name, &metav1.DeleteOptions{}); err == nil {
+			_, err = c.CoreV1().Secrets(namespace).Create(copied)
+		}
+	default:
+		if existing.Annotations == nil {
+			existing.Annotations = make(map[string]string)
+		}
+		existing.Annotations[secretMaterializedAtAnnotation] = materializedAt
+		existing.Data = data
+		_, err = c.CoreV1().Secrets(namespace).Update(existing)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      secret.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to copy a secret to Kubernetes.")
+		return util.NewUserError(codes.Unknown, "Unable to make secret '"+secret.Name+"' available to the workflow.")
+	}
+
+	return nil
+}
+
+// deleteMaterializedSecret deletes the Kubernetes copy of a secret from another backend, if there is one
+func (c *kubernetesSecretBackend) deleteMaterializedSecret(namespace, name string) {
+	if c.getMaterializedSecret(namespace, name) == nil {
+		return
+	}
+
+	if err := c.CoreV1().Secrets(namespace).Delete(name, &metav1.DeleteOptions{}); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Error":     err.Error(),
+		}).Error("Unable to delete the Kubernetes copy of a secret.")
+	}
+}
+
+// getMaterializedSecret returns the Kubernetes copy of a secret from another backend, or nil if there is none
+func (c *kubernetesSecretBackend) getMaterializedSecret(namespace, name string) *corev1.Secret {
+	existing, err := c.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil || existing.Labels[secretMaterializedLabel] != "true" {
+		return nil
+	}
+
+	return existing
+}
+
+// releaseMaterializedSecrets deletes the Kubernetes copies of the secrets a completed workflow used, unless
+// workflows that are still running, cron workflows or workspaces that are not terminated use them, or they were
+// copied again after it finished. Workspaces launched by a workflow keep using its copies after it completes.
+// Errors are logged rather than returned, copies that can't be checked are kept.
+func (c *Client) releaseMaterializedSecrets(wf *wfv1.Workflow) {
+	backend, err := c.SecretBackend(wf.Namespace)
+	if err != nil {
+		return
+	}
+	if _, ok := backend.(*kubernetesSecretBackend); ok {
+		return
+	}
+
+	names, err := workflowSecretNames(&wf.Spec)
+	if err != nil || len(names) == 0 {
+		return
+	}
+
+	inUse := make(map[string]bool)
+	workflows, err := c.ArgoprojV1alpha1().Workflows(wf.Namespace).List(metav1.ListOptions{})
+	if err == nil {
+		for i := range workflows.Items {
+			other := &workflows.Items[i]
+			if other.Name == wf.Name || other.Status.Phase.Completed() {
+				continue
+			}
+			otherNames, _ := workflowSecretNames(&other.Spec)
+			for _, name := range otherNames {
+				inUse[name] = true
+			}
+		}
+	}
+	if err == nil {
+		var cronWorkflows *wfv1.CronWorkflowList
+		cronWorkflows, err = c.ArgoprojV1alpha1().CronWorkflows(wf.Namespace).List(metav1.ListOptions{})
+		if err == nil {
+			for i := range cronWorkflows.Items {
+				cronNames, _ := workflowSecretNames(&cronWorkflows.Items[i].Spec.WorkflowSpec)
+				for _, name := range cronNames {
+					inUse[name] = true
+				}
+			}
+		}
+	}
+	if err == nil {
+		var workspaceSecrets map[string][]string
+		_, workspaceSecrets, err = c.getWorkspaceSecretNames(wf.Namespace)
+		for _, workspaceNames := range workspaceSecrets {
+			for _, name := range workspaceNames {
+				inUse[name] = true
+			}
+		}
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": wf.Namespace,
+			"UID":       wf.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to check which secrets are in use, their Kubernetes copies are kept.")
+		return
+	}
+
+	finishedAt := wf.Status.FinishedAt.Time
+	if finishedAt.IsZero() {
+		finishedAt = time.Now()
+	}
+
+	kubernetesSecrets := c.kubernetesSecrets()
+	for _, name := range names {
+		if inUse[name] {
+			continue
+		}
+		existing := kubernetesSecrets.getMaterializedSecret(wf.Namespace, name)
+		if existing == nil {
+			continue
+		}
+		materializedAt, err := time.Parse(time.RFC3339, existing.Annotations[secretMaterializedAtAnnotation])
+		if err == nil && materializedAt.After(finishedAt) {
+			continue
+		}
+
+		kubernetesSecrets.deleteMaterializedSecret(wf.Namespace, name)
+	}
+}
@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// SecretBackendKubernetes stores secrets as Kubernetes secrets, it is the default
+	SecretBackendKubernetes = "kubernetes"
+	// SecretBackendVault stores secrets in a Vault KV version 2 secrets engine
+	SecretBackendVault = "vault"
+
+	// secretBackendConfigKey is the key of the secret backend in the onepanel config map of a namespace
+	secretBackendConfigKey = "secretBackend"
+	// defaultVaultTokenKey is the key of the Vault token in the onepanel secret of a namespace
+	defaultVaultTokenKey = "vaultToken"
+	// secretMaterializedLabel marks the Kubernetes secrets that are copies of secrets in another backend
+	secretMaterializedLabel = "onepanel.io/secret-materialized"
+	// secretMaterializedAtAnnotation is when a Kubernetes copy of a secret was last made, in RFC3339
+	secretMaterializedAtAnnotation = "onepanel.io/secret-materialized-at"
+)
+
+// SecretBackendConfig is the secret backend of a namespace, Kubernetes unless another backend is set.
+// It is the YAML in the secretBackend key of the onepanel config map of the namespace.
+type SecretBackendConfig struct {
+	Vault *VaultSecretBackendConfig `yaml:"vault,omitempty"`
+}
+
+// VaultSecretBackendConfig is where the secrets of a namespace are stored in Vault
+type VaultSecretBackendConfig struct {
+	// Address is the URL of the Vault server, e.g. https://vault.vault:8200
+	Address string `yaml:"address"`
+	// Mount is the path of the KV version 2 secrets engine, secret by default
+	Mount string `yaml:"mount,omitempty"`
+	// Path is the folder of the secrets of the namespace, onepanel/<namespace> by default
+	Path string `yaml:"path,omitempty"`
+	// Namespace is the Vault Enterprise namespace, if any
+	Namespace string `yaml:"namespace,omitempty"`
+	// TokenSecret is the Kubernetes secret with the Vault token, the vaultToken key of the onepanel secret by default
+	TokenSecret ArtifactRepositorySecret `yaml:"tokenSecret,omitempty"`
+}
+
+// Name returns the name of the backend of the config
+func (c *SecretBackendConfig) Name() string {
+	if c.Vault != nil {
+		return SecretBackendVault
+	}
+
+	return SecretBackendKubernetes
+}
+
+// ParseSecretBackendConfig parses the secret backend of a namespace and sets the defaults of its options
+func ParseSecretBackendConfig(namespace, value string) (*SecretBackendConfig, error) {
+	config := &SecretBackendConfig{}
+	if err := yaml.Unmarshal([]byte(value), config); err != nil {
+		return nil, err
+	}
+
+	if config.Vault != nil {
+		if config.Vault.Address == "" {
+			return nil, fmt.Errorf("vault address is required")
+		}
+		if config.Vault.Mount == "" {
+			config.Vault.Mount = "secret"
+		}
+		if config.Vault.Path == "" {
+			config.Vault.Path = "onepanel/" + namespace
+		}
+		config.Vault.Path = strings.Trim(config.Vault.Path, "/")
+		if config.Vault.TokenSecret.Name == "" {
+			config.Vault.TokenSecret.Name = "onepanel"
+		}
+		if config.Vault.TokenSecret.Key == "" {
+			config.Vault.TokenSecret.Key = defaultVaultTokenKey
+		}
+	}
+
+	return config, nil
+}
+
+// workflowSecretNames returns the names of the secrets a workflow spec references, in environment variables,
+// volumes, image pull secrets, artifact repositories and the manifests of resource templates.
+// References to workflow parameters, e.g. {{workflow.parameters.secret}}, are replaced with their values.
+func workflowSecretNames(spec *wfv1.WorkflowSpec) ([]string, error) {
+	content, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal(content, &tree); err != nil {
+		return nil, err
+	}
+
+	parameters := make(map[string]string)
+	for _, parameter := range spec.Arguments.Parameters {
+		if parameter.Value != nil {
+			parameters[parameter.Name] = string(*parameter.Value)
+		}
+	}
+
+	return resolveSecretNames(tree, parameters), nil
+}
+
+// resolveSecretNames returns the names of the secrets referenced in a decoded JSON or YAML tree, in order.
+// References to workflow parameters are replaced with their values in parameters, names that still
+// reference parameters are skipped.
+func resolveSecretNames(tree interface{}, parameters map[string]string) []string {
+	names := make(map[string]bool)
+	collectSecretNames(tree, names)
+
+	replacements := make([]string, 0)
+	for name, value := range parameters {
+		replacements = append(replacements, "{{workflow.parameters."+name+"}}", value)
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool)
+	for name := range names {
+		name = replacer.Replace(name)
+		if name == "" || strings.Contains(name, "{{") || seen[name] {
+			continue
+		}
+		seen[name] = true
+		result = append(result, name)
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+// collectSecretNames adds the names of the secrets referenced in a decoded JSON or YAML tree to names
+func collectSecretNames(tree interface{}, names map[string]bool) {
+	switch v := tree.(type) {
+	case []interface{}:
+		for _, item := range v {
+			collectSecretNames(item, names)
+		}
+	case map[string]interface{}:
+		for key, value := range v {
+			switch key {
+			case "secretKeyRef", "secretRef", "accessKeySecret", "secretKeySecret", "serviceAccountKeySecret":
+				addSecretName(value, "name", names)
+			case "secret":
+				// Volumes use secretName, projected volume sources use name
+				addSecretName(value, "secretName", names)
+				addSecretName(value, "name", names)
+			case "imagePullSecrets":
+				if items, ok := value.([]interface{}); ok {
+					for _, item := range items {
+						addSecretName(item, "name", names)
+					}
+				}
+			case "manifest":
+				if manifest, ok := value.(string); ok {
+					var resource interface{}
+					if err := yaml.Unmarshal([]byte(manifest), &resource); err == nil {
+						collectSecretNames(resource, names)
+					}
+				}
+			}
+			collectSecretNames(value, names)
+		}
+	}
+}
+
+// addSecretName adds the string in the field of value, if it is an object, to names
+func addSecretName(value interface{}, field string, names map[string]bool) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+	if name, ok := object[field].(string); ok && name != "" {
+		names[name] = true
+	}
+}
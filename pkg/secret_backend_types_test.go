@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseSecretBackendConfig(t *testing.T) {
+	config, err := ParseSecretBackendConfig("default", "")
+	assert.Nil(t, err)
+	assert.Equal(t, SecretBackendKubernetes, config.Name())
+
+	config, err = ParseSecretBackendConfig("default", "vault:\n  address: https://vault:8200\n")
+	assert.Nil(t, err)
+	assert.Equal(t, SecretBackendVault, config.Name())
+	assert.Equal(t, "secret", config.Vault.Mount)
+	assert.Equal(t, "onepanel/default", config.Vault.Path)
+	assert.Equal(t, "onepanel", config.Vault.TokenSecret.Name)
+	assert.Equal(t, "vaultToken", config.Vault.TokenSecret.Key)
+
+	_, err = ParseSecretBackendConfig("default", "vault:\n  mount: kv\n")
+	assert.NotNil(t, err)
+}
+
+func TestWorkflowSecretNames(t *testing.T) {
+	spec := &wfv1.WorkflowSpec{
+		Arguments: wfv1.Arguments{
+			Parameters: []wfv1.Parameter{
+				{Name: "credentials", Value: wfv1.AnyStringPtr("aws")},
+			},
+		},
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "registry"}},
+		Volumes: []corev1.Volume{{
+			Name: "ssh",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "git"},
+			},
+		}},
+		Templates: []wfv1.Template{
+			{
+				Name: "main",
+				Container: &corev1.Container{
+					Env: []corev1.EnvVar{{
+						Name: "KEY",
+						ValueFrom: &corev1.EnvVarSource{
+							SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "{{workflow.parameters.credentials}}"},
+								Key:                  "accessKey",
+							},
+						},
+					}},
+				},
+			},
+			{
+				Name: "workspace",
+				Resource: &wfv1.ResourceTemplate{
+					Manifest: "spec:\n  template:\n    spec:\n      containers:\n      - envFrom:\n        - secretRef:\n            name: wandb\n",
+				},
+			},
+		},
+	}
+
+	names, err := workflowSecretNames(spec)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"aws", "git", "registry", "wandb"}, names)
+}
@@ -0,0 +1,464 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// kubernetesSecretBackend stores secrets as Kubernetes secrets in their namespace, it is the default secret backend.
+// Previous versions are kept as secrets with the history label, they are not listed.
+type kubernetesSecretBackend struct {
+	*Client
+}
+
+// kubernetesSecrets returns the Kubernetes secret backend, e.g. for the system secrets that must always be in Kubernetes
+func (c *Client) kubernetesSecrets() *kubernetesSecretBackend {
+	return &kubernetesSecretBackend{Client: c}
+}
+
+func (c *kubernetesSecretBackend) CreateSecret(namespace string, secret *Secret) (err error) {
+	if isSecretHistoryName(secret.Name) {
+		return util.NewUserError(codes.InvalidArgument, "Secret names can't end with .v and a number, they are used for previous versions of secrets.")
+	}
+
+	_, err = c.CoreV1().Secrets(namespace).Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: secret.Name,
+		},
+		Type:       corev1.SecretType(secret.Type),
+		StringData: secret.Data,
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Secret":    secret,
+			"Error":     err.Error(),
+		}).Error("Error creating secret.")
+		return util.NewUserError(codes.Unknown, "Secret was not created.")
+	}
+	return
+}
+
+// getKubernetesSecret returns the Kubernetes secret with name, including its version annotations
+func (c *kubernetesSecretBackend) getKubernetesSecret(namespace, name string) (*corev1.Secret, error) {
+	s, err := c.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Error":     err.Error(),
+		}).Error("Secret not found error.")
+
+		var statusError *errors.StatusError
+		if goerrors.As(err, &statusError) {
+			if statusError.ErrStatus.Reason == "NotFound" {
+				return nil, util.NewUserError(codes.NotFound, "Secret Not Found.")
+			}
+			return nil, util.NewUserError(codes.Unknown, "Error when getting secret.")
+		}
+		return nil, util.NewUserError(codes.Unknown, "Error when getting secret.")
+	}
+	if s == nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Error":     "Secret is nil.",
+		}).Error("Error getting secret.")
+		return nil, util.NewUserError(codes.Unknown, "Error when getting secret.")
+	}
+	if isSecretHistory(s) {
+		return nil, util.NewUserError(codes.NotFound, "Secret Not Found.")
+	}
+
+	return s, nil
+}
+
+func (c *kubernetesSecretBackend) GetSecret(namespace, name string) (secret *Secret, err error) {
+	s, err := c.getKubernetesSecret(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	secret = &Secret{
+		Name:    s.Name,
+		Data:    encodeSecretData(s.Data),
+		Type:    string(s.Type),
+		Version: secretVersion(s),
+	}
+	return
+}
+
+func (c *kubernetesSecretBackend) ListSecrets(namespace string) (secrets []*Secret, err error) {
+	secretsList, err := c.CoreV1().Secrets(namespace).List(metav1.ListOptions{
+		LabelSelector: secretHistoryLabel + "!=true",
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Error":     err.Error(),
+		}).Error("No secrets were found.")
+		return nil, util.NewUserError(codes.NotFound, "No secrets were found.")
+	}
+
+	for _, s := range secretsList.Items {
+		secret := Secret{
+			Name:    s.Name,
+			Type:    string(s.Type),
+			Version: secretVersion(&s),
+		}
+		secrets = append(secrets, &secret)
+	}
+
+	return
+}
+
+// DeleteSecret deletes a secret and its history secrets. Deleted is false if any of its history secrets remain.
+func (c *kubernetesSecretBackend) DeleteSecret(namespace string, name string) (deleted bool, err error) {
+	if _, err := c.getKubernetesSecret(namespace, name); err != nil {
+		return false, err
+	}
+
+	history, err := c.listSecretHistory(namespace, name)
+	if err != nil {
+		return false, err
+	}
+
+	err = c.CoreV1().Secrets(namespace).Delete(name, &metav1.DeleteOptions{})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Error":     err.Error(),
+		}).Error("Unable to delete a secret.")
+		return false, util.NewUserError(codes.Unknown, "Secret unable to be deleted.")
+	}
+
+	remaining := 0
+	for _, previous := range history {
+		if err := c.CoreV1().Secrets(namespace).Delete(previous.Name, &metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      previous.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to delete a previous version of a secret.")
+			remaining++
+		}
+	}
+	if remaining > 0 {
+		return false, util.NewUserError(codes.Unknown, fmt.Sprintf("Secret was deleted, but %v of its previous versions were not, delete it again to retry.", remaining))
+	}
+
+	return true, nil
+}
+
+// jsonPatchOperation is an operation of a JSON patch of a secret
+type jsonPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// listSecretHistory returns the secrets that keep the previous versions of the secret with name, newest first
+func (c *kubernetesSecretBackend) listSecretHistory(namespace, name string) ([]corev1.Secret, error) {
+	secretsList, err := c.CoreV1().Secrets(namespace).List(metav1.ListOptions{
+		LabelSelector: secretHistoryLabel + "=true",
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Error":     err.Error(),
+		}).Error("Unable to list the previous versions of a secret.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to list the versions of the secret.")
+	}
+
+	history := make([]corev1.Secret, 0)
+	for _, s := range secretsList.Items {
+		if s.Annotations[secretHistoryOfAnnotation] == name {
+			history = append(history, s)
+		}
+	}
+	sort.Slice(history, func(i, j int) bool {
+		return secretVersion(&history[i]) > secretVersion(&history[j])
+	})
+
+	return history, nil
+}
+
+// saveSecretVersion keeps the current values of secret so it can be rolled back to its current version
+func (c *kubernetesSecretBackend) saveSecretVersion(namespace string, secret *corev1.Secret) error {
+	version := secretVersion(secret)
+	name := secretHistoryName(secret.Name, version)
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) != 0 {
+		return util.NewUserError(codes.InvalidArgument, "Secret name is too long to keep its versions.")
+	}
+
+	history := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				secretHistoryLabel: "true",
+			},
+			Annotations: map[string]string{
+				secretHistoryOfAnnotation: secret.Name,
+				secretVersionAnnotation:   strconv.Itoa(version),
+				secretUpdatedAtAnnotation: secretUpdatedAt(secret).Format(time.RFC3339),
+			},
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+
+	_, err := c.CoreV1().Secrets(namespace).Create(history)
+	if errors.IsAlreadyExists(err) {
+		// An earlier change failed after its version was kept, replace it with the current values.
+		// Secrets that don't keep a version of this secret are never replaced.
+		existing, getErr := c.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+		if getErr != nil {
+			return getErr
+		}
+		if !isSecretHistory(existing) || existing.Annotations[secretHistoryOfAnnotation] != secret.Name {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      secret.Name,
+				"Version":   version,
+			}).Error("Another secret has the name of the previous version of a secret.")
+			return util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Secret '%v' exists, so the previous version of the secret can't be kept.", name))
+		}
+		existing.Labels = history.Labels
+		existing.Annotations = history.Annotations
+		existing.Data = history.Data
+		_, err = c.CoreV1().Secrets(namespace).Update(existing)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      secret.Name,
+			"Version":   version,
+			"Error":     err.Error(),
+		}).Error("Unable to keep the previous version of a secret.")
+		return util.NewUserError(codes.Unknown, "Unable to keep the previous version of the secret.")
+	}
+
+	return nil
+}
+
+// pruneSecretVersions deletes the oldest previous versions of the secret with name, keeping MaxSecretVersions
+func (c *kubernetesSecretBackend) pruneSecretVersions(namespace, name string) {
+	history, err := c.listSecretHistory(namespace, name)
+	if err != nil || len(history) <= MaxSecretVersions {
+		return
+	}
+
+	for _, previous := range history[MaxSecretVersions:] {
+		if err := c.CoreV1().Secrets(namespace).Delete(previous.Name, &metav1.DeleteOptions{}); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      previous.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to delete a previous version of a secret.")
+		}
+	}
+}
+
+// patchSecret upserts and deletes keys of current in one JSON patch, after keeping its current version.
+// The patch fails if the secret was changed since current was read, so changes are never lost.
+// Deleted keys must exist.
+func (c *kubernetesSecretBackend) patchSecret(namespace string, current *corev1.Secret, upsert map[string][]byte, deletes []string) (*SecretVersion, error) {
+	if err := c.saveSecretVersion(namespace, current); err != nil {
+		return nil, err
+	}
+
+	operations := make([]jsonPatchOperation, 0)
+	if current.ResourceVersion != "" {
+		operations = append(operations, jsonPatchOperation{
+			Op:    "test",
+			Path:  "/metadata/resourceVersion",
+			Value: current.ResourceVersion,
+		})
+	}
+
+	if current.Data == nil {
+		operations = append(operations, jsonPatchOperation{
+			Op:    "add",
+			Path:  "/data",
+			Value: map[string]string{},
+		})
+	}
+	upsertKeys := make([]string, 0, len(upsert))
+	for key := range upsert {
+		upsertKeys = append(upsertKeys, key)
+	}
+	sort.Strings(upsertKeys)
+	for _, key := range upsertKeys {
+		operations = append(operations, jsonPatchOperation{
+			Op:    "add",
+			Path:  "/data/" + escapeJSONPointer(key),
+			Value: base64.StdEncoding.EncodeToString(upsert[key]),
+		})
+	}
+	for _, key := range deletes {
+		operations = append(operations, jsonPatchOperation{
+			Op:   "remove",
+			Path: "/data/" + escapeJSONPointer(key),
+		})
+	}
+
+	annotations := map[string]string{
+		secretVersionAnnotation:   strconv.Itoa(secretVersion(current) + 1),
+		secretUpdatedAtAnnotation: time.Now().UTC().Format(time.RFC3339),
+	}
+	if current.Annotations == nil {
+		operations = append(operations, jsonPatchOperation{
+			Op:    "add",
+			Path:  "/metadata/annotations",
+			Value: annotations,
+		})
+	} else {
+		for _, key := range []string{secretVersionAnnotation, secretUpdatedAtAnnotation} {
+			operations = append(operations, jsonPatchOperation{
+				Op:    "add",
+				Path:  "/metadata/annotations/" + escapeJSONPointer(key),
+				Value: annotations[key],
+			})
+		}
+	}
+
+	payload, err := json.Marshal(operations)
+	if err != nil {
+		return nil, err
+	}
+
+	patched, err := c.CoreV1().Secrets(namespace).Patch(current.Name, types.JSONPatchType, payload)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Secret":    current.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to patch secret.")
+
+		return nil, secretPatchError(err)
+	}
+
+	c.pruneSecretVersions(namespace, current.Name)
+
+	return newSecretVersion(patched, true), nil
+}
+
+// secretPatchError returns the error to report for a secret patch that failed with err.
+// Only a failed test of the patch, or a conflict, means the secret changed and the patch can be retried.
+// Other Invalid errors are validation errors of the patched secret, they are returned with the API server's message.
+func secretPatchError(err error) error {
+	if strings.Contains(err.Error(), "Request entity too large:") ||
+		strings.Contains(err.Error(), "Too long: must have at most") {
+		return util.NewUserError(codes.InvalidArgument, "Value is too long")
+	}
+	if errors.IsConflict(err) || isJSONPatchTestFailure(err) {
+		return util.NewUserError(codes.Aborted, "Secret was changed while it was being updated, try again.")
+	}
+	if errors.IsInvalid(err) {
+		return util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	return util.NewUserError(codes.Unknown, "Unable to update secret.")
+}
+
+// isJSONPatchTestFailure returns true if err is from a JSON patch that could not be applied, e.g. because its test
+// operations failed. The API server reports those as Invalid without the causes validation errors have.
+func isJSONPatchTestFailure(err error) bool {
+	if strings.Contains(strings.ToLower(err.Error()), "testing value") {
+		return true
+	}
+	if !errors.IsInvalid(err) {
+		return false
+	}
+	apiStatus, ok := err.(errors.APIStatus)
+	if !ok {
+		return false
+	}
+	details := apiStatus.Status().Details
+
+	return details == nil || len(details.Causes) == 0
+}
+
+// PatchSecret changes the keys of a secret in one JSON patch, after keeping its current version in a history secret
+func (c *kubernetesSecretBackend) PatchSecret(namespace, name string, patch *SecretPatch) (*SecretVersion, error) {
+	current, err := c.getKubernetesSecret(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range patch.Delete {
+		if _, ok := current.Data[key]; !ok {
+			return nil, util.NewUserError(codes.NotFound, "Key '"+key+"' not found in secret.")
+		}
+	}
+
+	upsert := make(map[string][]byte)
+	for key, value := range patch.Upsert {
+		upsert[key] = []byte(value)
+	}
+
+	return c.patchSecret(namespace, current, upsert, patch.Delete)
+}
+
+// ListSecretVersions returns the current version of a secret, then the versions in its history secrets
+func (c *kubernetesSecretBackend) ListSecretVersions(namespace, name string) ([]*SecretVersion, error) {
+	current, err := c.getKubernetesSecret(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := c.listSecretHistory(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := []*SecretVersion{newSecretVersion(current, true)}
+	for i := range history {
+		versions = append(versions, newSecretVersion(&history[i], false))
+	}
+
+	return versions, nil
+}
+
+// RollbackSecret patches a secret with the keys and values of one of its history secrets
+func (c *kubernetesSecretBackend) RollbackSecret(namespace, name string, version int) (*SecretVersion, error) {
+	current, err := c.getKubernetesSecret(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if version == secretVersion(current) {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Secret is already at version %v.", version))
+	}
+
+	previous, err := c.CoreV1().Secrets(namespace).Get(secretHistoryName(name, version), metav1.GetOptions{})
+	if err != nil || !isSecretHistory(previous) || previous.Annotations[secretHistoryOfAnnotation] != name {
+		return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Version %v of the secret was not found.", version))
+	}
+
+	deletes := make([]string, 0)
+	for key := range current.Data {
+		if _, ok := previous.Data[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	sort.Strings(deletes)
+
+	return c.patchSecret(namespace, current, previous.Data, deletes)
+}
@@ -1,11 +1,36 @@
 package v1
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// testCreateSecretData creates a secret with data, the fake clientset doesn't convert StringData like Kubernetes
+func testCreateSecretData(c *Client, namespace, name string, data map[string]string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Data: map[string][]byte{},
+	}
+	for key, value := range data {
+		secret.Data[key] = []byte(value)
+	}
+
+	_, err := c.CoreV1().Secrets(namespace).Create(secret)
+	return err
+}
+
 func TestClient_CreateSecret(t *testing.T) {
 	c := DefaultTestClient()
 
@@ -29,3 +54,145 @@ func TestClient_GetSecret(t *testing.T) {
 	assert.NotNil(t, s)
 	assert.Equal(t, s.Name, "name")
 }
+
+func TestClient_PatchSecret(t *testing.T) {
+	c := DefaultTestClient()
+
+	err := testCreateSecretData(c, "namespace", "name", map[string]string{
+		"a": "1",
+		"b": "2",
+	})
+	assert.Nil(t, err)
+
+	version, err := c.PatchSecret("namespace", "name", &SecretPatch{
+		Upsert: map[string]string{
+			"a": "10",
+			"c": "3",
+		},
+		Delete: []string{"b"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, version.Version)
+	assert.Equal(t, []string{"a", "c"}, version.Keys)
+
+	// Values of secrets are base64 encoded
+	s, err := c.GetSecret("namespace", "name")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "MTA=", "c": "Mw=="}, s.Data)
+	assert.Equal(t, 2, s.Version)
+
+	_, err = c.PatchSecret("namespace", "name", &SecretPatch{
+		Delete: []string{"missing"},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestClient_RollbackSecret(t *testing.T) {
+	c := DefaultTestClient()
+
+	err := testCreateSecretData(c, "namespace", "name", map[string]string{
+		"a": "1",
+	})
+	assert.Nil(t, err)
+
+	_, err = c.PatchSecret("namespace", "name", &SecretPatch{
+		Upsert: map[string]string{"a": "2", "b": "3"},
+	})
+	assert.Nil(t, err)
+
+	versions, err := c.ListSecretVersions("namespace", "name")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, 2, versions[0].Version)
+	assert.True(t, versions[0].Current)
+	assert.Equal(t, 1, versions[1].Version)
+
+	// History is not listed as secrets
+	secrets, err := c.ListSecrets("namespace")
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 1)
+
+	version, err := c.RollbackSecret("namespace", "name", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, version.Version)
+
+	s, err := c.GetSecret("namespace", "name")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"a": "MQ=="}, s.Data)
+
+	_, err = c.RollbackSecret("namespace", "name", 3)
+	assert.NotNil(t, err)
+	_, err = c.RollbackSecret("namespace", "name", 7)
+	assert.NotNil(t, err)
+}
+
+func TestClient_CreateSecret_HistoryName(t *testing.T) {
+	c := DefaultTestClient()
+
+	err := c.CreateSecret("namespace", &Secret{
+		Name: "name.v1",
+	})
+	assert.NotNil(t, err)
+}
+
+func TestClient_PatchSecret_HistoryNameTaken(t *testing.T) {
+	c := DefaultTestClient()
+
+	err := testCreateSecretData(c, "namespace", "name", map[string]string{"a": "1"})
+	assert.Nil(t, err)
+	// Created directly, the backend doesn't allow the name
+	err = testCreateSecretData(c, "namespace", "name.v1", map[string]string{"b": "2"})
+	assert.Nil(t, err)
+
+	_, err = c.PatchSecret("namespace", "name", &SecretPatch{
+		Upsert: map[string]string{"a": "2"},
+	})
+	assert.NotNil(t, err)
+
+	other, err := c.CoreV1().Secrets("namespace").Get("name.v1", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]byte{"b": []byte("2")}, other.Data)
+}
+
+func TestClient_DeleteSecret(t *testing.T) {
+	c := DefaultTestClient()
+
+	err := testCreateSecretData(c, "namespace", "name", map[string]string{"a": "1"})
+	assert.Nil(t, err)
+	_, err = c.PatchSecret("namespace", "name", &SecretPatch{
+		Upsert: map[string]string{"a": "2"},
+	})
+	assert.Nil(t, err)
+
+	// History secrets can't be used as secrets
+	_, err = c.GetSecret("namespace", "name.v1")
+	assert.NotNil(t, err)
+	_, err = c.kubernetesSecrets().DeleteSecret("namespace", "name.v1")
+	assert.NotNil(t, err)
+
+	deleted, err := c.kubernetesSecrets().DeleteSecret("namespace", "name")
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+
+	secrets, err := c.CoreV1().Secrets("namespace").List(metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, secrets.Items)
+}
+
+func Test_secretPatchError(t *testing.T) {
+	// The API server doesn't keep the reason a JSON patch could not be applied
+	testFailed := errors.NewGenericServerResponse(http.StatusUnprocessableEntity, "patch", schema.GroupResource{Resource: "secrets"}, "aws",
+		"testing value /metadata/annotations/onepanel.io~1secret-version failed", 0, false)
+	assert.Equal(t, codes.Aborted, status.Code(secretPatchError(testFailed)))
+
+	conflict := errors.NewConflict(schema.GroupResource{Resource: "secrets"}, "aws", fmt.Errorf("the object has been modified"))
+	assert.Equal(t, codes.Aborted, status.Code(secretPatchError(conflict)))
+
+	// A patch that breaks the secret can't be fixed by trying again
+	invalid := errors.NewInvalid(schema.GroupKind{Kind: "Secret"}, "registry", field.ErrorList{
+		field.Required(field.NewPath("data").Key(".dockerconfigjson"), ""),
+	})
+	err := secretPatchError(invalid)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, err.Error(), ".dockerconfigjson")
+}
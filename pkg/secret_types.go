@@ -0,0 +1,250 @@
+package v1
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// SecretTypeDockerConfigJSON secrets are registry credentials that can be used as image pull secrets
+	SecretTypeDockerConfigJSON = "dockerconfigjson"
+	// SecretTypeS3 secrets have the accessKey and secretKey of S3 compatible storage
+	SecretTypeS3 = "s3"
+	// SecretTypeGCS secrets have the serviceAccountKey JSON of a Google Cloud service account
+	SecretTypeGCS = "gcs"
+	// SecretTypeSSH secrets have a private key, and optionally known hosts, e.g. to clone git repositories
+	SecretTypeSSH = "ssh"
+
+	// SecretS3AccessKey is the key of the access key in S3 secrets
+	SecretS3AccessKey = "accessKey"
+	// SecretS3SecretKey is the key of the secret key in S3 secrets
+	SecretS3SecretKey = "secretKey"
+	// SecretGCSServiceAccountKey is the key of the service account key in GCS secrets
+	SecretGCSServiceAccountKey = "serviceAccountKey"
+	// SecretSSHKnownHosts is the key of the known hosts in SSH secrets
+	SecretSSHKnownHosts = "known_hosts"
+
+	// MaxSecretVersions is how many previous versions of a secret are kept
+	MaxSecretVersions = 10
+
+	// secretVersionAnnotation is the version of a secret, secrets without it are at version 1
+	secretVersionAnnotation = "onepanel.io/secret-version"
+	// secretUpdatedAtAnnotation is when the version of a secret was created
+	secretUpdatedAtAnnotation = "onepanel.io/secret-updated-at"
+	// secretHistoryLabel marks the secrets that keep previous versions, they are not listed
+	secretHistoryLabel = "onepanel.io/secret-history"
+	// secretHistoryOfAnnotation is the name of the secret a previous version belongs to
+	secretHistoryOfAnnotation = "onepanel.io/secret-history-of"
+)
+
+// SecretPatch changes many keys of a secret at once. Upsert has plain values, they are encoded when the secret is patched.
+type SecretPatch struct {
+	Upsert map[string]string
+	Delete []string
+}
+
+// SecretVersion is a version of a secret, the values of previous versions are kept so they can be rolled back to
+type SecretVersion struct {
+	Version   int
+	Keys      []string
+	CreatedAt time.Time
+	Current   bool
+}
+
+// DockerRegistryCredentials are used to create dockerconfigjson secrets
+type DockerRegistryCredentials struct {
+	Server   string
+	Username string
+	Password string
+	Email    string
+}
+
+// S3Credentials are used to create s3 secrets
+type S3Credentials struct {
+	AccessKey string
+	SecretKey string
+}
+
+// GCSCredentials are used to create gcs secrets
+type GCSCredentials struct {
+	ServiceAccountKey string
+}
+
+// SSHKey is used to create ssh secrets
+type SSHKey struct {
+	PrivateKey string
+	KnownHosts string
+}
+
+// Validate returns an error if there are no changes, a key is invalid or is both upserted and deleted
+func (p *SecretPatch) Validate() error {
+	if len(p.Upsert) == 0 && len(p.Delete) == 0 {
+		return fmt.Errorf("at least one key must be upserted or deleted")
+	}
+
+	for key := range p.Upsert {
+		if errs := validation.IsConfigMapKey(key); len(errs) != 0 {
+			return fmt.Errorf("key '%v' is invalid: %v", key, strings.Join(errs, ", "))
+		}
+	}
+	for _, key := range p.Delete {
+		if _, ok := p.Upsert[key]; ok {
+			return fmt.Errorf("key '%v' can't be both upserted and deleted", key)
+		}
+	}
+
+	return nil
+}
+
+// NewDockerConfigJSONSecret returns a secret with registry credentials, it can be used as an image pull secret
+func NewDockerConfigJSONSecret(name string, credentials *DockerRegistryCredentials) (*Secret, error) {
+	if credentials.Server == "" || credentials.Username == "" || credentials.Password == "" {
+		return nil, fmt.Errorf("server, username and password are required")
+	}
+
+	config := map[string]interface{}{
+		"auths": map[string]interface{}{
+			credentials.Server: map[string]string{
+				"username": credentials.Username,
+				"password": credentials.Password,
+				"email":    credentials.Email,
+				"auth":     base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password)),
+			},
+		},
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Secret{
+		Name: name,
+		Type: string(corev1.SecretTypeDockerConfigJson),
+		Data: map[string]string{
+			corev1.DockerConfigJsonKey: string(data),
+		},
+	}, nil
+}
+
+// NewS3Secret returns a secret with the keys of S3 compatible storage
+func NewS3Secret(name string, credentials *S3Credentials) (*Secret, error) {
+	if credentials.AccessKey == "" || credentials.SecretKey == "" {
+		return nil, fmt.Errorf("access key and secret key are required")
+	}
+
+	return &Secret{
+		Name: name,
+		Type: string(corev1.SecretTypeOpaque),
+		Data: map[string]string{
+			SecretS3AccessKey: credentials.AccessKey,
+			SecretS3SecretKey: credentials.SecretKey,
+		},
+	}, nil
+}
+
+// NewGCSSecret returns a secret with the key of a Google Cloud service account
+func NewGCSSecret(name string, credentials *GCSCredentials) (*Secret, error) {
+	key := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(credentials.ServiceAccountKey), &key); err != nil {
+		return nil, fmt.Errorf("service account key must be JSON")
+	}
+	if key["type"] != "service_account" {
+		return nil, fmt.Errorf("service account key must have the type service_account")
+	}
+
+	return &Secret{
+		Name: name,
+		Type: string(corev1.SecretTypeOpaque),
+		Data: map[string]string{
+			SecretGCSServiceAccountKey: credentials.ServiceAccountKey,
+		},
+	}, nil
+}
+
+// NewSSHSecret returns a secret with an SSH private key and, if given, known hosts
+func NewSSHSecret(name string, key *SSHKey) (*Secret, error) {
+	if !strings.Contains(key.PrivateKey, "PRIVATE KEY-----") {
+		return nil, fmt.Errorf("private key must be PEM encoded")
+	}
+
+	secret := &Secret{
+		Name: name,
+		Type: string(corev1.SecretTypeSSHAuth),
+		Data: map[string]string{
+			corev1.SSHAuthPrivateKey: key.PrivateKey,
+		},
+	}
+	if key.KnownHosts != "" {
+		secret.Data[SecretSSHKnownHosts] = key.KnownHosts
+	}
+
+	return secret, nil
+}
+
+// secretVersion returns the version of a secret
+func secretVersion(secret *corev1.Secret) int {
+	version, err := strconv.Atoi(secret.Annotations[secretVersionAnnotation])
+	if err != nil || version < 1 {
+		return 1
+	}
+
+	return version
+}
+
+// secretUpdatedAt returns when the version of a secret was created
+func secretUpdatedAt(secret *corev1.Secret) time.Time {
+	updatedAt, err := time.Parse(time.RFC3339, secret.Annotations[secretUpdatedAtAnnotation])
+	if err != nil {
+		return secret.CreationTimestamp.UTC()
+	}
+
+	return updatedAt
+}
+
+// secretHistoryName returns the name of the secret that keeps a previous version of the secret with name
+func secretHistoryName(name string, version int) string {
+	return fmt.Sprintf("%v.v%v", name, version)
+}
+
+// secretHistoryNamePattern matches the names secretHistoryName returns
+var secretHistoryNamePattern = regexp.MustCompile(`\.v[0-9]+$`)
+
+// isSecretHistoryName returns true if name could be the name of a secret that keeps a previous version
+func isSecretHistoryName(name string) bool {
+	return secretHistoryNamePattern.MatchString(name)
+}
+
+// isSecretHistory returns true if secret keeps a previous version of another secret
+func isSecretHistory(secret *corev1.Secret) bool {
+	return secret.Labels[secretHistoryLabel] == "true"
+}
+
+// newSecretVersion returns the version of secret, without its values
+func newSecretVersion(secret *corev1.Secret, current bool) *SecretVersion {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return &SecretVersion{
+		Version:   secretVersion(secret),
+		Keys:      keys,
+		CreatedAt: secretUpdatedAt(secret),
+		Current:   current,
+	}
+}
+
+// escapeJSONPointer escapes a key so it can be used in the path of a JSON patch
+func escapeJSONPointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// selectManifestUsages returns the rows of query whose manifests reference the secret with name.
+// The query only selects the manifests that contain the name, they are parsed to check the references.
+func (c *Client) selectManifestUsages(query sq.SelectBuilder, manifestColumn, name, kind string) ([]*SecretReference, error) {
+	usages := make([]*manifestUsage, 0)
+	query = query.Where(sq.Like{manifestColumn: "%" + name + "%"})
+	if err := c.DB.Selectx(&usages, query); err != nil {
+		return nil, err
+	}
+
+	references := make([]*SecretReference, 0)
+	for _, usage := range usages {
+		if referencesSecret(usage.Manifest, name) {
+			references = append(references, &SecretReference{
+				Kind:    kind,
+				UID:     usage.UID,
+				Name:    usage.Name,
+				Version: usage.Version,
+			})
+		}
+	}
+
+	return references, nil
+}
+
+// getWorkspaceSecretNames returns the workspaces that are not terminated and the names of the secrets their
+// stateful sets reference, by workspace uid. Paused workspaces are included, they use the secrets again when
+// they are resumed.
+func (c *Client) getWorkspaceSecretNames(namespace string) ([]*manifestUsage, map[string][]string, error) {
+	workspaces := make([]*manifestUsage, 0)
+	query := sb.Select("uid", "name").
+		From("workspaces").
+		Where(sq.Eq{"namespace": namespace}).
+		Where(sq.NotEq{"phase": WorkspaceTerminated}).
+		OrderBy("name")
+	if err := c.DB.Selectx(&workspaces, query); err != nil {
+		return nil, nil, err
+	}
+	workspaceSecrets := make(map[string][]string)
+	if len(workspaces) == 0 {
+		return workspaces, workspaceSecrets, nil
+	}
+
+	statefulSets, err := c.AppsV1().StatefulSets(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	statefulSetSecrets := make(map[string][]string)
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		names, err := objectSecretNames(statefulSet)
+		if err != nil {
+			return nil, nil, err
+		}
+		statefulSetSecrets[statefulSet.Name] = names
+	}
+	for _, workspace := range workspaces {
+		workspaceSecrets[workspace.UID] = statefulSetSecrets[workspace.UID]
+	}
+
+	return workspaces, workspaceSecrets, nil
+}
+
+// getWorkspaceSecretReferences returns the workspaces that are not terminated whose stateful sets reference
+// the secret with name.
+func (c *Client) getWorkspaceSecretReferences(namespace, name string) ([]*SecretReference, error) {
+	workspaces, workspaceSecrets, err := c.getWorkspaceSecretNames(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	references := make([]*SecretReference, 0)
+	for _, workspace := range workspaces {
+		if containsString(workspaceSecrets[workspace.UID], name) {
+			references = append(references, &SecretReference{
+				Kind: SecretReferenceWorkspace,
+				UID:  workspace.UID,
+				Name: workspace.Name,
+			})
+		}
+	}
+
+	return references, nil
+}
+
+// GetSecretUsage returns the workflow template versions, workspace template versions, cron workflows and workspaces
+// that reference the secret with name. Archived templates and cron workflows, and terminated workspaces, are not
+// included.
+func (c *Client) GetSecretUsage(namespace, name string) (*SecretUsage, error) {
+	workflowTemplates := sb.Select("wt.uid", "wt.name", "wtv.version", "wtv.manifest").
+		From("workflow_template_versions wtv").
+		Join("workflow_templates wt ON wt.id = wtv.workflow_template_id").
+		Where(sq.Eq{
+			"wt.namespace":   namespace,
+			"wt.is_archived": false,
+			// System workflow templates belong to workspace templates, which are checked instead
+			"wt.is_system": false,
+		}).
+		OrderBy("wt.name", "wtv.version DESC")
+
+	workspaceTemplates := sb.Select("wt.uid", "wt.name", "wtv.version", "wtv.manifest").
+		From("workspace_template_versions wtv").
+		Join("workspace_templates wt ON wt.id = wtv.workspace_template_id").
+		Where(sq.Eq{
+			"wt.namespace":   namespace,
+			"wt.is_archived": false,
+		}).
+		OrderBy("wt.name", "wtv.version DESC")
+
+	cronWorkflows := sb.Select("uid", "name", "manifest").
+		From("cron_workflows").
+		Where(sq.Eq{
+			"namespace":   namespace,
+			"is_archived": false,
+		}).
+		OrderBy("name")
+
+	usage := &SecretUsage{
+		Name:       name,
+		References: make([]*SecretReference, 0),
+	}
+	for _, source := range []struct {
+		query          sq.SelectBuilder
+		manifestColumn string
+		kind           string
+	}{
+		{workflowTemplates, "wtv.manifest", SecretReferenceWorkflowTemplate},
+		{workspaceTemplates, "wtv.manifest", SecretReferenceWorkspaceTemplate},
+		{cronWorkflows, "manifest", SecretReferenceCronWorkflow},
+	} {
+		references, err := c.selectManifestUsages(source.query, source.manifestColumn, name, source.kind)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Name":      name,
+				"Kind":      source.kind,
+				"Error":     err.Error(),
+			}).Error("Unable to get the usage of a secret.")
+			return nil, util.NewUserError(codes.Unknown, "Unable to get the usage of the secret.")
+		}
+		usage.References = append(usage.References, references...)
+	}
+
+	references, err := c.getWorkspaceSecretReferences(namespace, name)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Kind":      SecretReferenceWorkspace,
+			"Error":     err.Error(),
+		}).Error("Unable to get the usage of a secret.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get the usage of the secret.")
+	}
+	usage.References = append(usage.References, references...)
+
+	return usage, nil
+}
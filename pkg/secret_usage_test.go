@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const secretUsageWorkflowTemplate = `entrypoint: main
+templates:
+- name: main
+  container:
+    image: alpine
+    envFrom:
+    - secretRef:
+        name: aws
+`
+
+func TestClient_GetSecretUsage(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	err := testCreateSecretData(c, namespace, "aws", map[string]string{"accessKey": "a"})
+	assert.Nil(t, err)
+
+	_, err = c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+		Name:     "uses-aws",
+		Manifest: secretUsageWorkflowTemplate,
+	})
+	assert.Nil(t, err)
+	_, err = c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+		Name:     "default",
+		Manifest: defaultWorkflowTemplate,
+	})
+	assert.Nil(t, err)
+
+	usage, err := c.GetSecretUsage(namespace, "aws")
+	assert.Nil(t, err)
+	assert.Len(t, usage.References, 1)
+	assert.Equal(t, SecretReferenceWorkflowTemplate, usage.References[0].Kind)
+	assert.Equal(t, "uses-aws", usage.References[0].Name)
+
+	_, err = c.DeleteSecret(namespace, "aws", false)
+	assert.NotNil(t, err)
+
+	deleted, err := c.DeleteSecret(namespace, "aws", true)
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// SecretReferenceWorkflowTemplate is a version of a workflow template
+	SecretReferenceWorkflowTemplate = "workflowTemplate"
+	// SecretReferenceWorkspaceTemplate is a version of a workspace template
+	SecretReferenceWorkspaceTemplate = "workspaceTemplate"
+	// SecretReferenceCronWorkflow is a cron workflow, its runs use the secret
+	SecretReferenceCronWorkflow = "cronWorkflow"
+	// SecretReferenceWorkspace is a workspace that is not terminated, its pods use the secret
+	SecretReferenceWorkspace = "workspace"
+)
+
+// SecretReference is a resource that references a secret, in an environment variable, volume or image pull secret
+type SecretReference struct {
+	// Kind is the kind of the resource, e.g. workflowTemplate
+	Kind string
+	UID  string
+	Name string
+	// Version is the version of templates, it is 0 for other resources
+	Version int64
+}
+
+// SecretUsage is the resources that reference a secret
+type SecretUsage struct {
+	Name       string
+	References []*SecretReference
+}
+
+// InUse returns true if any resource references the secret
+func (u *SecretUsage) InUse() bool {
+	return len(u.References) != 0
+}
+
+// Summary describes the resources that reference the secret, e.g. to say why it can't be deleted
+func (u *SecretUsage) Summary() string {
+	if !u.InUse() {
+		return fmt.Sprintf("Secret '%v' is not used.", u.Name)
+	}
+
+	first := u.References[0]
+	if len(u.References) == 1 {
+		return fmt.Sprintf("Secret '%v' is used by %v '%v'.", u.Name, first.Kind, first.Name)
+	}
+
+	return fmt.Sprintf("Secret '%v' is used by %v '%v' and %v other resources.", u.Name, first.Kind, first.Name, len(u.References)-1)
+}
+
+// manifestUsage is a version of a resource whose manifest may reference secrets
+type manifestUsage struct {
+	UID      string
+	Name     string
+	Version  int64
+	Manifest string
+}
+
+// manifestSecretNames returns the names of the secrets a manifest references, e.g. of a workflow template or the
+// workflow spec of a cron workflow. Workflow parameters are replaced with their values in the manifest.
+func manifestSecretNames(manifest string) ([]string, error) {
+	var tree interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &tree); err != nil {
+		return nil, err
+	}
+
+	return resolveSecretNames(tree, manifestParameters(tree)), nil
+}
+
+// objectSecretNames returns the names of the secrets a Kubernetes object, like a stateful set, references
+func objectSecretNames(object interface{}) ([]string, error) {
+	content, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var tree interface{}
+	if err := json.Unmarshal(content, &tree); err != nil {
+		return nil, err
+	}
+
+	return resolveSecretNames(tree, nil), nil
+}
+
+// manifestParameters returns the values of the workflow parameters of a manifest.
+// Cron workflows have their parameters in workflowSpec, and Kubernetes resources in spec.
+func manifestParameters(tree interface{}) map[string]string {
+	parameters := make(map[string]string)
+
+	root, ok := tree.(map[string]interface{})
+	if !ok {
+		return parameters
+	}
+	specs := []interface{}{root, root["workflowSpec"], root["spec"]}
+	for _, spec := range specs {
+		object, ok := spec.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		arguments, ok := object["arguments"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		items, _ := arguments["parameters"].([]interface{})
+		for _, item := range items {
+			parameter, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			name, _ := parameter["name"].(string)
+			if name == "" {
+				continue
+			}
+			if value, ok := parameter["value"]; ok && value != nil {
+				parameters[name] = fmt.Sprint(value)
+			}
+		}
+	}
+
+	return parameters
+}
+
+// referencesSecret returns true if manifest references the secret with name.
+// Manifests that can't be parsed don't reference it.
+func referencesSecret(manifest, name string) bool {
+	names, err := manifestSecretNames(manifest)
+	if err != nil {
+		return false
+	}
+
+	return containsString(names, name)
+}
@@ -0,0 +1,60 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestSecretNames(t *testing.T) {
+	manifest := `entrypoint: main
+arguments:
+  parameters:
+  - name: credentials
+    value: aws
+templates:
+- name: main
+  container:
+    image: alpine
+    env:
+    - name: ACCESS_KEY
+      valueFrom:
+        secretKeyRef:
+          name: "{{workflow.parameters.credentials}}"
+          key: accessKey
+    envFrom:
+    - secretRef:
+        name: wandb
+`
+	names, err := manifestSecretNames(manifest)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"aws", "wandb"}, names)
+
+	// Cron workflows have their parameters in workflowSpec
+	cronManifest := `schedule: "* * * * *"
+workflowSpec:
+  arguments:
+    parameters:
+    - name: registry
+      value: docker-hub
+  imagePullSecrets:
+  - name: "{{workflow.parameters.registry}}"
+`
+	assert.True(t, referencesSecret(cronManifest, "docker-hub"))
+	assert.False(t, referencesSecret(cronManifest, "docker"))
+	assert.False(t, referencesSecret("not: [yaml", "docker-hub"))
+}
+
+func TestSecretUsage_Summary(t *testing.T) {
+	usage := &SecretUsage{Name: "aws"}
+	assert.False(t, usage.InUse())
+	assert.Equal(t, "Secret 'aws' is not used.", usage.Summary())
+
+	usage.References = []*SecretReference{
+		{Kind: SecretReferenceWorkflowTemplate, Name: "train"},
+	}
+	assert.Equal(t, "Secret 'aws' is used by workflowTemplate 'train'.", usage.Summary())
+
+	usage.References = append(usage.References, &SecretReference{Kind: SecretReferenceWorkspace, Name: "cvat"})
+	assert.Equal(t, "Secret 'aws' is used by workflowTemplate 'train' and 1 other resources.", usage.Summary())
+}
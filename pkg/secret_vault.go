@@ -0,0 +1,274 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/vault"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// vaultSecretTypeKey keeps the Kubernetes type of a secret in Vault. Keys of secrets can't have a /, so it
+// can't be one of them.
+const vaultSecretTypeKey = "onepanel.io/type"
+
+// vaultSecretBackend stores the secrets of a namespace in a folder of a Vault KV version 2 secrets engine.
+// Vault keeps the previous versions of secrets, and check-and-set writes make changes atomic.
+type vaultSecretBackend struct {
+	*Client
+	vault *vault.Client
+	// path is the folder of the secrets of the namespace
+	path string
+}
+
+// secretPath returns the path of the secret with name in Vault
+func (b *vaultSecretBackend) secretPath(name string) string {
+	return b.path + "/" + name
+}
+
+// vaultError logs err and returns the user error for it
+func vaultError(namespace, name, message string, err error) error {
+	switch err {
+	case vault.ErrNotFound:
+		return util.NewUserError(codes.NotFound, "Secret Not Found.")
+	case vault.ErrCheckAndSet:
+		return util.NewUserError(codes.Aborted, "Secret was changed while it was being updated, try again.")
+	}
+
+	log.WithFields(log.Fields{
+		"Namespace": namespace,
+		"Name":      name,
+		"Error":     err.Error(),
+	}).Error(message)
+
+	return util.NewUserError(codes.Unavailable, message)
+}
+
+// newVaultSecretVersion returns the version of a secret in Vault, without its values
+func newVaultSecretVersion(secret *vault.Secret, current bool) *SecretVersion {
+	keys := make([]string, 0, len(secret.Data))
+	for key := range secret.Data {
+		if key != vaultSecretTypeKey {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return &SecretVersion{
+		Version:   secret.Metadata.Version,
+		Keys:      keys,
+		CreatedAt: secret.Metadata.CreatedTime.UTC(),
+		Current:   current,
+	}
+}
+
+func (b *vaultSecretBackend) CreateSecret(namespace string, secret *Secret) error {
+	if errs := validation.IsDNS1123Subdomain(secret.Name); len(errs) != 0 {
+		return util.NewUserError(codes.InvalidArgument, "Secret name is invalid: "+strings.Join(errs, ", "))
+	}
+
+	data := make(map[string]string)
+	for key, value := range secret.Data {
+		data[key] = value
+	}
+	if secret.Type != "" {
+		data[vaultSecretTypeKey] = secret.Type
+	}
+
+	// A check-and-set of 0 only writes the secret if it does not exist
+	cas := 0
+	if _, err := b.vault.Write(b.secretPath(secret.Name), data, &cas); err != nil {
+		if err == vault.ErrCheckAndSet {
+			return util.NewUserError(codes.AlreadyExists, "Secret already exists.")
+		}
+		return vaultError(namespace, secret.Name, "Secret was not created.", err)
+	}
+
+	return nil
+}
+
+func (b *vaultSecretBackend) GetSecret(namespace, name string) (*Secret, error) {
+	secret, err := b.vault.Read(b.secretPath(name), 0)
+	if err != nil {
+		return nil, vaultError(namespace, name, "Error when getting secret.", err)
+	}
+
+	data := make(map[string][]byte)
+	for key, value := range secret.Data {
+		if key != vaultSecretTypeKey {
+			data[key] = []byte(value)
+		}
+	}
+
+	return &Secret{
+		Name:    name,
+		Data:    encodeSecretData(data),
+		Type:    secret.Data[vaultSecretTypeKey],
+		Version: secret.Metadata.Version,
+	}, nil
+}
+
+// ListSecrets returns the names of the secrets. Their types and versions would take a request for each secret,
+// so they are not included.
+func (b *vaultSecretBackend) ListSecrets(namespace string) ([]*Secret, error) {
+	keys, err := b.vault.List(b.path)
+	if err != nil {
+		return nil, vaultError(namespace, "", "Unable to list secrets.", err)
+	}
+
+	secrets := make([]*Secret, 0)
+	for _, key := range keys {
+		// Folders end with a /, they are not secrets of the namespace
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+		secrets = append(secrets, &Secret{
+			Name: key,
+		})
+	}
+
+	return secrets, nil
+}
+
+// DeleteSecret deletes the secret, all of its versions and its Kubernetes copy
+func (b *vaultSecretBackend) DeleteSecret(namespace, name string) (bool, error) {
+	if _, err := b.vault.ReadMetadata(b.secretPath(name)); err != nil {
+		return false, vaultError(namespace, name, "Secret unable to be deleted.", err)
+	}
+	if err := b.vault.DeleteMetadata(b.secretPath(name)); err != nil {
+		return false, vaultError(namespace, name, "Secret unable to be deleted.", err)
+	}
+
+	b.kubernetesSecrets().deleteMaterializedSecret(namespace, name)
+
+	return true, nil
+}
+
+// refreshMaterializedSecret copies the current version of a secret to its Kubernetes copy, if there is one,
+// so workflows that start later don't use the values it had when it was copied.
+// If the copy can't be refreshed, it is deleted so it is copied again when a workflow uses it.
+func (b *vaultSecretBackend) refreshMaterializedSecret(namespace, name string) {
+	kubernetesSecrets := b.kubernetesSecrets()
+	if kubernetesSecrets.getMaterializedSecret(namespace, name) == nil {
+		return
+	}
+
+	secret, err := b.GetSecret(namespace, name)
+	if err == nil {
+		err = kubernetesSecrets.materializeSecret(namespace, secret)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Name":      name,
+			"Error":     err.Error(),
+		}).Error("Unable to refresh the Kubernetes copy of a secret, it is deleted instead.")
+		kubernetesSecrets.deleteMaterializedSecret(namespace, name)
+	}
+}
+
+// writeSecretVersion writes data as a new version of the secret, if its current version is still current
+func (b *vaultSecretBackend) writeSecretVersion(namespace, name string, current *vault.Secret, data map[string]string) (*SecretVersion, error) {
+	cas := current.Metadata.Version
+	metadata, err := b.vault.Write(b.secretPath(name), data, &cas)
+	if err != nil {
+		return nil, vaultError(namespace, name, "Unable to update secret.", err)
+	}
+
+	return newVaultSecretVersion(&vault.Secret{
+		Data:     data,
+		Metadata: *metadata,
+	}, true), nil
+}
+
+func (b *vaultSecretBackend) PatchSecret(namespace, name string, patch *SecretPatch) (*SecretVersion, error) {
+	current, err := b.vault.Read(b.secretPath(name), 0)
+	if err != nil {
+		return nil, vaultError(namespace, name, "Error when getting secret.", err)
+	}
+
+	for _, key := range patch.Delete {
+		if _, ok := current.Data[key]; !ok {
+			return nil, util.NewUserError(codes.NotFound, "Key '"+key+"' not found in secret.")
+		}
+	}
+
+	data := make(map[string]string)
+	for key, value := range current.Data {
+		data[key] = value
+	}
+	for key, value := range patch.Upsert {
+		data[key] = value
+	}
+	for _, key := range patch.Delete {
+		delete(data, key)
+	}
+
+	version, err := b.writeSecretVersion(namespace, name, current, data)
+	if err != nil {
+		return nil, err
+	}
+	b.refreshMaterializedSecret(namespace, name)
+
+	return version, nil
+}
+
+// ListSecretVersions returns the versions Vault keeps, up to MaxSecretVersions previous ones.
+// Deleted and destroyed versions are skipped.
+func (b *vaultSecretBackend) ListSecretVersions(namespace, name string) ([]*SecretVersion, error) {
+	metadata, err := b.vault.ReadMetadata(b.secretPath(name))
+	if err != nil {
+		return nil, vaultError(namespace, name, "Unable to list the versions of the secret.", err)
+	}
+
+	versions := make([]*SecretVersion, 0)
+	for _, version := range metadata.Versions {
+		if len(versions) > MaxSecretVersions {
+			break
+		}
+		if version.Destroyed || version.DeletionTime != "" {
+			continue
+		}
+
+		secret, err := b.vault.Read(b.secretPath(name), version.Version)
+		if err == vault.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, vaultError(namespace, name, "Unable to list the versions of the secret.", err)
+		}
+		versions = append(versions, newVaultSecretVersion(secret, version.Version == metadata.CurrentVersion))
+	}
+
+	return versions, nil
+}
+
+func (b *vaultSecretBackend) RollbackSecret(namespace, name string, version int) (*SecretVersion, error) {
+	current, err := b.vault.Read(b.secretPath(name), 0)
+	if err != nil {
+		return nil, vaultError(namespace, name, "Error when getting secret.", err)
+	}
+	if version == current.Metadata.Version {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Secret is already at version %v.", version))
+	}
+
+	previous, err := b.vault.Read(b.secretPath(name), version)
+	if err != nil {
+		if err == vault.ErrNotFound {
+			return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Version %v of the secret was not found.", version))
+		}
+		return nil, vaultError(namespace, name, "Error when getting secret.", err)
+	}
+
+	result, err := b.writeSecretVersion(namespace, name, current, previous.Data)
+	if err != nil {
+		return nil, err
+	}
+	b.refreshMaterializedSecret(namespace, name)
+
+	return result, nil
+}
@@ -0,0 +1,356 @@
+package v1
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/vault"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testVaultClient returns a client whose namespace has its secrets in server
+func testVaultClient(t *testing.T, server *vault.FakeServer) *Client {
+	c := DefaultTestClient()
+
+	_, err := c.CoreV1().ConfigMaps("namespace").Create(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "onepanel",
+		},
+		Data: map[string]string{
+			secretBackendConfigKey: "vault:\n  address: " + server.URL + "\n",
+		},
+	})
+	assert.Nil(t, err)
+
+	_, err = c.CoreV1().Secrets("namespace").Create(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "onepanel",
+		},
+		Data: map[string][]byte{
+			defaultVaultTokenKey: []byte(server.Token),
+		},
+	})
+	assert.Nil(t, err)
+
+	return c
+}
+
+func TestClient_SecretBackend(t *testing.T) {
+	c := DefaultTestClient()
+
+	backend, err := c.SecretBackend("namespace")
+	assert.Nil(t, err)
+	assert.IsType(t, &kubernetesSecretBackend{}, backend)
+
+	server := vault.NewFakeServer("token")
+	defer server.Close()
+
+	backend, err = testVaultClient(t, server).SecretBackend("namespace")
+	assert.Nil(t, err)
+	assert.IsType(t, &vaultSecretBackend{}, backend)
+}
+
+func TestVaultSecretBackend_Secrets(t *testing.T) {
+	server := vault.NewFakeServer("token")
+	defer server.Close()
+	c := testVaultClient(t, server)
+
+	err := c.CreateSecret("namespace", &Secret{
+		Name: "aws",
+		Data: map[string]string{"accessKey": "a", "secretKey": "b"},
+	})
+	assert.Nil(t, err)
+	err = c.CreateSecret("namespace", &Secret{Name: "aws"})
+	assert.NotNil(t, err)
+
+	secret, err := c.GetSecret("namespace", "aws")
+	assert.Nil(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte("a")), secret.Data["accessKey"])
+	assert.Equal(t, 1, secret.Version)
+
+	secrets, err := c.ListSecrets("namespace")
+	assert.Nil(t, err)
+	assert.Len(t, secrets, 1)
+
+	// The secret is not a Kubernetes secret
+	_, err = c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.NotNil(t, err)
+
+	version, err := c.PatchSecret("namespace", "aws", &SecretPatch{
+		Upsert: map[string]string{"accessKey": "c"},
+		Delete: []string{"secretKey"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, version.Version)
+	assert.Equal(t, []string{"accessKey"}, version.Keys)
+
+	versions, err := c.ListSecretVersions("namespace", "aws")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.True(t, versions[0].Current)
+
+	version, err = c.RollbackSecret("namespace", "aws", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, version.Version)
+	assert.Equal(t, []string{"accessKey", "secretKey"}, version.Keys)
+
+	deleted, err := c.DeleteSecret("namespace", "aws", true)
+	assert.Nil(t, err)
+	assert.True(t, deleted)
+	_, err = c.GetSecret("namespace", "aws")
+	assert.NotNil(t, err)
+}
+
+func TestClient_materializeSecrets(t *testing.T) {
+	server := vault.NewFakeServer("token")
+	defer server.Close()
+	c := testVaultClient(t, server)
+
+	err := c.CreateSecret("namespace", &Secret{
+		Name: "aws",
+		Data: map[string]string{"accessKey": "a"},
+	})
+	assert.Nil(t, err)
+
+	spec := &wfv1.WorkflowSpec{
+		Templates: []wfv1.Template{{
+			Name: "main",
+			Container: &corev1.Container{
+				EnvFrom: []corev1.EnvFromSource{
+					{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "aws"}}},
+					{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "onepanel"}}},
+				},
+			},
+		}},
+	}
+	assert.Nil(t, c.materializeSecrets("namespace", spec))
+
+	copied, err := c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "a", string(copied.Data["accessKey"]))
+	assert.Equal(t, "true", copied.Labels[secretMaterializedLabel])
+
+	// Kubernetes secrets with the same name are not replaced
+	system, err := c.CoreV1().Secrets("namespace").Get("onepanel", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, system.Labels[secretMaterializedLabel])
+
+	_, err = c.DeleteSecret("namespace", "aws", true)
+	assert.Nil(t, err)
+	_, err = c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.NotNil(t, err)
+}
+
+func TestVaultSecretBackend_RefreshMaterializedSecret(t *testing.T) {
+	server := vault.NewFakeServer("token")
+	defer server.Close()
+	c := testVaultClient(t, server)
+
+	err := c.CreateSecret("namespace", &Secret{
+		Name: "aws",
+		Data: map[string]string{"accessKey": "a"},
+	})
+	assert.Nil(t, err)
+	err = c.CreateSecret("namespace", &Secret{
+		Name: "gcs",
+		Data: map[string]string{"key": "a"},
+	})
+	assert.Nil(t, err)
+
+	backend, err := c.SecretBackend("namespace")
+	assert.Nil(t, err)
+	secret, err := backend.GetSecret("namespace", "aws")
+	assert.Nil(t, err)
+	assert.Nil(t, c.kubernetesSecrets().materializeSecret("namespace", secret))
+
+	_, err = c.PatchSecret("namespace", "aws", &SecretPatch{
+		Upsert: map[string]string{"accessKey": "b"},
+	})
+	assert.Nil(t, err)
+	copied, err := c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "b", string(copied.Data["accessKey"]))
+
+	_, err = c.RollbackSecret("namespace", "aws", 1)
+	assert.Nil(t, err)
+	copied, err = c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "a", string(copied.Data["accessKey"]))
+
+	// Secrets without a copy are not copied
+	_, err = c.PatchSecret("namespace", "gcs", &SecretPatch{
+		Upsert: map[string]string{"key": "b"},
+	})
+	assert.Nil(t, err)
+	_, err = c.CoreV1().Secrets("namespace").Get("gcs", metav1.GetOptions{})
+	assert.NotNil(t, err)
+}
+
+func TestClient_releaseMaterializedSecrets(t *testing.T) {
+	server := vault.NewFakeServer("token")
+	defer server.Close()
+	c := testVaultClient(t, server)
+
+	specWithSecret := func(name string) wfv1.WorkflowSpec {
+		return wfv1.WorkflowSpec{
+			Templates: []wfv1.Template{{
+				Name: "main",
+				Container: &corev1.Container{
+					EnvFrom: []corev1.EnvFromSource{
+						{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}}},
+					},
+				},
+			}},
+		}
+	}
+
+	for _, name := range []string{"aws", "gcs", "azure"} {
+		err := c.CreateSecret("namespace", &Secret{
+			Name: name,
+			Data: map[string]string{"key": "a"},
+		})
+		assert.Nil(t, err)
+		spec := specWithSecret(name)
+		assert.Nil(t, c.materializeSecrets("namespace", &spec))
+	}
+
+	// gcs is still used by a running workflow and azure by a cron workflow
+	_, err := c.ArgoprojV1alpha1().Workflows("namespace").Create(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "running"},
+		Spec:       specWithSecret("gcs"),
+	})
+	assert.Nil(t, err)
+	_, err = c.ArgoprojV1alpha1().CronWorkflows("namespace").Create(&wfv1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Spec:       wfv1.CronWorkflowSpec{WorkflowSpec: specWithSecret("azure")},
+	})
+	assert.Nil(t, err)
+
+	for _, name := range []string{"aws", "gcs", "azure"} {
+		c.releaseMaterializedSecrets(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "completed-" + name, Namespace: "namespace"},
+			Spec:       specWithSecret(name),
+			Status: wfv1.WorkflowStatus{
+				Phase:      wfv1.NodeSucceeded,
+				FinishedAt: metav1.NewTime(time.Now().Add(time.Minute)),
+			},
+		})
+	}
+
+	_, err = c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.NotNil(t, err)
+	_, err = c.CoreV1().Secrets("namespace").Get("gcs", metav1.GetOptions{})
+	assert.Nil(t, err)
+	_, err = c.CoreV1().Secrets("namespace").Get("azure", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	// Copies made after the workflow finished are kept for the workflow that made them
+	spec := specWithSecret("aws")
+	assert.Nil(t, c.materializeSecrets("namespace", &spec))
+	c.releaseMaterializedSecrets(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "completed-aws", Namespace: "namespace"},
+		Spec:       specWithSecret("aws"),
+		Status: wfv1.WorkflowStatus{
+			Phase:      wfv1.NodeSucceeded,
+			FinishedAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+		},
+	})
+	_, err = c.CoreV1().Secrets("namespace").Get("aws", metav1.GetOptions{})
+	assert.Nil(t, err)
+}
+
+func TestClient_releaseMaterializedSecrets_Workspaces(t *testing.T) {
+	server := vault.NewFakeServer("token")
+	defer server.Close()
+	c := testVaultClient(t, server)
+	clearDatabase(t)
+
+	namespace := "namespace"
+	spec := wfv1.WorkflowSpec{
+		Templates: []wfv1.Template{{
+			Name: "main",
+			Container: &corev1.Container{
+				EnvFrom: []corev1.EnvFromSource{
+					{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "aws"}}},
+				},
+			},
+		}},
+	}
+	err := c.CreateSecret(namespace, &Secret{
+		Name: "aws",
+		Data: map[string]string{"key": "a"},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, c.materializeSecrets(namespace, &spec))
+
+	// The workflow that launched the workspace completed, its stateful set still uses the copy
+	wt, err := c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+		Name:     "workspace",
+		Manifest: defaultWorkflowTemplate,
+	})
+	assert.Nil(t, err)
+	workspaceTemplateID := uint64(0)
+	err = sb.Insert("workspace_templates").
+		SetMap(sq.Eq{
+			"uid":                  "workspace",
+			"name":                 "workspace",
+			"namespace":            namespace,
+			"workflow_template_id": wt.ID,
+		}).
+		Suffix("RETURNING id").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&workspaceTemplateID)
+	assert.Nil(t, err)
+	_, err = sb.Insert("workspaces").
+		SetMap(sq.Eq{
+			"uid":                        "notebook",
+			"name":                       "notebook",
+			"namespace":                  namespace,
+			"phase":                      WorkspaceRunning,
+			"parameters":                 "[]",
+			"workspace_template_id":      workspaceTemplateID,
+			"workspace_template_version": 1,
+		}).
+		RunWith(c.DB).
+		Exec()
+	assert.Nil(t, err)
+	_, err = c.AppsV1().StatefulSets(namespace).Create(&appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "notebook"},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{*spec.Templates[0].Container}},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	completed := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: namespace},
+		Spec:       spec,
+		Status: wfv1.WorkflowStatus{
+			Phase:      wfv1.NodeSucceeded,
+			FinishedAt: metav1.NewTime(time.Now().Add(time.Minute)),
+		},
+	}
+	c.releaseMaterializedSecrets(completed)
+	_, err = c.CoreV1().Secrets(namespace).Get("aws", metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	// Terminated workspaces don't use it anymore
+	_, err = sb.Update("workspaces").
+		Set("phase", WorkspaceTerminated).
+		Where(sq.Eq{"uid": "notebook"}).
+		RunWith(c.DB).
+		Exec()
+	assert.Nil(t, err)
+	c.releaseMaterializedSecrets(completed)
+	_, err = c.CoreV1().Secrets(namespace).Get("aws", metav1.GetOptions{})
+	assert.NotNil(t, err)
+}
@@ -50,6 +50,10 @@ type Namespace struct {
 type Secret struct {
 	Name string
 	Data map[string]string
+	// Type is the Kubernetes type of the secret, e.g. kubernetes.io/dockerconfigjson, empty means Opaque
+	Type string
+	// Version increases each time the keys of the secret are changed
+	Version int
 }
 
 type ConfigMap struct {
@@ -57,9 +61,17 @@ type ConfigMap struct {
 	Data map[string]string
 }
 
+// LogEntry is a line of a log. If the line is structured, like a JSON object, the parsed level, logger, message
+// and remaining fields are set as well.
 type LogEntry struct {
 	Timestamp time.Time
 	Content   string
+	Level     string
+	Logger    string
+	Message   string
+	Fields    map[string]string
+	// ContainerName is the container that logged the entry, set when logs of several containers are merged
+	ContainerName string
 }
 
 // IsEmpty returns true if the content for the log entry is just an empty string
@@ -68,8 +80,9 @@ func (l *LogEntry) IsEmpty() bool {
 }
 
 // LogEntryFromLine creates a LogEntry given a line of text
-// it tries to parse out a timestamp and content
-func LogEntryFromLine(line *string) *LogEntry {
+// it tries to parse out a timestamp and content.
+// The content is then parsed by the first of parsers that recognizes it, DefaultLogParsers are used if none are passed.
+func LogEntryFromLine(line *string, parsers ...LogParser) *LogEntry {
 	if line == nil {
 		return nil
 	}
@@ -83,15 +96,25 @@ func LogEntryFromLine(line *string) *LogEntry {
 		return nil
 	}
 
+	entry := &LogEntry{Content: *line}
+
 	timestamp, err := time.Parse(time.RFC3339, parts[0])
-	if err != nil {
-		return &LogEntry{Content: *line}
+	if err == nil {
+		entry.Timestamp = timestamp
+		entry.Content = strings.Join(parts[1:], " ")
 	}
 
-	return &LogEntry{
-		Timestamp: timestamp,
-		Content:   strings.Join(parts[1:], " "),
+	if len(parsers) == 0 {
+		parsers = DefaultLogParsers
 	}
+
+	for _, parser := range parsers {
+		if parser.Parse(entry) {
+			break
+		}
+	}
+
+	return entry
 }
 
 type Metric struct {
@@ -105,6 +128,7 @@ type WorkflowExecutionStatisticReport struct {
 	Total              int32
 	LastExecuted       *time.Time `db:"last_executed"`
 	Running            int32
+	Queued             int32
 	Completed          int32
 	Failed             int32
 	Terminated         int32
@@ -4,9 +4,11 @@ import (
 	"cloud.google.com/go/storage"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/option"
 	"io"
+	"net/http"
 )
 
 // Client is a struct used for accessing Google Cloud Storage.
@@ -16,7 +18,8 @@ type Client struct {
 
 // NewClient handles the details of initializing the connection to Google Cloud Storage.
 // - Note that the permissions are set to ReadWrite.
-func NewClient(namespace string, serviceAccountJSON string) (gcsClient *Client, err error) {
+// - wrapTransport, if not nil, wraps the transport of the client, e.g. to trace requests.
+func NewClient(namespace string, serviceAccountJSON string, wrapTransport func(http.RoundTripper) http.RoundTripper) (gcsClient *Client, err error) {
 	ctx := context.Background()
 	creds, err := google.CredentialsFromJSON(ctx, []byte(serviceAccountJSON), storage.ScopeReadWrite)
 	if err != nil {
@@ -27,7 +30,12 @@ func NewClient(namespace string, serviceAccountJSON string) (gcsClient *Client,
 		}).Error("GetGCSClient failed when initializing a new GCS client.")
 		return
 	}
-	client, err := storage.NewClient(ctx, option.WithCredentials(creds))
+	clientOption := option.WithCredentials(creds)
+	if wrapTransport != nil {
+		baseClient := &http.Client{Transport: wrapTransport(http.DefaultTransport)}
+		clientOption = option.WithHTTPClient(oauth2.NewClient(context.WithValue(ctx, oauth2.HTTPClient, baseClient), creds.TokenSource))
+	}
+	client, err := storage.NewClient(ctx, clientOption)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
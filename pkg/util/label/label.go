@@ -15,8 +15,22 @@ const (
 	CronWorkflowUid             = OnepanelPrefix + "cron-workflow-uid"
 	Version                     = OnepanelPrefix + "version"
 	VersionLatest               = OnepanelPrefix + "version-latest"
+	ApprovalRequired            = OnepanelPrefix + "approval-required"
+	LogFormat                   = OnepanelPrefix + "log-format"
+	Sweep                       = OnepanelPrefix + "sweep"
 )
 
+// reservedKeys are the keys of labels that only the system sets, like the sweep an execution belongs to
+var reservedKeys = map[string]bool{
+	Sweep: true,
+}
+
+// IsReserved returns true if the key is of a label that only the system sets.
+// Other onepanel.io/ keys are not reserved, as clients already send them.
+func IsReserved(key string) bool {
+	return reservedKeys[key]
+}
+
 // Label represents a Key/Value pair label
 type Label struct {
 	Key   string
@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	k8smetrics "k8s.io/client-go/tools/metrics"
+)
+
+const namespace = "onepanel"
+
+var (
+	grpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "grpc_requests_total",
+		Help:      "Number of gRPC requests by method and response code.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "grpc_request_duration_seconds",
+		Help:      "Duration of gRPC requests by method. For streams, this is how long the stream was open.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	grpcActiveStreams = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "grpc_active_streams",
+		Help:      "Number of open gRPC streams, like logs and watches, by method.",
+	}, []string{"method"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Duration of database queries by operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	kubernetesRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kubernetes_request_duration_seconds",
+		Help:      "Duration of Kubernetes API requests by verb.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"verb"})
+
+	kubernetesRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "kubernetes_requests_total",
+		Help:      "Number of Kubernetes API requests by method and response code.",
+	}, []string{"method", "code"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		grpcRequests,
+		grpcRequestDuration,
+		grpcActiveStreams,
+		dbQueryDuration,
+		kubernetesRequestDuration,
+		kubernetesRequests,
+	)
+
+	k8smetrics.Register(kubernetesLatency{}, kubernetesResult{})
+}
+
+// kubernetesLatency records the latency of requests made by Kubernetes clients
+type kubernetesLatency struct{}
+
+// Observe is called by client-go after each request
+func (kubernetesLatency) Observe(verb string, u url.URL, latency time.Duration) {
+	kubernetesRequestDuration.WithLabelValues(verb).Observe(latency.Seconds())
+}
+
+// kubernetesResult counts the responses to requests made by Kubernetes clients
+type kubernetesResult struct{}
+
+// Increment is called by client-go after each request
+func (kubernetesResult) Increment(code string, method string, host string) {
+	kubernetesRequests.WithLabelValues(method, code).Inc()
+}
+
+// ObserveDBQuery records how long a database query took, from start until now
+func ObserveDBQuery(operation string, start time.Time) {
+	dbQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+}
+
+// UnaryServerInterceptor counts gRPC requests and records their duration
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		grpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor counts gRPC streams, records how long they were open and tracks how many are open
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		activeStreams := grpcActiveStreams.WithLabelValues(info.FullMethod)
+		activeStreams.Inc()
+		defer activeStreams.Dec()
+
+		err := handler(srv, ss)
+
+		grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		grpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+
+		return err
+	}
+}
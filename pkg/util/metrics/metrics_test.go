@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_UnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Test/Unary"}
+
+	_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "Not found.")
+	})
+	assert.NotNil(t, err)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(grpcRequests.WithLabelValues("/api.Test/Unary", "NotFound")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(grpcRequests.WithLabelValues("/api.Test/Unary", "OK")))
+}
+
+func Test_StreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/api.Test/Stream"}
+
+	err := interceptor(nil, nil, info, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, 1.0, testutil.ToFloat64(grpcActiveStreams.WithLabelValues("/api.Test/Stream")))
+		return nil
+	})
+	assert.Nil(t, err)
+
+	assert.Equal(t, 0.0, testutil.ToFloat64(grpcActiveStreams.WithLabelValues("/api.Test/Stream")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(grpcRequests.WithLabelValues("/api.Test/Stream", "OK")))
+}
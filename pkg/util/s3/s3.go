@@ -2,6 +2,7 @@ package s3
 
 import (
 	"io"
+	"net/http"
 
 	minio "github.com/minio/minio-go/v6"
 )
@@ -18,6 +19,8 @@ type Config struct {
 	Endpoint  string
 	Region    string
 	InSecure  bool
+	// WrapTransport, if not nil, wraps the transport of the client, e.g. to trace requests
+	WrapTransport func(http.RoundTripper) http.RoundTripper
 }
 
 func NewClient(config Config) (s3Client *Client, err error) {
@@ -30,6 +33,14 @@ func NewClient(config Config) (s3Client *Client, err error) {
 	if err != nil {
 		return
 	}
+	if config.WrapTransport != nil {
+		var transport http.RoundTripper
+		transport, err = minio.DefaultTransport(!config.InSecure)
+		if err != nil {
+			return
+		}
+		minioClient.SetCustomTransport(config.WrapTransport(transport))
+	}
 	return &Client{Client: minioClient}, nil
 }
 
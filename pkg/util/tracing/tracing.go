@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/onepanelio/core/pkg/util/env"
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/api/global"
+	"go.opentelemetry.io/otel/api/trace"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/propagators"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+)
+
+const (
+	// ServiceName is the name traces are exported under
+	ServiceName = "onepanel-core"
+	// ExporterNone is the default exporter, spans are not recorded or exported
+	ExporterNone = "none"
+	// ExporterOTLP exports spans to an OpenTelemetry collector with OTLP over gRPC
+	ExporterOTLP = "otlp"
+
+	tracerName = "github.com/onepanelio/core"
+)
+
+// Init configures the global tracer provider from the environment and returns a function that flushes
+// and stops it. TRACING_EXPORTER selects the exporter, none by default. With otlp, spans are sent to
+// OTEL_EXPORTER_OTLP_ENDPOINT, over plaintext if OTEL_EXPORTER_OTLP_INSECURE is true.
+func Init(ctx context.Context) (shutdown func(context.Context) error, err error) {
+	global.SetTextMapPropagator(otel.NewCompositeTextMapPropagator(propagators.TraceContext{}, propagators.Baggage{}))
+
+	exporter := env.Get("TRACING_EXPORTER", ExporterNone)
+	if exporter != ExporterOTLP {
+		if exporter != ExporterNone {
+			log.Printf("[error] unknown tracing exporter %v, tracing is disabled", exporter)
+		}
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlp.ExporterOption{
+		otlp.WithAddress(env.Get("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4317")),
+	}
+	if env.Get("OTEL_EXPORTER_OTLP_INSECURE", "false") == "true" {
+		options = append(options, otlp.WithInsecure())
+	}
+
+	otlpExporter, err := otlp.NewExporter(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	processor := sdktrace.NewBatchSpanProcessor(otlpExporter)
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(sdkresource.New(semconv.ServiceNameKey.String(ServiceName))),
+	)
+	global.SetTracerProvider(provider)
+
+	return func(ctx context.Context) error {
+		// Shutting down the processor flushes the spans that are left to the exporter
+		processor.Shutdown()
+		return otlpExporter.Shutdown(ctx)
+	}, nil
+}
+
+// Start starts a span that is a child of the span in ctx, if any
+func Start(ctx context.Context, name string, opts ...trace.SpanOption) (context.Context, trace.Span) {
+	return global.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// EndSpan records err on span, if it is not nil, and ends span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(context.Background(), err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
+
+// parentTransport makes the span in parent the parent of requests that don't already have a span.
+// Only the span is taken from parent, so the request is not cancelled with it.
+type parentTransport struct {
+	parent context.Context
+	base   http.RoundTripper
+}
+
+// RoundTrip sets the parent span of the request and passes it on to the base transport
+func (t *parentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.parent != nil && !trace.SpanFromContext(req.Context()).SpanContext().IsValid() {
+		if span := trace.SpanFromContext(t.parent); span.SpanContext().IsValid() {
+			req = req.WithContext(trace.ContextWithSpan(req.Context(), span))
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// NewTransport wraps base, or http.DefaultTransport if it is nil, so each request is traced as a span named after
+// operation and the method. Requests made without a span in their context become children of the span in parent.
+func NewTransport(parent context.Context, operation string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	traced := otelhttp.NewTransport(base, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return operation + " " + r.Method
+	}))
+
+	return &parentTransport{
+		parent: parent,
+		base:   traced,
+	}
+}
+
+// WrapTransport returns a function that wraps transports with NewTransport, for Kubernetes rest configs
+func WrapTransport(parent context.Context, operation string) func(http.RoundTripper) http.RoundTripper {
+	return func(base http.RoundTripper) http.RoundTripper {
+		return NewTransport(parent, operation, base)
+	}
+}
@@ -0,0 +1,74 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/api/global"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/export/trace/tracetest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// useTestTracerProvider records spans in memory until the test finishes
+func useTestTracerProvider(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := global.TracerProvider()
+	global.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() {
+		global.SetTracerProvider(previous)
+	})
+
+	return exporter
+}
+
+func Test_Init_NoExporter(t *testing.T) {
+	shutdown, err := Init(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, shutdown(context.Background()))
+}
+
+func Test_NewTransport(t *testing.T) {
+	exporter := useTestTracerProvider(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("traceparent"))
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	parent, span := Start(context.Background(), "request")
+	client := &http.Client{Transport: NewTransport(parent, "s3", nil)}
+
+	resp, err := client.Get(server.URL)
+	assert.Nil(t, err)
+	_, err = ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	span.End()
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "s3 GET", spans[0].Name)
+		assert.Equal(t, span.SpanContext().TraceID, spans[0].SpanContext.TraceID)
+		assert.Equal(t, span.SpanContext().SpanID, spans[0].ParentSpanID)
+	}
+}
+
+func Test_EndSpan(t *testing.T) {
+	exporter := useTestTracerProvider(t)
+
+	_, span := Start(context.Background(), "query")
+	EndSpan(span, errors.New("failed"))
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, otelcodes.Error, spans[0].StatusCode)
+		assert.Equal(t, "failed", spans[0].StatusMessage)
+	}
+}
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeServer is an in-memory KV version 2 secrets engine, mounted at secret, for tests
+type FakeServer struct {
+	*httptest.Server
+	Token string
+
+	mutex   sync.Mutex
+	secrets map[string][]fakeVersion
+}
+
+// fakeVersion is a version of a secret in a FakeServer
+type fakeVersion struct {
+	data      map[string]string
+	createdAt time.Time
+}
+
+// NewFakeServer starts a FakeServer that accepts token, close it when done
+func NewFakeServer(token string) *FakeServer {
+	s := &FakeServer{
+		Token:   token,
+		secrets: make(map[string][]fakeVersion),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+// Config returns the config of a client of the server
+func (s *FakeServer) Config() Config {
+	return Config{
+		Address: s.URL,
+		Token:   s.Token,
+		Mount:   "secret",
+	}
+}
+
+func (s *FakeServer) respond(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if data != nil {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}
+}
+
+func (s *FakeServer) fail(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{message}})
+}
+
+func (s *FakeServer) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Vault-Token") != s.Token {
+		s.fail(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/data/"):
+		s.handleData(w, r, strings.TrimPrefix(r.URL.Path, "/v1/secret/data/"))
+	case strings.HasPrefix(r.URL.Path, "/v1/secret/metadata/"):
+		s.handleMetadata(w, r, strings.TrimPrefix(r.URL.Path, "/v1/secret/metadata/"))
+	default:
+		s.fail(w, http.StatusNotFound, "no handler for route")
+	}
+}
+
+func (s *FakeServer) handleData(w http.ResponseWriter, r *http.Request, path string) {
+	versions := s.secrets[path]
+
+	switch r.Method {
+	case http.MethodGet:
+		number := len(versions)
+		if value := r.URL.Query().Get("version"); value != "" {
+			number, _ = strconv.Atoi(value)
+		}
+		if number < 1 || number > len(versions) {
+			s.fail(w, http.StatusNotFound, "")
+			return
+		}
+		version := versions[number-1]
+		s.respond(w, http.StatusOK, map[string]interface{}{
+			"data": version.data,
+			"metadata": map[string]interface{}{
+				"version":       number,
+				"created_time":  version.createdAt,
+				"deletion_time": "",
+				"destroyed":     false,
+			},
+		})
+	case http.MethodPost, http.MethodPut:
+		body := struct {
+			Data    map[string]string `json:"data"`
+			Options struct {
+				Cas *int `json:"cas"`
+			} `json:"options"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			s.fail(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body.Options.Cas != nil && *body.Options.Cas != len(versions) {
+			s.fail(w, http.StatusBadRequest, "check-and-set parameter did not match the current version")
+			return
+		}
+		version := fakeVersion{data: body.Data, createdAt: time.Now().UTC()}
+		s.secrets[path] = append(versions, version)
+		s.respond(w, http.StatusOK, map[string]interface{}{
+			"version":       len(s.secrets[path]),
+			"created_time":  version.createdAt,
+			"deletion_time": "",
+			"destroyed":     false,
+		})
+	default:
+		s.fail(w, http.StatusMethodNotAllowed, "")
+	}
+}
+
+func (s *FakeServer) handleMetadata(w http.ResponseWriter, r *http.Request, path string) {
+	if r.Method == "LIST" || r.URL.Query().Get("list") == "true" {
+		prefix := strings.TrimSuffix(path, "/") + "/"
+		keys := make(map[string]bool)
+		for name := range s.secrets {
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			key := strings.TrimPrefix(name, prefix)
+			if i := strings.Index(key, "/"); i != -1 {
+				key = key[:i+1]
+			}
+			keys[key] = true
+		}
+		if len(keys) == 0 {
+			s.fail(w, http.StatusNotFound, "")
+			return
+		}
+		result := make([]string, 0, len(keys))
+		for key := range keys {
+			result = append(result, key)
+		}
+		sort.Strings(result)
+		s.respond(w, http.StatusOK, map[string]interface{}{"keys": result})
+		return
+	}
+
+	versions, ok := s.secrets[path]
+	if !ok {
+		s.fail(w, http.StatusNotFound, "")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		result := make(map[string]interface{})
+		for i, version := range versions {
+			result[strconv.Itoa(i+1)] = map[string]interface{}{
+				"created_time":  version.createdAt,
+				"deletion_time": "",
+				"destroyed":     false,
+			}
+		}
+		s.respond(w, http.StatusOK, map[string]interface{}{
+			"current_version": len(versions),
+			"versions":        result,
+		})
+	case http.MethodDelete:
+		delete(s.secrets, path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.fail(w, http.StatusMethodNotAllowed, "")
+	}
+}
@@ -0,0 +1,274 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when a secret, or a version of it, does not exist or was deleted
+	ErrNotFound = errors.New("vault: secret not found")
+	// ErrCheckAndSet is returned when a secret was written to since the version a write was based on
+	ErrCheckAndSet = errors.New("vault: check-and-set parameter did not match the current version")
+)
+
+// Config is the configuration of a client of a KV version 2 secrets engine
+type Config struct {
+	// Address is the URL of the Vault server, e.g. https://vault.vault:8200
+	Address string
+	Token   string
+	// Mount is the path the KV version 2 secrets engine is mounted at, e.g. secret
+	Mount string
+	// Namespace is the Vault Enterprise namespace, if any
+	Namespace string
+	Timeout   time.Duration
+	// WrapTransport, if not nil, wraps the transport of the client, e.g. to trace requests
+	WrapTransport func(http.RoundTripper) http.RoundTripper
+}
+
+// Client reads and writes secrets of a KV version 2 secrets engine over the HTTP API of Vault
+type Client struct {
+	config     Config
+	httpClient *http.Client
+}
+
+// Secret is a version of a secret
+type Secret struct {
+	Data     map[string]string
+	Metadata VersionMetadata
+}
+
+// VersionMetadata describes a version of a secret
+type VersionMetadata struct {
+	Version      int       `json:"version"`
+	CreatedTime  time.Time `json:"created_time"`
+	DeletionTime string    `json:"deletion_time"`
+	Destroyed    bool      `json:"destroyed"`
+}
+
+// Metadata describes a secret and all of its versions
+type Metadata struct {
+	CurrentVersion int
+	// Versions are ordered from the newest to the oldest
+	Versions []VersionMetadata
+}
+
+// NewClient returns a client of the KV version 2 secrets engine in config
+func NewClient(config Config) (*Client, error) {
+	if config.Address == "" {
+		return nil, fmt.Errorf("vault: address is required")
+	}
+	if config.Mount == "" {
+		config.Mount = "secret"
+	}
+	config.Address = strings.TrimSuffix(config.Address, "/")
+	config.Mount = strings.Trim(config.Mount, "/")
+	if config.Timeout == 0 {
+		config.Timeout = 10 * time.Second
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if config.WrapTransport != nil {
+		transport = config.WrapTransport(transport)
+	}
+
+	return &Client{
+		config: config,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   config.Timeout,
+		},
+	}, nil
+}
+
+// apiError is the body of the responses of Vault that are errors
+type apiError struct {
+	Errors []string `json:"errors"`
+}
+
+// do sends a request to the path of the API, relative to /v1, and decodes the data of the response into result.
+// Responses with status 404 return ErrNotFound.
+func (c *Client) do(method, path string, body interface{}, result interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequest(method, c.config.Address+"/v1/"+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.config.Token)
+	if c.config.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", c.config.Namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	content, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if res.StatusCode >= 400 {
+		apiErr := &apiError{}
+		_ = json.Unmarshal(content, apiErr)
+		message := strings.Join(apiErr.Errors, "; ")
+		if strings.Contains(message, "check-and-set parameter did not match") {
+			return ErrCheckAndSet
+		}
+		return fmt.Errorf("vault: %v %v returned %v: %v", method, path, res.StatusCode, message)
+	}
+
+	if result == nil || len(content) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(content, &struct {
+		Data interface{} `json:"data"`
+	}{Data: result})
+}
+
+// escapePath escapes each segment of a secret path
+func escapePath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func (c *Client) dataPath(path string) string {
+	return c.config.Mount + "/data/" + escapePath(path)
+}
+
+func (c *Client) metadataPath(path string) string {
+	return c.config.Mount + "/metadata/" + escapePath(path)
+}
+
+// Read returns a version of the secret at path, version 0 is the current version
+func (c *Client) Read(path string, version int) (*Secret, error) {
+	requestPath := c.dataPath(path)
+	if version > 0 {
+		requestPath += "?version=" + strconv.Itoa(version)
+	}
+
+	result := &struct {
+		Data     map[string]string `json:"data"`
+		Metadata VersionMetadata   `json:"metadata"`
+	}{}
+	if err := c.do(http.MethodGet, requestPath, nil, result); err != nil {
+		return nil, err
+	}
+	if result.Metadata.Destroyed || result.Metadata.DeletionTime != "" {
+		return nil, ErrNotFound
+	}
+
+	return &Secret{
+		Data:     result.Data,
+		Metadata: result.Metadata,
+	}, nil
+}
+
+// Write creates a new version of the secret at path with data.
+// If cas is not nil, the write only succeeds if the current version is *cas, 0 means the secret must not exist.
+func (c *Client) Write(path string, data map[string]string, cas *int) (*VersionMetadata, error) {
+	body := map[string]interface{}{
+		"data": data,
+	}
+	if cas != nil {
+		body["options"] = map[string]interface{}{
+			"cas": *cas,
+		}
+	}
+
+	result := &VersionMetadata{}
+	if err := c.do(http.MethodPost, c.dataPath(path), body, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// List returns the names of the secrets and folders under path, folders end with /
+func (c *Client) List(path string) ([]string, error) {
+	result := &struct {
+		Keys []string `json:"keys"`
+	}{}
+	err := c.do("LIST", c.metadataPath(path)+"/", nil, result)
+	if err == ErrNotFound {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Keys, nil
+}
+
+// ReadMetadata returns the current version and the versions of the secret at path
+func (c *Client) ReadMetadata(path string) (*Metadata, error) {
+	result := &struct {
+		CurrentVersion int `json:"current_version"`
+		Versions       map[string]struct {
+			CreatedTime  time.Time `json:"created_time"`
+			DeletionTime string    `json:"deletion_time"`
+			Destroyed    bool      `json:"destroyed"`
+		} `json:"versions"`
+	}{}
+	if err := c.do(http.MethodGet, c.metadataPath(path), nil, result); err != nil {
+		return nil, err
+	}
+
+	metadata := &Metadata{
+		CurrentVersion: result.CurrentVersion,
+		Versions:       make([]VersionMetadata, 0, len(result.Versions)),
+	}
+	for key, version := range result.Versions {
+		number, err := strconv.Atoi(key)
+		if err != nil {
+			continue
+		}
+		metadata.Versions = append(metadata.Versions, VersionMetadata{
+			Version:      number,
+			CreatedTime:  version.CreatedTime,
+			DeletionTime: version.DeletionTime,
+			Destroyed:    version.Destroyed,
+		})
+	}
+	sort.Slice(metadata.Versions, func(i, j int) bool {
+		return metadata.Versions[i].Version > metadata.Versions[j].Version
+	})
+
+	return metadata, nil
+}
+
+// DeleteMetadata permanently deletes the secret at path and all of its versions
+func (c *Client) DeleteMetadata(path string) error {
+	return c.do(http.MethodDelete, c.metadataPath(path), nil, nil)
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_WriteRead(t *testing.T) {
+	server := NewFakeServer("token")
+	defer server.Close()
+
+	c, err := NewClient(server.Config())
+	assert.Nil(t, err)
+
+	cas := 0
+	metadata, err := c.Write("onepanel/default/a", map[string]string{"key": "1"}, &cas)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, metadata.Version)
+
+	_, err = c.Write("onepanel/default/a", map[string]string{"key": "2"}, &cas)
+	assert.Equal(t, ErrCheckAndSet, err)
+
+	cas = 1
+	_, err = c.Write("onepanel/default/a", map[string]string{"key": "2"}, &cas)
+	assert.Nil(t, err)
+
+	secret, err := c.Read("onepanel/default/a", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "2", secret.Data["key"])
+	assert.Equal(t, 2, secret.Metadata.Version)
+
+	secret, err = c.Read("onepanel/default/a", 1)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", secret.Data["key"])
+
+	_, err = c.Read("onepanel/default/b", 0)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestClient_ListMetadata(t *testing.T) {
+	server := NewFakeServer("token")
+	defer server.Close()
+
+	c, err := NewClient(server.Config())
+	assert.Nil(t, err)
+
+	keys, err := c.List("onepanel/default")
+	assert.Nil(t, err)
+	assert.Empty(t, keys)
+
+	_, err = c.Write("onepanel/default/a", map[string]string{"key": "1"}, nil)
+	assert.Nil(t, err)
+	_, err = c.Write("onepanel/default/a", map[string]string{"key": "2"}, nil)
+	assert.Nil(t, err)
+	_, err = c.Write("onepanel/default/nested/b", map[string]string{"key": "1"}, nil)
+	assert.Nil(t, err)
+
+	keys, err = c.List("onepanel/default")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"a", "nested/"}, keys)
+
+	metadata, err := c.ReadMetadata("onepanel/default/a")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, metadata.CurrentVersion)
+	assert.Equal(t, 2, metadata.Versions[0].Version)
+
+	assert.Nil(t, c.DeleteMetadata("onepanel/default/a"))
+	_, err = c.Read("onepanel/default/a", 0)
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestClient_Token(t *testing.T) {
+	server := NewFakeServer("token")
+	defer server.Close()
+
+	config := server.Config()
+	config.Token = "wrong"
+	c, err := NewClient(config)
+	assert.Nil(t, err)
+
+	_, err = c.Read("onepanel/default/a", 0)
+	assert.NotNil(t, err)
+	assert.NotEqual(t, ErrNotFound, err)
+}
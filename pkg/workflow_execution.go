@@ -1,7 +1,6 @@
 package v1
 
 import (
-	"bufio"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -46,6 +45,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/util/retry"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 )
 
@@ -98,8 +98,9 @@ func typeWorkflow(wf *wfv1.Workflow) (workflow *WorkflowExecution) {
 
 // WorkflowExecutionFilter represents the available ways we can filter WorkflowExecutions
 type WorkflowExecutionFilter struct {
-	Labels []*Label
-	Phase  string // empty string means none
+	Labels    []*Label
+	Phase     string           // empty string means none
+	Ownership *OwnershipFilter // nil means all workflow executions, regardless of their visibility
 }
 
 // GetLabels returns the labels in the filter
@@ -117,6 +118,10 @@ func applyWorkflowExecutionFilter(sb sq.SelectBuilder, request *request.Request)
 		return sb, nil
 	}
 
+	if filter.Ownership != nil {
+		sb = filter.Ownership.apply(sb, "we")
+	}
+
 	// template, name are reserved labels.
 	// we query the columns on the appropriate tables instead
 	finalLabels := make([]*Label, 0)
@@ -455,6 +460,9 @@ func (c *Client) ArchiveWorkflowExecution(namespace, uid string) error {
 		return err
 	}
 
+	// The pods and events the timeline is built from are deleted with the workflow
+	c.archiveCompletedWorkflowExecutionStatus(namespace, uid)
+
 	err = c.ArgoprojV1alpha1().Workflows(namespace).Delete(uid, nil)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
@@ -508,6 +516,9 @@ func (c *Client) createWorkflow(namespace string, workflowTemplateID uint64, wor
 	if opts.Labels != nil {
 		wf.ObjectMeta.Labels = opts.Labels
 	}
+	if opts.Queued {
+		wf.Spec.Suspend = ptr.Bool(true)
+	}
 
 	newParameters := make([]wfv1.Parameter, 0)
 
@@ -569,6 +580,11 @@ func (c *Client) createWorkflow(namespace string, workflowTemplateID uint64, wor
 		return nil, err
 	}
 	wf.Spec.Templates = newTemplateOrder
+
+	if err = c.materializeSecrets(namespace, &wf.Spec); err != nil {
+		return nil, err
+	}
+
 	createdArgoWorkflow, err := c.ArgoprojV1alpha1().Workflows(namespace).Create(wf)
 	if err != nil {
 		return nil, err
@@ -583,6 +599,12 @@ func (c *Client) createWorkflow(namespace string, workflowTemplateID uint64, wor
 		},
 		Parameters: opts.Parameters,
 		Labels:     labels,
+		Priority:   opts.Priority,
+		Ownership:  opts.Ownership,
+	}
+
+	if opts.Queued {
+		createdWorkflow.Phase = WorkflowExecutionPhaseQueued
 	}
 
 	if err = createdWorkflow.GenerateUID(createdArgoWorkflow.Name); err != nil {
@@ -879,6 +901,10 @@ func (c *Client) ValidateWorkflowExecution(namespace string, manifest []byte) (e
 			return
 		}
 
+		if err = validateLogFormats(&wf); err != nil {
+			return
+		}
+
 		// Check that entrypoint and onExit templates are DAGs
 		for _, t := range wf.Spec.Templates {
 			if t.Name == wf.Spec.Entrypoint && t.DAG == nil {
@@ -898,6 +924,10 @@ func (c *Client) ValidateWorkflowExecution(namespace string, manifest []byte) (e
 // If workflow.Name is set, it is used instead of a generated name.
 // If there is a parameter named "workflow-execution-name" in workflow.Parameters, it is set as the name.
 func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate) (*WorkflowExecution, error) {
+	if err := workflow.Ownership.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
 	opts := &WorkflowExecutionOptions{
 		Labels:     make(map[string]string),
 		Parameters: workflow.Parameters,
@@ -917,10 +947,20 @@ func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExe
 	}
 	opts.GenerateName = nameUID + "-"
 	opts.WorkflowTemplateUID = workflowTemplate.UID
+	opts.Priority = workflow.Priority
+	opts.Ownership = workflow.Ownership
+	opts.Queued = workflowTemplate.Concurrency.IsEnabled()
 
 	opts.Labels[workflowTemplateUIDLabelKey] = workflowTemplate.UID
 	opts.Labels[workflowTemplateVersionLabelKey] = fmt.Sprint(workflowTemplate.Version)
-	label.MergeLabelsPrefix(opts.Labels, workflow.Labels, label.TagPrefix)
+	for key, value := range workflow.Labels {
+		// Reserved labels, like the sweep label, are set by the system and keep their key
+		if label.IsReserved(key) {
+			opts.Labels[key] = value
+			continue
+		}
+		opts.Labels[label.TagPrefix+key] = value
+	}
 
 	workflows, err := getWorkflowsFromWorkflowTemplate(workflowTemplate)
 	if err != nil {
@@ -945,17 +985,26 @@ func (c *Client) CreateWorkflowExecution(namespace string, workflow *WorkflowExe
 	workflow.Name = createdWorkflow.Name
 	workflow.CreatedAt = createdWorkflow.CreatedAt.UTC()
 	workflow.UID = createdWorkflow.UID
+	workflow.Phase = createdWorkflow.Phase
 	workflow.WorkflowTemplate = workflowTemplate
 
+	// The execution exists at this point, if the queue can't be released now it is on the next release
+	if opts.Queued {
+		c.releaseQueuedWorkflowExecutions(namespace)
+	}
+
 	return workflow, nil
 }
 
-func (c *Client) CloneWorkflowExecution(namespace, uid string) (*WorkflowExecution, error) {
+// CloneWorkflowExecution creates a copy of the workflow execution with uid, owned by owner.
+// The copy keeps the visibility of the original.
+func (c *Client) CloneWorkflowExecution(namespace, uid, owner string) (*WorkflowExecution, error) {
 	// TODO do you need the and template here?
 	workflowExecution, err := c.getWorkflowExecutionAndTemplate(namespace, uid)
 	if err != nil {
 		return nil, err
 	}
+	workflowExecution.Owner = owner
 
 	workflowTemplate, err := c.GetWorkflowTemplate(namespace, workflowExecution.WorkflowTemplate.UID, workflowExecution.WorkflowTemplate.Version)
 	if err != nil {
@@ -990,6 +1039,11 @@ func (c *Client) createWorkflowExecutionDB(namespace string, workflowExecution *
 		return err
 	}
 
+	phase := workflowExecution.Phase
+	if phase == "" {
+		phase = wfv1.NodePending
+	}
+
 	err = sb.Insert("workflow_executions").
 		SetMap(sq.Eq{
 			"UID":                          workflowExecution.UID,
@@ -997,11 +1051,16 @@ func (c *Client) createWorkflowExecutionDB(namespace string, workflowExecution *
 			"name":                         workflowExecution.Name,
 			"namespace":                    namespace,
 			"created_at":                   workflowExecution.CreatedAt.UTC(),
-			"phase":                        wfv1.NodePending,
+			"phase":                        phase,
 			"parameters":                   string(parametersJSON),
 			"is_archived":                  false,
 			"labels":                       workflowExecution.Labels,
 			"metrics":                      workflowExecution.Metrics,
+			"priority":                     workflowExecution.Priority,
+			"owner":                        workflowExecution.Owner,
+			"visibility":                   workflowExecution.Visibility,
+			"shared_users":                 workflowExecution.SharedUsers,
+			"shared_groups":                workflowExecution.SharedGroups,
 		}).
 		Suffix("RETURNING id").
 		RunWith(c.DB).
@@ -1026,8 +1085,13 @@ func (c *Client) FinishWorkflowExecutionStatisticViaExitHandler(namespace, name
 		}).
 		RunWith(c.DB).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	c.releaseQueuedWorkflowExecutions(namespace)
+
+	return nil
 }
 
 func (c *Client) CronStartWorkflowExecutionStatisticInsert(namespace, uid string, workflowTemplateID int64) (err error) {
@@ -1053,20 +1117,37 @@ func (c *Client) CronStartWorkflowExecutionStatisticInsert(namespace, uid string
 		return err
 	}
 
+	fieldMap := sq.Eq{
+		"uid":                          uid,
+		"workflow_template_version_id": cronWorkflow.WorkflowTemplateVersionID,
+		"name":                         uid,
+		"namespace":                    namespace,
+		"phase":                        wfv1.NodeRunning,
+		"started_at":                   time.Now().UTC(),
+		"cron_workflow_id":             cronWorkflow.ID,
+		"parameters":                   string(parametersJSON),
+		"labels":                       cronWorkflow.Labels,
+		"metrics":                      Metrics{},
+		"owner":                        cronWorkflow.Owner,
+		"visibility":                   cronWorkflow.Visibility,
+		"shared_users":                 cronWorkflow.SharedUsers,
+		"shared_groups":                cronWorkflow.SharedGroups,
+	}
+
+	// Cron workflows are created by argo, so they are queued by suspending them after they start
+	queued := workflowTemplate.Concurrency.IsEnabled()
+	if queued {
+		if err := argoutil.SuspendWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), uid); err != nil {
+			return err
+		}
+
+		fieldMap["phase"] = WorkflowExecutionPhaseQueued
+		delete(fieldMap, "started_at")
+	}
+
 	workflowExecutionID := uint64(0)
 	err = sb.Insert("workflow_executions").
-		SetMap(sq.Eq{
-			"uid":                          uid,
-			"workflow_template_version_id": cronWorkflow.WorkflowTemplateVersionID,
-			"name":                         uid,
-			"namespace":                    namespace,
-			"phase":                        wfv1.NodeRunning,
-			"started_at":                   time.Now().UTC(),
-			"cron_workflow_id":             cronWorkflow.ID,
-			"parameters":                   string(parametersJSON),
-			"labels":                       cronWorkflow.Labels,
-			"metrics":                      Metrics{},
-		}).
+		SetMap(fieldMap).
 		Suffix("RETURNING id").
 		RunWith(c.DB).
 		QueryRow().
@@ -1075,6 +1156,10 @@ func (c *Client) CronStartWorkflowExecutionStatisticInsert(namespace, uid string
 		return err
 	}
 
+	if queued {
+		return c.ReleaseQueuedWorkflowExecutions(namespace)
+	}
+
 	return err
 }
 
@@ -1100,7 +1185,7 @@ func (c *Client) GetWorkflowExecution(namespace, uid string) (workflow *Workflow
 		return nil, err
 	}
 
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	wf, err := c.getArgoWorkflow(namespace, uid)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
@@ -1328,8 +1413,8 @@ func (c *Client) WatchWorkflowExecution(namespace, uid string) (<-chan *Workflow
 	return workflowWatcher, nil
 }
 
-func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName string) (<-chan []*LogEntry, error) {
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+func (c *Client) GetWorkflowExecutionLogs(ctx context.Context, namespace, uid, podName, containerName string) (<-chan []*LogEntry, error) {
+	wf, err := c.getArgoWorkflow(namespace, uid)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace":     namespace,
@@ -1341,157 +1426,18 @@ func (c *Client) GetWorkflowExecutionLogs(namespace, uid, podName, containerName
 		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
 	}
 
-	var (
-		stream    io.ReadCloser
-		s3Client  *s3.Client
-		gcsClient *gcs.Client
-		config    *NamespaceConfig
-		endOffset int
-	)
-
-	if wf.Status.Nodes[podName].Completed() {
-		config, err = c.GetNamespaceConfig(namespace)
-		if err != nil {
-			log.WithFields(log.Fields{
-				"Namespace":     namespace,
-				"UID":           uid,
-				"PodName":       podName,
-				"ContainerName": containerName,
-				"Error":         err.Error(),
-			}).Error("Can't get configuration.")
-			return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
-		}
-
-		switch {
-		case config.ArtifactRepository.S3 != nil:
-			{
-				s3Client, err = c.GetS3Client(namespace, config.ArtifactRepository.S3)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't connect to S3 storage.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-				}
-
-				opts := s3.GetObjectOptions{}
-				endOffset, err = strconv.Atoi(readEndOffset)
-				if err != nil {
-					return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
-				}
-				err = opts.SetRange(0, int64(endOffset))
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't set range.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
-				}
-
-				key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
-				stream, err = s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
-			}
-		case config.ArtifactRepository.GCS != nil:
-			{
-				gcsClient, err = c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
-				if err != nil {
-					log.WithFields(log.Fields{
-						"Namespace":     namespace,
-						"UID":           uid,
-						"PodName":       podName,
-						"ContainerName": containerName,
-						"Error":         err.Error(),
-					}).Error("Can't connect to GCS storage.")
-					return nil, util.NewUserError(codes.NotFound, "Can't connect to GCS storage.")
-				}
-				key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
-				stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
-			}
-		}
-	} else {
-		stream, err = c.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
-			Container:  containerName,
-			Follow:     true,
-			Timestamps: true,
-		}).Stream()
+	node, ok := wf.Status.Nodes[podName]
+	if !ok {
+		return nil, util.NewUserError(codes.NotFound, "Pod not found.")
 	}
-	// TODO: Catch exact kubernetes error
-	//Todo: Can above todo be removed with the logging error?
+
+	stream, err := c.openWorkflowExecutionLogs(namespace, uid, podName, containerName, node.Completed(), true)
 	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace":     namespace,
-			"UID":           uid,
-			"PodName":       podName,
-			"ContainerName": containerName,
-			"Error":         err.Error(),
-		}).Error("Error with logs.")
-		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+		return nil, err
 	}
+	parsers := getWorkflowExecutionLogParsers(wf, podName)
 
-	logWatcher := make(chan []*LogEntry)
-	go func() {
-		buffer := make([]byte, 4096)
-		reader := bufio.NewReader(stream)
-
-		lastChunkSent := -1
-		lastLine := ""
-		for {
-			bytesRead, err := reader.Read(buffer)
-			if err != nil && err.Error() != "EOF" {
-				break
-			}
-			content := lastLine + string(buffer[:bytesRead])
-			lastLine = ""
-
-			chunk := make([]*LogEntry, 0)
-			lines := strings.Split(content, "\n")
-			for lineIndex, line := range lines {
-				if lineIndex == len(lines)-1 {
-					lastLine = line
-					continue
-				}
-
-				newLogEntry := LogEntryFromLine(&line)
-				if newLogEntry == nil {
-					continue
-				}
-
-				chunk = append(chunk, newLogEntry)
-			}
-
-			if lastChunkSent == 0 && lastLine != "" {
-				newLogEntry := LogEntryFromLine(&lastLine)
-				if newLogEntry != nil {
-					chunk = append(chunk, newLogEntry)
-					lastLine = ""
-				}
-			}
-
-			if len(chunk) > 0 {
-				logWatcher <- chunk
-			}
-			lastChunkSent = len(chunk)
-
-			if err != nil && err.Error() == "EOF" {
-				break
-			}
-		}
-
-		newLogEntry := LogEntryFromLine(&lastLine)
-		if newLogEntry != nil {
-			logWatcher <- []*LogEntry{newLogEntry}
-		}
-
-		close(logWatcher)
-	}()
-
-	return logWatcher, err
+	return streamLogEntries(ctx, stream, containerName, parsers), nil
 }
 
 func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (metrics []*Metric, err error) {
@@ -1599,19 +1545,176 @@ func (c *Client) GetWorkflowExecutionMetrics(namespace, uid, podName string) (me
 	return
 }
 
-func (c *Client) RetryWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
+// RetryWorkflowExecution retries a Failed/Errored workflow execution in place, keeping the outputs of successful nodes.
+// If nodeID is not empty, that node and its descendants are re-run even if they succeeded.
+// parameterOverrides replace the values of the execution's parameters, matched by name, before the retry.
+// The retry is recorded in the execution's retry lineage.
+func (c *Client) RetryWorkflowExecution(namespace, uid, nodeID string, parameterOverrides []Parameter) (workflow *WorkflowExecution, err error) {
+	workflow, err = c.GetWorkflowExecution(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if workflow == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow execution not found.")
+	}
+
+	if _, err := workflow.LoadParametersFromBytes(); err != nil {
+		return nil, err
+	}
+
 	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
 	if err != nil {
-		return
+		return nil, err
+	}
+
+	if wf.Status.Phase != wfv1.NodeFailed && wf.Status.Phase != wfv1.NodeError {
+		return nil, util.NewUserError(codes.FailedPrecondition, "Workflow execution must be Failed or Error to retry.")
+	}
+
+	nodeFieldSelector := ""
+	if nodeID != "" {
+		hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
+		if err := hy.Hydrate(wf); err != nil {
+			return nil, err
+		}
+
+		node, ok := wf.Status.Nodes[nodeID]
+		if !ok {
+			return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Node '%v' not found.", nodeID))
+		}
+
+		nodeFieldSelector = getNodeFieldSelector(node)
+	}
+
+	if err := workflow.OverrideParameters(parameterOverrides); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	// The overrides are applied before the retry, so the re-run nodes use them, and restored if it fails
+	var previousParameters []Parameter
+	if len(parameterOverrides) > 0 {
+		previousParameters, err = c.overrideArgoWorkflowParameters(namespace, uid, parameterOverrides)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
+	if _, err := argoutil.RetryWorkflow(c, hy, c.ArgoprojV1alpha1().Workflows(namespace), uid, nodeID != "", nodeFieldSelector); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"NodeID":    nodeID,
+			"Error":     err.Error(),
+		}).Error("Unable to retry workflow execution.")
 
-	wf, err = argoutil.RetryWorkflow(c, hy, c.ArgoprojV1alpha1().Workflows(namespace), uid, true, "")
+		if len(previousParameters) > 0 {
+			if _, restoreErr := c.overrideArgoWorkflowParameters(namespace, uid, previousParameters); restoreErr != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"UID":       uid,
+					"Error":     restoreErr.Error(),
+				}).Error("Unable to restore workflow execution parameters.")
+			}
+		}
 
-	workflow = typeWorkflow(wf)
+		return nil, util.NewUserError(codes.FailedPrecondition, err.Error())
+	}
 
-	return
+	retry := &WorkflowExecutionRetry{
+		NodeID:     nodeID,
+		Phase:      wf.Status.Phase,
+		Parameters: parameterOverrides,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	if err := c.addWorkflowExecutionRetryDB(namespace, workflow, retry); err != nil {
+		return nil, err
+	}
+
+	return c.GetWorkflowExecution(namespace, uid)
+}
+
+// getNodeFieldSelector returns an argo node field selector that matches the node.
+// Argo selectors can't match on the node id, so the node is matched by its display name and template.
+// Nodes created from the same step in a loop share these and are all matched.
+func getNodeFieldSelector(node wfv1.NodeStatus) string {
+	selector := fields.Set{
+		"displayName": node.DisplayName,
+	}
+
+	if node.TemplateRef != nil {
+		selector["templateRef.name"] = node.TemplateRef.Name
+		selector["templateRef.template"] = node.TemplateRef.Template
+	} else {
+		selector["templateName"] = node.TemplateName
+	}
+
+	return selector.AsSelector().String()
+}
+
+// overrideArgoWorkflowParameters updates the argo workflow's global parameters with the values in overrides.
+// It returns the values the overridden parameters had, so they can be restored.
+func (c *Client) overrideArgoWorkflowParameters(namespace, uid string, overrides []Parameter) (previous []Parameter, err error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, override := range overrides {
+		for i := range wf.Spec.Arguments.Parameters {
+			param := &wf.Spec.Arguments.Parameters[i]
+			if param.Name != override.Name {
+				continue
+			}
+
+			previousParameter := Parameter{Name: param.Name}
+			if param.Value != nil {
+				previousParameter.Value = ptr.String(param.Value.String())
+			}
+			previous = append(previous, previousParameter)
+
+			if override.Value == nil {
+				param.Value = nil
+			} else {
+				param.Value = wfv1.AnyStringPtr(*override.Value)
+			}
+		}
+	}
+
+	if _, err := c.ArgoprojV1alpha1().Workflows(namespace).Update(wf); err != nil {
+		return nil, err
+	}
+
+	return previous, nil
+}
+
+// addWorkflowExecutionRetryDB appends retry to the retry lineage of the workflow execution
+// and stores the workflow execution's current parameters.
+func (c *Client) addWorkflowExecutionRetryDB(namespace string, workflow *WorkflowExecution, retry *WorkflowExecutionRetry) error {
+	parametersJSON, err := json.Marshal(workflow.Parameters)
+	if err != nil {
+		return err
+	}
+
+	retryJSON, err := json.Marshal([]*WorkflowExecutionRetry{retry})
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("workflow_executions").
+		Set("parameters", string(parametersJSON)).
+		Set("phase", wfv1.NodeRunning).
+		Set("finished_at", nil).
+		Set("retries", sq.Expr("retries || ?::jsonb", string(retryJSON))).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       workflow.UID,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
 }
 
 func (c *Client) ResubmitWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
@@ -1635,9 +1738,30 @@ func (c *Client) ResubmitWorkflowExecution(namespace, uid string) (workflow *Wor
 	return
 }
 
+// ResumeWorkflowExecution resumes a suspended workflow execution and its suspended nodes.
+// Approval gates are not resumed, they have to be approved with ApproveWorkflowNode.
 func (c *Client) ResumeWorkflowExecution(namespace, uid string) (workflow *WorkflowExecution, err error) {
-	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
-	err = argoutil.ResumeWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), hy, uid, "")
+	err = c.updateArgoWorkflowStatus(namespace, uid, func(wf *wfv1.Workflow) (bool, error) {
+		updated := false
+		if wf.Spec.Suspend != nil && *wf.Spec.Suspend {
+			wf.Spec.Suspend = nil
+			updated = true
+		}
+
+		for nodeID, node := range wf.Status.Nodes {
+			if !node.IsActiveSuspendNode() || IsApprovalGate(wf, node) {
+				continue
+			}
+
+			if err := resumeSuspendNode(&node); err != nil {
+				return false, err
+			}
+			wf.Status.Nodes[nodeID] = node
+			updated = true
+		}
+
+		return updated, nil
+	})
 	if err != nil {
 		return
 	}
@@ -1649,6 +1773,154 @@ func (c *Client) ResumeWorkflowExecution(namespace, uid string) (workflow *Workf
 	return
 }
 
+// ApproveWorkflowNode resumes the approval gate identified by nodeID and records who approved it.
+func (c *Client) ApproveWorkflowNode(namespace, uid, nodeID, approvedBy, comment string) (approval *WorkflowExecutionApproval, err error) {
+	workflowExecution, err := c.GetWorkflowExecution(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if workflowExecution == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workflow execution not found.")
+	}
+
+	approval = &WorkflowExecutionApproval{
+		WorkflowExecutionID: workflowExecution.ID,
+		NodeID:              nodeID,
+		ApprovedBy:          approvedBy,
+		Comment:             comment,
+	}
+
+	// The approval is recorded before the node is resumed, so no gate is passed without a record of who approved it.
+	// It is deleted again if the node can't be resumed.
+	err = sb.Insert("workflow_execution_approvals").
+		SetMap(sq.Eq{
+			"workflow_execution_id": approval.WorkflowExecutionID,
+			"node_id":               approval.NodeID,
+			"approved_by":           approval.ApprovedBy,
+			"comment":               approval.Comment,
+		}).
+		Suffix("RETURNING id, created_at").
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&approval.ID, &approval.CreatedAt)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"NodeID":    nodeID,
+			"Error":     err.Error(),
+		}).Error("Unable to record workflow node approval.")
+		return nil, err
+	}
+
+	err = c.updateArgoWorkflowStatus(namespace, uid, func(wf *wfv1.Workflow) (bool, error) {
+		node, ok := wf.Status.Nodes[nodeID]
+		if !ok {
+			return false, util.NewUserError(codes.NotFound, fmt.Sprintf("Node '%v' not found.", nodeID))
+		}
+		if !IsApprovalGate(wf, node) {
+			return false, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("Node '%v' is not an approval gate.", nodeID))
+		}
+		if !node.IsActiveSuspendNode() {
+			return false, util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Node '%v' is not waiting for approval.", nodeID))
+		}
+
+		if err := resumeSuspendNode(&node); err != nil {
+			return false, err
+		}
+		node.Message = fmt.Sprintf("Approved by %v", approvedBy)
+		wf.Status.Nodes[nodeID] = node
+
+		return true, nil
+	})
+	if err != nil {
+		_, deleteErr := sb.Delete("workflow_execution_approvals").
+			Where(sq.Eq{"id": approval.ID}).
+			RunWith(c.DB).
+			Exec()
+		if deleteErr != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"NodeID":    nodeID,
+				"Error":     deleteErr.Error(),
+			}).Error("Unable to delete approval of a node that was not resumed.")
+		}
+		return nil, err
+	}
+
+	return
+}
+
+// ListWorkflowExecutionApprovals returns the approvals of the workflow execution's approval gates, oldest first
+func (c *Client) ListWorkflowExecutionApprovals(namespace, uid string) (approvals []*WorkflowExecutionApproval, err error) {
+	sb := sb.Select("a.id", "a.workflow_execution_id", "a.node_id", "a.approved_by", "a.comment", "a.created_at").
+		From("workflow_execution_approvals a").
+		Join("workflow_executions we ON we.id = a.workflow_execution_id").
+		Where(sq.Eq{
+			"we.namespace": namespace,
+			"we.uid":       uid,
+		}).
+		OrderBy("a.created_at")
+
+	approvals = make([]*WorkflowExecutionApproval, 0)
+	err = c.DB.Selectx(&approvals, sb)
+
+	return
+}
+
+// updateArgoWorkflowStatus gets the hydrated argo workflow, lets update modify it and saves it if update returns true.
+// Conflicts are retried.
+func (c *Client) updateArgoWorkflowStatus(namespace, uid string, update func(wf *wfv1.Workflow) (bool, error)) error {
+	wfClient := c.ArgoprojV1alpha1().Workflows(namespace)
+	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		wf, err := wfClient.Get(uid, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if err := hy.Hydrate(wf); err != nil {
+			return err
+		}
+
+		updated, err := update(wf)
+		if err != nil || !updated {
+			return err
+		}
+
+		if err := hy.Dehydrate(wf); err != nil {
+			return err
+		}
+
+		_, err = wfClient.Update(wf)
+
+		return err
+	})
+}
+
+// resumeSuspendNode marks a suspend node as Succeeded, using the default values of its supplied output parameters
+func resumeSuspendNode(node *wfv1.NodeStatus) error {
+	if node.Outputs != nil {
+		for i, param := range node.Outputs.Parameters {
+			if param.ValueFrom != nil && param.ValueFrom.Supplied != nil {
+				if param.ValueFrom.Default == nil {
+					return util.NewUserError(codes.FailedPrecondition, fmt.Sprintf("Output parameter '%v' has not been set and does not have a default value.", param.Name))
+				}
+
+				node.Outputs.Parameters[i].Value = param.ValueFrom.Default
+				node.Outputs.Parameters[i].ValueFrom = nil
+			}
+		}
+	}
+
+	node.Phase = wfv1.NodeSucceeded
+	node.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+
+	return nil
+}
+
 func (c *Client) SuspendWorkflowExecution(namespace, uid string) (err error) {
 	err = argoutil.SuspendWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), uid)
 
@@ -1674,6 +1946,8 @@ func (c *Client) TerminateWorkflowExecution(namespace, uid string) (err error) {
 	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
 	err = argoutil.StopWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), hy, uid, "", "")
 
+	c.releaseQueuedWorkflowExecutions(namespace)
+
 	return
 }
 
@@ -1897,7 +2171,7 @@ func filterOutCustomTypesFromManifest(manifest []byte) (result []byte, err error
 // prefix is the label prefix.
 // e.g. prefix/my-label-key: my-label-value
 func (c *Client) GetWorkflowExecutionLabels(namespace, uid, prefix string) (labels map[string]string, err error) {
-	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	wf, err := c.getArgoWorkflow(namespace, uid)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"Namespace": namespace,
@@ -2035,6 +2309,17 @@ func (c *Client) GetWorkflowExecutionStatisticsForNamespace(namespace string) (r
 	return
 }
 
+// workflowExecutionStatisticsSelect are the columns of a WorkflowExecutionStatisticReport, grouped by workflow template
+const workflowExecutionStatisticsSelect = `
+		workflow_template_id,
+		MAX(we.created_at) last_executed,
+		COUNT(*) FILTER (WHERE finished_at IS NULL AND (phase = 'Running' OR phase = 'Pending')) running,
+		COUNT(*) FILTER (WHERE phase = 'Queued') queued,
+		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND phase = 'Succeeded') completed,
+		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND (phase = 'Failed' OR phase = 'Error')) failed,
+		COUNT(*) FILTER (WHERE phase = 'Terminated') terminated,
+		COUNT(*) total`
+
 // GetWorkflowExecutionStatisticsForTemplates loads statistics on workflow executions for the provided
 // workflowTemplates and sets it as the WorkflowExecutionStatisticReport property
 func (c *Client) GetWorkflowExecutionStatisticsForTemplates(workflowTemplates ...*WorkflowTemplate) (err error) {
@@ -2054,16 +2339,7 @@ func (c *Client) GetWorkflowExecutionStatisticsForTemplates(workflowTemplates ..
 
 	defer tx.Rollback()
 
-	statsSelect := `
-		workflow_template_id,
-		MAX(we.created_at) last_executed,
-		COUNT(*) FILTER (WHERE finished_at IS NULL AND (phase = 'Running' OR phase = 'Pending')) running,
-		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND phase = 'Succeeded') completed,
-		COUNT(*) FILTER (WHERE finished_at IS NOT NULL AND (phase = 'Failed' OR phase = 'Error')) failed,
-		COUNT(*) FILTER (WHERE phase = 'Terminated') terminated,
-		COUNT(*) total`
-
-	query, args, err := sb.Select(statsSelect).
+	query, args, err := sb.Select(workflowExecutionStatisticsSelect).
 		From("workflow_executions we").
 		Join("workflow_template_versions wtv ON wtv.id = we.workflow_template_version_id").
 		Where(sq.Eq{
@@ -2349,18 +2625,28 @@ func (c *Client) UpdateWorkflowExecutionStatus(namespace, uid string, status *Wo
 		fieldMap["started_at"] = time.Now().UTC()
 		break
 	}
-	_, err = sb.Update("workflow_executions").
+	updateSb := sb.Update("workflow_executions").
 		SetMap(fieldMap).
 		Where(sq.Eq{
 			"namespace": namespace,
 			"uid":       uid,
-		}).
+		})
+	// Cron workflow executions are queued after they start, so a status sent before they were suspended
+	// must not take them out of the queue. Only a final status does.
+	if !status.Phase.Fulfilled() {
+		updateSb = updateSb.Where("phase IS DISTINCT FROM ?", WorkflowExecutionPhaseQueued)
+	}
+	_, err = updateSb.
 		RunWith(c.DB).
 		Exec()
 	if err != nil {
 		return util.NewUserError(codes.NotFound, "Workflow execution not found.")
 	}
 
+	if status.Phase.Fulfilled() {
+		c.releaseQueuedWorkflowExecutions(namespace)
+	}
+
 	return
 }
 
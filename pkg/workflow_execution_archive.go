@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/hydrator"
+	"github.com/onepanelio/core/pkg/util/label"
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ArchiveWorkflowExecutionStatus stores the argo workflow of a completed workflow execution, with its nodes, outputs,
+// parameters and resource durations, along with its timeline. The stored workflow is read once argo deletes its
+// workflow, so the workflow execution can still be inspected. The Kubernetes copies of the secrets it used are
+// deleted if nothing else uses them.
+func (c *Client) ArchiveWorkflowExecutionStatus(wf *wfv1.Workflow) error {
+	wf = wf.DeepCopy()
+
+	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
+	if err := hy.Hydrate(wf); err != nil {
+		return err
+	}
+
+	wf.ManagedFields = nil
+	data, err := json.Marshal(wf)
+	if err != nil {
+		return err
+	}
+
+	_, err = sb.Update("workflow_executions").
+		Set("argo_workflow", string(data)).
+		Where(sq.Eq{
+			"namespace": wf.Namespace,
+			"uid":       wf.Name,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	timeline, err := c.getWorkflowExecutionTimeline(wf.Namespace, wf)
+	if err == nil {
+		err = c.saveWorkflowExecutionTimeline(wf.Namespace, wf.Name, timeline)
+	}
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": wf.Namespace,
+			"UID":       wf.Name,
+			"Error":     err.Error(),
+		}).Error("Unable to store workflow execution timeline.")
+	}
+
+	c.releaseMaterializedSecrets(wf)
+
+	return nil
+}
+
+// archiveCompletedWorkflowExecutionStatus stores the argo workflow of a workflow execution if it has completed.
+// Errors are logged rather than returned as the archive is not required for the workflow execution.
+func (c *Client) archiveCompletedWorkflowExecutionStatus(namespace, uid string) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil || !wf.Status.Phase.Completed() {
+		return
+	}
+
+	if err := c.ArchiveWorkflowExecutionStatus(wf); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to archive workflow execution status.")
+	}
+}
+
+// IsWorkflowExecutionStatusArchived returns true if the argo workflow of a workflow execution has been stored
+func (c *Client) IsWorkflowExecutionStatusArchived(namespace, uid string) (bool, error) {
+	query := sb.Select("COUNT(*)").
+		From("workflow_executions").
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{"argo_workflow": nil},
+		})
+
+	count := 0
+	if err := c.DB.Getx(&count, query); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// getArchivedArgoWorkflow returns the stored argo workflow of a workflow execution, or nil if it has not been stored
+func (c *Client) getArchivedArgoWorkflow(namespace, uid string) (*wfv1.Workflow, error) {
+	query := sb.Select("argo_workflow").
+		From("workflow_executions").
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{"argo_workflow": nil},
+		})
+
+	data := make([]string, 0)
+	if err := c.DB.Selectx(&data, query); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	wf := &wfv1.Workflow{}
+	if err := json.Unmarshal([]byte(data[0]), wf); err != nil {
+		return nil, err
+	}
+
+	return wf, nil
+}
+
+// getArgoWorkflow returns the argo workflow of a workflow execution. If argo has deleted it, the workflow stored
+// when the execution completed is returned instead.
+func (c *Client) getArgoWorkflow(namespace, uid string) (*wfv1.Workflow, error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err == nil || !k8serrors.IsNotFound(err) {
+		return wf, err
+	}
+
+	archived, archiveErr := c.getArchivedArgoWorkflow(namespace, uid)
+	if archiveErr != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     archiveErr.Error(),
+		}).Error("Unable to get archived workflow.")
+		return nil, err
+	}
+	if archived == nil {
+		return nil, err
+	}
+
+	return archived, nil
+}
+
+// WatchWorkflowExecutionCompletions archives the status of workflow executions as their argo workflows complete,
+// until stopCh is closed. Workflows that completed while it was not running are archived when it starts.
+func (c *Client) WatchWorkflowExecutionCompletions(stopCh <-chan struct{}) {
+	workflows := c.ArgoprojV1alpha1().Workflows(metav1.NamespaceAll)
+	source := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			options.LabelSelector = label.WorkflowTemplateUid
+			return workflows.List(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			options.LabelSelector = label.WorkflowTemplateUid
+			return workflows.Watch(options)
+		},
+	}
+
+	archive := func(wf *wfv1.Workflow) {
+		if err := c.ArchiveWorkflowExecutionStatus(wf); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": wf.Namespace,
+				"UID":       wf.Name,
+				"Error":     err.Error(),
+			}).Error("Unable to archive workflow execution status.")
+		}
+	}
+
+	_, controller := cache.NewInformer(
+		source,
+		&wfv1.Workflow{},
+		0,
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				wf, ok := obj.(*wfv1.Workflow)
+				if !ok || !wf.Status.Phase.Completed() {
+					return
+				}
+
+				archived, err := c.IsWorkflowExecutionStatusArchived(wf.Namespace, wf.Name)
+				if err != nil || archived {
+					return
+				}
+
+				archive(wf)
+			},
+			UpdateFunc: func(old, new interface{}) {
+				oldWf, ok := old.(*wfv1.Workflow)
+				if !ok {
+					return
+				}
+				newWf, ok := new.(*wfv1.Workflow)
+				if !ok || oldWf.Status.Phase.Completed() || !newWf.Status.Phase.Completed() {
+					return
+				}
+
+				archive(newWf)
+			},
+		})
+
+	controller.Run(stopCh)
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestClient_ArchiveWorkflowExecutionStatus makes sure a workflow execution can still be read after argo deletes its workflow
+func TestClient_ArchiveWorkflowExecutionStatus(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+
+	wt := &WorkflowTemplate{
+		Name:     "test",
+		Manifest: defaultWorkflowTemplate,
+	}
+	wt, _ = c.CreateWorkflowTemplate(namespace, wt)
+
+	we, err := c.CreateWorkflowExecution(namespace, &WorkflowExecution{Name: "test"}, wt)
+	assert.Nil(t, err)
+
+	workflows := c.ArgoprojV1alpha1().Workflows(namespace)
+	wf, err := workflows.Get(we.UID, metav1.GetOptions{})
+	assert.Nil(t, err)
+
+	wf.Status.Phase = wfv1.NodeSucceeded
+	wf.Status.Nodes = wfv1.Nodes{
+		we.UID: {ID: we.UID, Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded},
+	}
+
+	archived, err := c.IsWorkflowExecutionStatusArchived(namespace, we.UID)
+	assert.Nil(t, err)
+	assert.False(t, archived)
+
+	assert.Nil(t, c.ArchiveWorkflowExecutionStatus(wf))
+
+	archived, err = c.IsWorkflowExecutionStatusArchived(namespace, we.UID)
+	assert.Nil(t, err)
+	assert.True(t, archived)
+
+	assert.Nil(t, workflows.Delete(we.UID, nil))
+
+	wf, err = c.getArgoWorkflow(namespace, we.UID)
+	assert.Nil(t, err)
+	assert.Equal(t, wfv1.NodeSucceeded, wf.Status.Phase)
+	assert.Contains(t, wf.Status.Nodes, we.UID)
+
+	getWe, err := c.GetWorkflowExecution(namespace, we.UID)
+	assert.Nil(t, err)
+	assert.Equal(t, we.UID, getWe.UID)
+}
@@ -0,0 +1,204 @@
+package v1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workflowExecutionDiagnosticsLogLines is the number of log lines returned for each failed node
+const workflowExecutionDiagnosticsLogLines = 50
+
+// workflowExecutionNodePool is the node pool a workflow execution's pods are scheduled on, and the nodes in it
+type workflowExecutionNodePool struct {
+	Label  string
+	Option *NodePoolOption
+	Nodes  []corev1.Node
+}
+
+// getWorkflowExecutionNodePool returns the node pool selected by the sys-node-pool parameter of a workflow,
+// or nil if it has none or the node pool can't be determined
+func (c *Client) getWorkflowExecutionNodePool(wf *wfv1.Workflow) *workflowExecutionNodePool {
+	value := ""
+	for _, parameter := range wf.Spec.Arguments.Parameters {
+		if parameter.Name == "sys-node-pool" && parameter.Value != nil {
+			value = parameter.Value.String()
+		}
+	}
+	if value == "" {
+		return nil
+	}
+
+	config, err := c.GetSystemConfig()
+	if err != nil {
+		return nil
+	}
+
+	nodePoolLabel := config.NodePoolLabel()
+	if nodePoolLabel == nil {
+		return nil
+	}
+
+	option, err := config.NodePoolOptionByValue(value)
+	if err != nil || option == nil {
+		option = &NodePoolOption{ParameterOption: ParameterOption{Value: value}}
+	}
+
+	nodes, err := c.CoreV1().Nodes().List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%v=%v", *nodePoolLabel, value),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": wf.Namespace,
+			"UID":       wf.Name,
+			"NodePool":  value,
+			"Error":     err.Error(),
+		}).Error("Unable to list node pool nodes.")
+		return nil
+	}
+
+	return &workflowExecutionNodePool{
+		Label:  *nodePoolLabel,
+		Option: option,
+		Nodes:  nodes.Items,
+	}
+}
+
+// getWorkflowExecutionNodeExitCode returns the exit code of the main container of a failed node,
+// and whether it was killed for running out of memory. The pod is used if it still exists, otherwise the node status.
+func getWorkflowExecutionNodeExitCode(node *wfv1.NodeStatus, pod *corev1.Pod) (exitCode *int32, oomKilled bool) {
+	if pod != nil {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != defaultWorkflowExecutionLogContainer || status.State.Terminated == nil {
+				continue
+			}
+
+			code := status.State.Terminated.ExitCode
+			return &code, status.State.Terminated.Reason == "OOMKilled"
+		}
+	}
+
+	if node.Outputs != nil && node.Outputs.ExitCode != nil {
+		if code, err := strconv.ParseInt(*node.Outputs.ExitCode, 10, 32); err == nil {
+			code32 := int32(code)
+			exitCode = &code32
+		}
+	}
+
+	return exitCode, strings.Contains(node.Message, "OOMKilled")
+}
+
+// GetWorkflowExecutionDiagnostics explains the state of the pod nodes of a workflow execution that are pending, running
+// or failed using the pod's events and conditions. Scheduling problems are explained against the node pool selected by
+// the sys-node-pool parameter. Failed nodes include their exit code and the end of their logs.
+func (c *Client) GetWorkflowExecutionDiagnostics(namespace, uid string) (*WorkflowExecutionDiagnostics, error) {
+	wf, err := c.getArgoWorkflow(namespace, uid)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	diagnostics := &WorkflowExecutionDiagnostics{
+		UID:      uid,
+		Phase:    string(wf.Status.Phase),
+		Message:  wf.Status.Message,
+		Nodes:    make([]*WorkflowExecutionNodeDiagnostics, 0),
+		Findings: make([]*DiagnosticFinding, 0),
+	}
+
+	nodePool := c.getWorkflowExecutionNodePool(wf)
+	if nodePool != nil {
+		diagnostics.NodePool = nodePool.Option.Value
+	}
+
+	nodes := make([]wfv1.NodeStatus, 0)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod || (node.Fulfilled() && !node.FailedOrError()) {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].ID < nodes[j].ID
+		}
+		return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+	})
+
+	for i := range nodes {
+		node := &nodes[i]
+
+		nodeDiagnostics := &WorkflowExecutionNodeDiagnostics{
+			ID:           node.ID,
+			DisplayName:  node.DisplayName,
+			TemplateName: node.TemplateName,
+			Phase:        string(node.Phase),
+			Message:      node.Message,
+			Conditions:   make([]*PodCondition, 0),
+			Findings:     make([]*DiagnosticFinding, 0),
+		}
+
+		// Pods of completed nodes may have been garbage collected, their events are kept for a while longer
+		pod, err := c.CoreV1().Pods(namespace).Get(node.ID, metav1.GetOptions{})
+		if err != nil {
+			pod = nil
+		}
+		if pod != nil {
+			nodeDiagnostics.PodPhase = string(pod.Status.Phase)
+			nodeDiagnostics.Conditions = podConditions(pod)
+			nodeDiagnostics.Findings = append(nodeDiagnostics.Findings, findingsFromPod(pod)...)
+			if nodePool != nil {
+				nodeDiagnostics.Findings = append(nodeDiagnostics.Findings, findingsFromNodePool(pod, nodePool.Label, nodePool.Option, nodePool.Nodes)...)
+			}
+		}
+
+		nodeDiagnostics.Events = c.listObjectEvents(namespace, []diagnosticObject{{Kind: "Pod", Name: node.ID}})
+		nodeDiagnostics.Findings = append(nodeDiagnostics.Findings, findingsFromEvents(nodeDiagnostics.Events)...)
+
+		if node.FailedOrError() {
+			nodeDiagnostics.ExitCode, nodeDiagnostics.OOMKilled = getWorkflowExecutionNodeExitCode(node, pod)
+			if nodeDiagnostics.OOMKilled {
+				nodeDiagnostics.Findings = append(nodeDiagnostics.Findings, &DiagnosticFinding{
+					Severity: DiagnosticSeverityError,
+					Reason:   "OOMKilled",
+					Message:  fmt.Sprintf("Container %v: %v", defaultWorkflowExecutionLogContainer, containerFindingMessages["OOMKilled"]),
+					Object:   "Pod/" + node.ID,
+				})
+			}
+
+			stream, err := c.openWorkflowExecutionLogs(namespace, uid, node.ID, defaultWorkflowExecutionLogContainer, node.Completed(), false)
+			if err == nil {
+				nodeDiagnostics.Logs, err = tailLogEntries(stream, workflowExecutionDiagnosticsLogLines, getWorkflowExecutionLogParsers(wf, node.ID))
+				stream.Close()
+				if err != nil {
+					log.WithFields(log.Fields{
+						"Namespace": namespace,
+						"UID":       uid,
+						"PodName":   node.ID,
+						"Error":     err.Error(),
+					}).Error("Unable to read logs.")
+				}
+			}
+		}
+
+		nodeDiagnostics.Findings = uniqueFindings(nodeDiagnostics.Findings)
+		diagnostics.Findings = append(diagnostics.Findings, nodeDiagnostics.Findings...)
+		diagnostics.Nodes = append(diagnostics.Nodes, nodeDiagnostics)
+	}
+
+	diagnostics.Findings = uniqueFindings(diagnostics.Findings)
+
+	return diagnostics, nil
+}
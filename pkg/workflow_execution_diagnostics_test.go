@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test_getWorkflowExecutionNodeExitCode tests getting the exit code of a failed node from its pod, or its status
+func Test_getWorkflowExecutionNodeExitCode(t *testing.T) {
+	exitCode := "137"
+	node := &wfv1.NodeStatus{
+		Message: "OOMKilled (exit code 137)",
+		Outputs: &wfv1.Outputs{ExitCode: &exitCode},
+	}
+
+	code, oomKilled := getWorkflowExecutionNodeExitCode(node, nil)
+	assert.Equal(t, int32(137), *code)
+	assert.True(t, oomKilled)
+
+	pod := &corev1.Pod{
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "wait", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 0}}},
+				{Name: "main", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}},
+			},
+		},
+	}
+
+	code, oomKilled = getWorkflowExecutionNodeExitCode(node, pod)
+	assert.Equal(t, int32(1), *code)
+	assert.False(t, oomKilled)
+
+	code, oomKilled = getWorkflowExecutionNodeExitCode(&wfv1.NodeStatus{Message: "failed"}, nil)
+	assert.Nil(t, code)
+	assert.False(t, oomKilled)
+}
@@ -0,0 +1,450 @@
+package v1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/gcs"
+	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/onepanelio/core/pkg/util/s3"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// maxLogLineSize is the longest log line that is read when searching logs
+const maxLogLineSize = 1024 * 1024
+
+// openWorkflowExecutionLogs opens the logs of a container of a workflow execution pod.
+// The logs of completed pods are read from the artifact repository, otherwise they are read from the pod.
+// If follow is true, the logs of running pods are followed and only the end of archived logs is read.
+func (c *Client) openWorkflowExecutionLogs(namespace, uid, podName, containerName string, completed, follow bool) (stream io.ReadCloser, err error) {
+	var (
+		s3Client  *s3.Client
+		gcsClient *gcs.Client
+		config    *NamespaceConfig
+		endOffset int
+	)
+
+	if completed {
+		config, err = c.GetNamespaceConfig(namespace)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":     namespace,
+				"UID":           uid,
+				"PodName":       podName,
+				"ContainerName": containerName,
+				"Error":         err.Error(),
+			}).Error("Can't get configuration.")
+			return nil, util.NewUserError(codes.NotFound, "Can't get configuration.")
+		}
+
+		switch {
+		case config.ArtifactRepository.S3 != nil:
+			{
+				s3Client, err = c.GetS3Client(namespace, config.ArtifactRepository.S3)
+				if err != nil {
+					log.WithFields(log.Fields{
+						"Namespace":     namespace,
+						"UID":           uid,
+						"PodName":       podName,
+						"ContainerName": containerName,
+						"Error":         err.Error(),
+					}).Error("Can't connect to S3 storage.")
+					return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
+				}
+
+				// Only the end of the logs is streamed, all of it is read otherwise
+				opts := s3.GetObjectOptions{}
+				if follow {
+					endOffset, err = strconv.Atoi(readEndOffset)
+					if err != nil {
+						return nil, util.NewUserError(codes.InvalidArgument, "Invalid range.")
+					}
+					err = opts.SetRange(0, int64(endOffset))
+					if err != nil {
+						log.WithFields(log.Fields{
+							"Namespace":     namespace,
+							"UID":           uid,
+							"PodName":       podName,
+							"ContainerName": containerName,
+							"Error":         err.Error(),
+						}).Error("Can't set range.")
+						return nil, util.NewUserError(codes.NotFound, "Can't connect to S3 storage.")
+					}
+				}
+
+				key := config.ArtifactRepository.S3.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
+				stream, err = s3Client.GetObject(config.ArtifactRepository.S3.Bucket, key, opts)
+			}
+		case config.ArtifactRepository.GCS != nil:
+			{
+				gcsClient, err = c.GetGCSClient(namespace, config.ArtifactRepository.GCS)
+				if err != nil {
+					log.WithFields(log.Fields{
+						"Namespace":     namespace,
+						"UID":           uid,
+						"PodName":       podName,
+						"ContainerName": containerName,
+						"Error":         err.Error(),
+					}).Error("Can't connect to GCS storage.")
+					return nil, util.NewUserError(codes.NotFound, "Can't connect to GCS storage.")
+				}
+				key := config.ArtifactRepository.GCS.FormatKey(namespace, uid, podName) + "/" + containerName + ".log"
+				stream, err = gcsClient.GetObject(config.ArtifactRepository.GCS.Bucket, key)
+			}
+		}
+	} else {
+		stream, err = c.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+			Container:  containerName,
+			Follow:     follow,
+			Timestamps: true,
+		}).Stream()
+	}
+	// TODO: Catch exact kubernetes error
+	//Todo: Can above todo be removed with the logging error?
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":     namespace,
+			"UID":           uid,
+			"PodName":       podName,
+			"ContainerName": containerName,
+			"Error":         err.Error(),
+		}).Error("Error with logs.")
+		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+	}
+
+	if stream == nil {
+		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+	}
+
+	return stream, nil
+}
+
+// getWorkflowExecutionLogParsers returns the log parsers for a node of the workflow.
+// A template can set a custom log format, a regular expression with named groups,
+// with the onepanel.io/log-format annotation.
+func getWorkflowExecutionLogParsers(wf *wfv1.Workflow, nodeID string) []LogParser {
+	node, ok := wf.Status.Nodes[nodeID]
+	if !ok {
+		return DefaultLogParsers
+	}
+
+	template := getNodeTemplate(wf, &node)
+	if template == nil {
+		return DefaultLogParsers
+	}
+
+	return GetLogParsers(template.Metadata.Annotations[label.LogFormat])
+}
+
+// validateLogFormats checks the custom log formats of the templates of a workflow
+func validateLogFormats(wf *wfv1.Workflow) error {
+	for _, template := range wf.Spec.Templates {
+		logFormat, ok := template.Metadata.Annotations[label.LogFormat]
+		if !ok {
+			continue
+		}
+
+		if _, err := NewRegexLogParser(logFormat); err != nil {
+			return fmt.Errorf("template '%v': %v", template.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// getWorkflowExecutionPodNodes returns the nodes of the workflow that ran in a pod, ordered by when they started
+func getWorkflowExecutionPodNodes(wf *wfv1.Workflow) []wfv1.NodeStatus {
+	nodes := make([]wfv1.NodeStatus, 0)
+	for _, node := range wf.Status.Nodes {
+		if node.Type != wfv1.NodeTypePod {
+			continue
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].ID < nodes[j].ID
+		}
+		return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+	})
+
+	return nodes
+}
+
+// SearchWorkflowExecutionLogs searches the logs of all of the pods of a workflow execution.
+// Pods whose logs can't be read are skipped.
+func (c *Client) SearchWorkflowExecutionLogs(namespace, uid string, search *WorkflowExecutionLogSearch) (*WorkflowExecutionLogSearchResult, error) {
+	if err := search.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	wf, err := c.getArgoWorkflow(namespace, uid)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	result := &WorkflowExecutionLogSearchResult{
+		Matches: make([]*WorkflowExecutionLogMatch, 0),
+	}
+	containerName := search.GetContainerName()
+	limit := search.GetLimit()
+
+	for _, node := range getWorkflowExecutionPodNodes(wf) {
+		stream, err := c.openWorkflowExecutionLogs(namespace, uid, node.ID, containerName, node.Completed(), false)
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(stream)
+		scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+
+		parsers := getWorkflowExecutionLogParsers(wf, node.ID)
+		lineNumber := 0
+		for scanner.Scan() {
+			lineNumber++
+
+			line := scanner.Text()
+			entry := LogEntryFromLine(&line, parsers...)
+			if !search.Matches(entry) {
+				continue
+			}
+
+			if len(result.Matches) == limit {
+				result.Truncated = true
+				break
+			}
+
+			result.Matches = append(result.Matches, &WorkflowExecutionLogMatch{
+				PodName:       node.ID,
+				DisplayName:   node.DisplayName,
+				TemplateName:  node.TemplateName,
+				ContainerName: containerName,
+				LineNumber:    lineNumber,
+				Entry:         entry,
+			})
+		}
+
+		if err := scanner.Err(); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"PodName":   node.ID,
+				"Error":     err.Error(),
+			}).Error("Unable to read logs.")
+		}
+		stream.Close()
+
+		if result.Truncated {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// GetWorkflowExecutionLogsBundle returns the main container logs of all of the pods of a workflow execution
+// as a single zip or gzipped tar file. Each pod's logs are in <pod name>/main.log. If a pod's logs can't be read,
+// the reason is in <pod name>/main.log.error instead.
+// Logs over maxWorkflowExecutionLogsBundleSize in total are not bundled, a FailedPrecondition error is returned.
+func (c *Client) GetWorkflowExecutionLogsBundle(namespace, uid, format string) (*WorkflowExecutionLogsBundle, error) {
+	if format == "" {
+		format = WorkflowExecutionLogsBundleFormatZip
+	}
+	if format != WorkflowExecutionLogsBundleFormatZip && format != WorkflowExecutionLogsBundleFormatGzip {
+		return nil, util.NewUserError(codes.InvalidArgument, "Format must be zip or gzip.")
+	}
+
+	wf, err := c.getArgoWorkflow(namespace, uid)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Workflow not found.")
+		return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+	}
+
+	files := make([]*bundleFile, 0)
+	remaining := int64(maxWorkflowExecutionLogsBundleSize)
+	for _, node := range getWorkflowExecutionPodNodes(wf) {
+		name := node.ID + "/" + defaultWorkflowExecutionLogContainer + ".log"
+
+		data, err := c.readWorkflowExecutionLogs(namespace, uid, node, remaining)
+		if err == errLogsBundleTooLarge {
+			message := fmt.Sprintf("The logs are over the %v MiB a bundle can have, search them or get the logs of each pod instead.", maxWorkflowExecutionLogsBundleSize>>20)
+			return nil, util.NewUserError(codes.FailedPrecondition, message)
+		}
+		if err != nil {
+			files = append(files, &bundleFile{
+				Name: name + ".error",
+				Data: []byte(err.Error()),
+			})
+			continue
+		}
+
+		remaining -= int64(len(data))
+		files = append(files, &bundleFile{
+			Name: name,
+			Data: data,
+		})
+	}
+
+	bundle := &WorkflowExecutionLogsBundle{}
+	if format == WorkflowExecutionLogsBundleFormatGzip {
+		bundle.Name = uid + "-logs.tar.gz"
+		bundle.ContentType = "application/gzip"
+		bundle.Data, err = writeTarGzBundle(files)
+	} else {
+		bundle.Name = uid + "-logs.zip"
+		bundle.ContentType = "application/zip"
+		bundle.Data, err = writeZipBundle(files)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return bundle, nil
+}
+
+// errLogsBundleTooLarge is returned when the logs of a bundle are over maxWorkflowExecutionLogsBundleSize
+var errLogsBundleTooLarge = errors.New("logs bundle is too large")
+
+// readWorkflowExecutionLogs reads all of the main container logs of a workflow execution node,
+// or returns errLogsBundleTooLarge if there are more than limit bytes of them
+func (c *Client) readWorkflowExecutionLogs(namespace, uid string, node wfv1.NodeStatus, limit int64) ([]byte, error) {
+	stream, err := c.openWorkflowExecutionLogs(namespace, uid, node.ID, defaultWorkflowExecutionLogContainer, node.Completed(), false)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	return readLimited(stream, limit)
+}
+
+// readLimited reads all of reader, or returns errLogsBundleTooLarge if it has more than limit bytes.
+// At most limit+1 bytes are read.
+func readLimited(reader io.Reader, limit int64) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, errLogsBundleTooLarge
+	}
+
+	return data, nil
+}
+
+// tailLogEntries returns the last n log entries of stream
+func tailLogEntries(stream io.Reader, n int, parsers []LogParser) ([]*LogEntry, error) {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 64*1024), maxLogLineSize)
+
+	lines := make([]string, 0, n)
+	for scanner.Scan() {
+		if len(lines) == n {
+			lines = lines[1:]
+		}
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*LogEntry, 0, len(lines))
+	for i := range lines {
+		entry := LogEntryFromLine(&lines[i], parsers...)
+		if entry == nil || entry.IsEmpty() {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// bundleFile is a file in a logs bundle
+type bundleFile struct {
+	Name string
+	Data []byte
+}
+
+// writeZipBundle writes the files to a zip archive
+func writeZipBundle(files []*bundleFile) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer := zip.NewWriter(buffer)
+
+	for _, file := range files {
+		fileWriter, err := writer.CreateHeader(&zip.FileHeader{
+			Name:     file.Name,
+			Method:   zip.Deflate,
+			Modified: time.Now().UTC(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := fileWriter.Write(file.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// writeTarGzBundle writes the files to a gzipped tar archive
+func writeTarGzBundle(files []*bundleFile) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, file := range files {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name:    file.Name,
+			Mode:    0644,
+			Size:    int64(len(file.Data)),
+			ModTime: time.Now().UTC(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tarWriter.Write(file.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
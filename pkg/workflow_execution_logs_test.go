@@ -0,0 +1,128 @@
+package v1
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/stretchr/testify/assert"
+)
+
+var testBundleFiles = []*bundleFile{
+	{Name: "pod-1/main.log", Data: []byte("epoch 1\n")},
+	{Name: "pod-2/main.log.error", Data: []byte("Log not found.")},
+}
+
+// Test_writeZipBundle tests writing logs to a zip archive
+func Test_writeZipBundle(t *testing.T) {
+	data, err := writeZipBundle(testBundleFiles)
+	assert.Nil(t, err)
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	assert.Nil(t, err)
+	assert.Len(t, reader.File, 2)
+	assert.Equal(t, "pod-1/main.log", reader.File[0].Name)
+
+	file, err := reader.File[0].Open()
+	assert.Nil(t, err)
+	content, err := ioutil.ReadAll(file)
+	assert.Nil(t, err)
+	assert.Equal(t, "epoch 1\n", string(content))
+}
+
+// Test_writeTarGzBundle tests writing logs to a gzipped tar archive
+func Test_writeTarGzBundle(t *testing.T) {
+	data, err := writeTarGzBundle(testBundleFiles)
+	assert.Nil(t, err)
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	assert.Nil(t, err)
+	reader := tar.NewReader(gzipReader)
+
+	header, err := reader.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "pod-1/main.log", header.Name)
+
+	content, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "epoch 1\n", string(content))
+
+	header, err = reader.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "pod-2/main.log.error", header.Name)
+}
+
+// Test_getWorkflowExecutionLogParsers tests using the log format of a node's template
+func Test_getWorkflowExecutionLogParsers(t *testing.T) {
+	wf := &wfv1.Workflow{
+		Spec: wfv1.WorkflowSpec{
+			Templates: []wfv1.Template{
+				{
+					Name: "train",
+					Metadata: wfv1.Metadata{
+						Annotations: map[string]string{label.LogFormat: `^(?P<level>\w+) (?P<message>.*)$`},
+					},
+				},
+				{Name: "process"},
+			},
+		},
+		Status: wfv1.WorkflowStatus{
+			Nodes: wfv1.Nodes{
+				"train-1":   {ID: "train-1", TemplateName: "train"},
+				"process-1": {ID: "process-1", TemplateName: "process"},
+				"eval-1":    {ID: "eval-1", TemplateRef: &wfv1.TemplateRef{Name: "shared", Template: "eval"}},
+			},
+			StoredTemplates: map[string]wfv1.Template{
+				"namespaced/shared/eval": {
+					Name: "eval",
+					Metadata: wfv1.Metadata{
+						Annotations: map[string]string{label.LogFormat: `^(?P<message>.*)$`},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Len(t, getWorkflowExecutionLogParsers(wf, "train-1"), len(DefaultLogParsers)+1)
+	assert.Len(t, getWorkflowExecutionLogParsers(wf, "eval-1"), len(DefaultLogParsers)+1)
+	assert.Equal(t, DefaultLogParsers, getWorkflowExecutionLogParsers(wf, "process-1"))
+	assert.Equal(t, DefaultLogParsers, getWorkflowExecutionLogParsers(wf, "missing"))
+
+	assert.Nil(t, validateLogFormats(wf))
+	wf.Spec.Templates[1].Metadata.Annotations = map[string]string{label.LogFormat: `(`}
+	assert.NotNil(t, validateLogFormats(wf))
+}
+
+// Test_tailLogEntries tests reading the last lines of a log stream
+func Test_tailLogEntries(t *testing.T) {
+	lines := make([]string, 0)
+	for i := 1; i <= 60; i++ {
+		lines = append(lines, fmt.Sprintf("2021-04-15T10:00:00Z line %v", i))
+	}
+
+	entries, err := tailLogEntries(strings.NewReader(strings.Join(lines, "\n")+"\n"), 50, DefaultLogParsers)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 50)
+	assert.Equal(t, "line 11", entries[0].Content)
+	assert.Equal(t, "line 60", entries[49].Content)
+
+	entries, err = tailLogEntries(strings.NewReader("2021-04-15T10:00:00Z only\n"), 50, DefaultLogParsers)
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func Test_readLimited(t *testing.T) {
+	data, err := readLimited(strings.NewReader("hello"), 5)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("hello"), data)
+
+	_, err = readLimited(strings.NewReader("hello"), 4)
+	assert.Equal(t, errLogsBundleTooLarge, err)
+}
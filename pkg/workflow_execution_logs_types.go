@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// LogLevelDebug is the level of debug and trace log entries
+	LogLevelDebug = "debug"
+	// LogLevelInfo is the level of informational log entries
+	LogLevelInfo = "info"
+	// LogLevelWarning is the level of warning log entries
+	LogLevelWarning = "warning"
+	// LogLevelError is the level of error log entries
+	LogLevelError = "error"
+	// LogLevelFatal is the level of fatal, critical and panic log entries
+	LogLevelFatal = "fatal"
+
+	// WorkflowExecutionLogsBundleFormatZip bundles logs as a zip file
+	WorkflowExecutionLogsBundleFormatZip = "zip"
+	// WorkflowExecutionLogsBundleFormatGzip bundles logs as a gzipped tar file
+	WorkflowExecutionLogsBundleFormatGzip = "gzip"
+
+	// maxWorkflowExecutionLogsBundleSize is the most logs a bundle can have, before they are compressed.
+	// Bundles are built in memory and returned in a single response, so larger logs have to be searched
+	// or read pod by pod instead.
+	maxWorkflowExecutionLogsBundleSize = 64 << 20
+
+	// defaultWorkflowExecutionLogContainer is the container argo archives logs for
+	defaultWorkflowExecutionLogContainer = "main"
+	// defaultWorkflowExecutionLogSearchLimit is the number of matches returned by a search if it has no limit
+	defaultWorkflowExecutionLogSearchLimit = 1000
+	// maxWorkflowExecutionLogSearchLimit is the maximum number of matches returned by a search
+	maxWorkflowExecutionLogSearchLimit = 10000
+)
+
+// logLevelSeverity orders the log levels, higher is more severe
+var logLevelSeverity = map[string]int{
+	LogLevelDebug:   1,
+	LogLevelInfo:    2,
+	LogLevelWarning: 3,
+	LogLevelError:   4,
+	LogLevelFatal:   5,
+}
+
+// logLevelAliases maps the level names used by common logging libraries to a log level
+var logLevelAliases = map[string]string{
+	"trace":    LogLevelDebug,
+	"debug":    LogLevelDebug,
+	"info":     LogLevelInfo,
+	"notice":   LogLevelInfo,
+	"warn":     LogLevelWarning,
+	"warning":  LogLevelWarning,
+	"err":      LogLevelError,
+	"error":    LogLevelError,
+	"fatal":    LogLevelFatal,
+	"critical": LogLevelFatal,
+	"panic":    LogLevelFatal,
+}
+
+var (
+	// logLevelFieldRegex matches level fields like level=error or "level":"error"
+	logLevelFieldRegex = regexp.MustCompile(`(?i)\b(?:level|lvl|severity)"?\s*[=:]\s*"?([a-z]+)`)
+	// logLevelPrefixRegex matches lines that start with a level, like [ERROR] or INFO:
+	logLevelPrefixRegex = regexp.MustCompile(`(?i)^\W*(trace|debug|info|notice|warn|warning|err|error|fatal|critical|panic)\b`)
+)
+
+// LogLevelFromContent returns the level of a log line's content, or an empty string if it can not be determined
+func LogLevelFromContent(content string) string {
+	if match := logLevelFieldRegex.FindStringSubmatch(content); match != nil {
+		if level, ok := logLevelAliases[strings.ToLower(match[1])]; ok {
+			return level
+		}
+	}
+
+	if match := logLevelPrefixRegex.FindStringSubmatch(content); match != nil {
+		return logLevelAliases[strings.ToLower(match[1])]
+	}
+
+	return ""
+}
+
+// WorkflowExecutionLogSearch filters the log entries of a workflow execution
+type WorkflowExecutionLogSearch struct {
+	// Query is a substring, or a regular expression if Regex is true, the content must match
+	Query string
+	Regex bool
+	// Since and Until limit the entries to a time range. Entries without a timestamp are not filtered by time.
+	Since *time.Time
+	Until *time.Time
+	// Level is the minimum level of the entries. Entries without a level don't match if it is set.
+	Level string
+	// ContainerName is the container to search the logs of, it defaults to main
+	ContainerName string
+	// Limit is the maximum number of matches returned
+	Limit int
+
+	pattern *regexp.Regexp
+}
+
+// Validate checks the search and prepares it for use by Matches
+func (s *WorkflowExecutionLogSearch) Validate() error {
+	if s.Regex {
+		pattern, err := regexp.Compile(s.Query)
+		if err != nil {
+			return fmt.Errorf("invalid regular expression: %v", err)
+		}
+		s.pattern = pattern
+	}
+
+	if s.Level != "" {
+		level, ok := logLevelAliases[strings.ToLower(s.Level)]
+		if !ok {
+			return fmt.Errorf("unsupported log level '%v'", s.Level)
+		}
+		s.Level = level
+	}
+
+	if s.Since != nil && s.Until != nil && s.Until.Before(*s.Since) {
+		return fmt.Errorf("until can not be before since")
+	}
+
+	if s.Limit < 0 || s.Limit > maxWorkflowExecutionLogSearchLimit {
+		return fmt.Errorf("limit must be between 0 and %v", maxWorkflowExecutionLogSearchLimit)
+	}
+
+	return nil
+}
+
+// GetContainerName returns the container to search, defaulting to main
+func (s *WorkflowExecutionLogSearch) GetContainerName() string {
+	if s.ContainerName == "" {
+		return defaultWorkflowExecutionLogContainer
+	}
+
+	return s.ContainerName
+}
+
+// GetLimit returns the maximum number of matches to return
+func (s *WorkflowExecutionLogSearch) GetLimit() int {
+	if s.Limit == 0 {
+		return defaultWorkflowExecutionLogSearchLimit
+	}
+
+	return s.Limit
+}
+
+// Matches returns true if the log entry passes all of the filters of the search.
+// Validate must be called first.
+func (s *WorkflowExecutionLogSearch) Matches(entry *LogEntry) bool {
+	if entry == nil || entry.IsEmpty() {
+		return false
+	}
+
+	if !entry.Timestamp.IsZero() {
+		if s.Since != nil && entry.Timestamp.Before(*s.Since) {
+			return false
+		}
+		if s.Until != nil && entry.Timestamp.After(*s.Until) {
+			return false
+		}
+	}
+
+	if s.Level != "" {
+		level := entry.Level
+		if level == "" {
+			level = LogLevelFromContent(entry.Content)
+		}
+		if logLevelSeverity[level] < logLevelSeverity[s.Level] {
+			return false
+		}
+	}
+
+	if s.pattern != nil {
+		return s.pattern.MatchString(entry.Content)
+	}
+
+	return strings.Contains(entry.Content, s.Query)
+}
+
+// WorkflowExecutionLogMatch is a log entry that matched a search, with the node it was logged by
+type WorkflowExecutionLogMatch struct {
+	PodName       string
+	DisplayName   string
+	TemplateName  string
+	ContainerName string
+	// LineNumber is the line of the entry in the container's logs, starting at 1
+	LineNumber int
+	Entry      *LogEntry
+}
+
+// WorkflowExecutionLogSearchResult are the matches of a log search.
+// Truncated is true if there were more matches than the search limit.
+type WorkflowExecutionLogSearchResult struct {
+	Matches   []*WorkflowExecutionLogMatch
+	Truncated bool
+}
+
+// WorkflowExecutionLogsBundle is an archive of all of the logs of a workflow execution
+type WorkflowExecutionLogsBundle struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
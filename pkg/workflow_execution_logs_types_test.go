@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLogLevelFromContent tests detecting the level of log lines
+func TestLogLevelFromContent(t *testing.T) {
+	assert.Equal(t, LogLevelError, LogLevelFromContent(`time="2021-04-15T10:00:00Z" level=error msg="failed"`))
+	assert.Equal(t, LogLevelWarning, LogLevelFromContent(`{"level":"warn","msg":"slow"}`))
+	assert.Equal(t, LogLevelInfo, LogLevelFromContent(`INFO:root:epoch 1`))
+	assert.Equal(t, LogLevelFatal, LogLevelFromContent(`[CRITICAL] out of memory`))
+	assert.Equal(t, "", LogLevelFromContent(`loss: 0.25 error rate: 0.1`))
+}
+
+// TestWorkflowExecutionLogSearch_Validate tests validating log searches
+func TestWorkflowExecutionLogSearch_Validate(t *testing.T) {
+	assert.Nil(t, (&WorkflowExecutionLogSearch{Query: "loss"}).Validate())
+	assert.NotNil(t, (&WorkflowExecutionLogSearch{Query: "(", Regex: true}).Validate())
+	assert.NotNil(t, (&WorkflowExecutionLogSearch{Level: "verbose"}).Validate())
+	assert.NotNil(t, (&WorkflowExecutionLogSearch{Limit: maxWorkflowExecutionLogSearchLimit + 1}).Validate())
+
+	since := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	until := since.Add(-time.Hour)
+	assert.NotNil(t, (&WorkflowExecutionLogSearch{Since: &since, Until: &until}).Validate())
+
+	search := &WorkflowExecutionLogSearch{Level: "WARN"}
+	assert.Nil(t, search.Validate())
+	assert.Equal(t, LogLevelWarning, search.Level)
+	assert.Equal(t, "main", search.GetContainerName())
+	assert.Equal(t, defaultWorkflowExecutionLogSearchLimit, search.GetLimit())
+}
+
+// TestWorkflowExecutionLogSearch_Matches tests filtering log entries
+func TestWorkflowExecutionLogSearch_Matches(t *testing.T) {
+	since := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+
+	search := &WorkflowExecutionLogSearch{
+		Query: `loss=\d+\.\d+`,
+		Regex: true,
+		Since: &since,
+		Until: &until,
+	}
+	assert.Nil(t, search.Validate())
+
+	assert.True(t, search.Matches(&LogEntry{Timestamp: since.Add(time.Minute), Content: "loss=0.25"}))
+	assert.True(t, search.Matches(&LogEntry{Content: "loss=0.25"}))
+	assert.False(t, search.Matches(&LogEntry{Timestamp: since.Add(-time.Minute), Content: "loss=0.25"}))
+	assert.False(t, search.Matches(&LogEntry{Timestamp: until.Add(time.Minute), Content: "loss=0.25"}))
+	assert.False(t, search.Matches(&LogEntry{Timestamp: since, Content: "accuracy=0.9"}))
+	assert.False(t, search.Matches(&LogEntry{}))
+	assert.False(t, search.Matches(nil))
+
+	search = &WorkflowExecutionLogSearch{Level: LogLevelWarning}
+	assert.Nil(t, search.Validate())
+
+	assert.True(t, search.Matches(&LogEntry{Content: "level=error msg=failed"}))
+	assert.True(t, search.Matches(&LogEntry{Content: "WARNING: slow"}))
+	assert.False(t, search.Matches(&LogEntry{Content: "INFO: started"}))
+	assert.False(t, search.Matches(&LogEntry{Content: "started"}))
+}
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/argoproj/argo/persist/sqldb"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/argoproj/argo/workflow/hydrator"
+	argoutil "github.com/argoproj/argo/workflow/util"
+	"github.com/jmoiron/sqlx"
+	"github.com/onepanelio/core/pkg/util/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// WorkflowExecutionPhaseQueued is the phase of a workflow execution that is waiting for its workflow template's
+// concurrency limit to allow it to start. Queued executions exist in argo as suspended workflows.
+const WorkflowExecutionPhaseQueued wfv1.NodePhase = "Queued"
+
+// activeWorkflowExecutionPhases are the phases that count towards a concurrency limit
+var activeWorkflowExecutionPhases = []wfv1.NodePhase{wfv1.NodePending, wfv1.NodeRunning}
+
+// maxQueuedWorkflowExecutionsReleased is the maximum number of queued executions of a template that are considered
+// every time executions are released
+const maxQueuedWorkflowExecutionsReleased = 100
+
+// queuedWorkflowTemplate is a workflow template that has queued executions
+type queuedWorkflowTemplate struct {
+	ID          uint64
+	Concurrency *WorkflowTemplateConcurrency
+}
+
+// queuedWorkflowExecution is a queued workflow execution with the information needed to release it
+type queuedWorkflowExecution struct {
+	ID     uint64
+	UID    string
+	Labels types.JSONLabels
+}
+
+// ReleaseQueuedWorkflowExecutions starts the queued workflow executions in the namespace as far as the concurrency limits
+// of their workflow templates allow. This is called whenever an execution is queued or stops running.
+func (c *Client) ReleaseQueuedWorkflowExecutions(namespace string) error {
+	sb := sb.Select("DISTINCT wt.id", "wt.concurrency").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON we.workflow_template_version_id = wtv.id").
+		Join("workflow_templates wt ON wtv.workflow_template_id = wt.id").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.phase":       WorkflowExecutionPhaseQueued,
+			"we.is_archived": false,
+		})
+
+	workflowTemplates := make([]*queuedWorkflowTemplate, 0)
+	if err := c.DB.Selectx(&workflowTemplates, sb); err != nil {
+		return err
+	}
+
+	hy := hydrator.New(sqldb.ExplosiveOffloadNodeStatusRepo)
+	for _, workflowTemplate := range workflowTemplates {
+		released, err := c.claimQueuedWorkflowExecutions(namespace, workflowTemplate)
+		if err != nil {
+			return err
+		}
+
+		for _, uid := range released {
+			if err := argoutil.ResumeWorkflow(c.ArgoprojV1alpha1().Workflows(namespace), hy, uid, ""); err != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace,
+					"UID":       uid,
+					"Error":     err.Error(),
+				}).Error("Unable to resume released workflow execution.")
+				c.requeueWorkflowExecution(namespace, uid)
+			}
+		}
+	}
+
+	return nil
+}
+
+// releaseQueuedWorkflowExecutions calls ReleaseQueuedWorkflowExecutions and logs any error.
+// It is used after an execution stops running, where failing to release the queue should not fail the request.
+func (c *Client) releaseQueuedWorkflowExecutions(namespace string) {
+	if err := c.ReleaseQueuedWorkflowExecutions(namespace); err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"Error":     err.Error(),
+		}).Error("Unable to release queued workflow executions.")
+	}
+}
+
+// requeueWorkflowExecution puts a claimed execution that could not be resumed back in the queue,
+// so it doesn't take up a slot of the concurrency limit while it is still suspended
+func (c *Client) requeueWorkflowExecution(namespace, uid string) {
+	_, err := sb.Update("workflow_executions").
+		Set("phase", WorkflowExecutionPhaseQueued).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+			"phase":     wfv1.NodePending,
+		}).
+		RunWith(c.DB).
+		Exec()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to requeue workflow execution.")
+	}
+}
+
+// claimQueuedWorkflowExecutions marks the queued executions of the workflow template that fit in its concurrency limit
+// as Pending and returns their uids. The executions are claimed under an advisory lock, so concurrent calls
+// can't start more executions than the limit allows.
+func (c *Client) claimQueuedWorkflowExecutions(namespace string, workflowTemplate *queuedWorkflowTemplate) (released []string, err error) {
+	concurrency := workflowTemplate.Concurrency
+
+	tx, err := c.DB.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	lockKey := fmt.Sprintf("workflow_template/%v", workflowTemplate.ID)
+	if concurrency.IsEnabled() && concurrency.GetScope() == ConcurrencyScopeNamespace {
+		lockKey = "namespace/" + namespace
+	}
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext($1))", lockKey); err != nil {
+		return nil, err
+	}
+
+	queuedSb := sb.Select("we.id", "we.uid", "we.labels").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON we.workflow_template_version_id = wtv.id").
+		Where(sq.Eq{
+			"wtv.workflow_template_id": workflowTemplate.ID,
+			"we.namespace":             namespace,
+			"we.phase":                 WorkflowExecutionPhaseQueued,
+			"we.is_archived":           false,
+		}).
+		Limit(maxQueuedWorkflowExecutionsReleased).
+		Suffix("FOR UPDATE OF we")
+
+	if concurrency.IsEnabled() && concurrency.Order == ConcurrencyOrderPriority {
+		queuedSb = queuedSb.OrderBy("we.priority DESC", "we.created_at", "we.id")
+	} else {
+		queuedSb = queuedSb.OrderBy("we.created_at", "we.id")
+	}
+
+	query, args, err := queuedSb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	queued := make([]*queuedWorkflowExecution, 0)
+	if err := tx.Select(&queued, query, args...); err != nil {
+		return nil, err
+	}
+
+	for _, execution := range queued {
+		if concurrency.IsEnabled() {
+			active, err := countActiveWorkflowExecutions(tx, namespace, workflowTemplate.ID, concurrency, execution)
+			if err != nil {
+				return nil, err
+			}
+
+			if active >= int(concurrency.Limit) {
+				// Executions with another label value may still fit in their own limit
+				if concurrency.GetScope() == ConcurrencyScopeLabel {
+					continue
+				}
+				break
+			}
+		}
+
+		_, err := sb.Update("workflow_executions").
+			Set("phase", wfv1.NodePending).
+			Where(sq.Eq{"id": execution.ID}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, err
+		}
+
+		released = append(released, execution.UID)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// countActiveWorkflowExecutions counts the Pending or Running executions that share a concurrency limit with execution
+func countActiveWorkflowExecutions(tx *sqlx.Tx, namespace string, workflowTemplateID uint64, concurrency *WorkflowTemplateConcurrency, execution *queuedWorkflowExecution) (count int, err error) {
+	countSb := sb.Select("COUNT(*)").
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON we.workflow_template_version_id = wtv.id").
+		Join("workflow_templates wt ON wtv.workflow_template_id = wt.id").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.phase":       activeWorkflowExecutionPhases,
+			"we.is_archived": false,
+		})
+
+	switch concurrency.GetScope() {
+	case ConcurrencyScopeNamespace:
+		countSb = countSb.Where(sq.Eq{"wt.is_system": false})
+	case ConcurrencyScopeLabel:
+		countSb = countSb.Where(sq.Eq{"wt.id": workflowTemplateID}).
+			Where("COALESCE(we.labels->>?, '') = ?", concurrency.LabelKey, execution.Labels[concurrency.LabelKey])
+	default:
+		countSb = countSb.Where(sq.Eq{"wt.id": workflowTemplateID})
+	}
+
+	err = countSb.RunWith(tx).
+		QueryRow().
+		Scan(&count)
+
+	return
+}
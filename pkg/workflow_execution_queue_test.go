@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testQueueWorkflowExecutions creates a workflow template with count queued executions, which are suspended
+// argo workflows, and returns the template and the uids of the executions in the order they were queued
+func testQueueWorkflowExecutions(t *testing.T, c *Client, namespace string, count int) (*queuedWorkflowTemplate, []string) {
+	wt, err := c.CreateWorkflowTemplate(namespace, &WorkflowTemplate{
+		Name:     "queued",
+		Manifest: defaultWorkflowTemplate,
+	})
+	assert.Nil(t, err)
+
+	workflowTemplateVersionID := uint64(0)
+	err = sb.Select("id").
+		From("workflow_template_versions").
+		Where(sq.Eq{"workflow_template_id": wt.ID}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&workflowTemplateVersionID)
+	assert.Nil(t, err)
+
+	uids := make([]string, count)
+	for i := range uids {
+		uids[i] = fmt.Sprintf("queued-%v", i)
+		_, err := c.ArgoprojV1alpha1().Workflows(namespace).Create(&wfv1.Workflow{
+			ObjectMeta: metav1.ObjectMeta{Name: uids[i]},
+			Spec:       wfv1.WorkflowSpec{Suspend: ptr.Bool(true)},
+		})
+		assert.Nil(t, err)
+
+		_, err = sb.Insert("workflow_executions").
+			SetMap(sq.Eq{
+				"uid":                          uids[i],
+				"name":                         uids[i],
+				"namespace":                    namespace,
+				"workflow_template_version_id": workflowTemplateVersionID,
+				"phase":                        WorkflowExecutionPhaseQueued,
+				"parameters":                   "[]",
+				"metrics":                      Metrics{},
+			}).
+			RunWith(c.DB).
+			Exec()
+		assert.Nil(t, err)
+	}
+
+	return &queuedWorkflowTemplate{ID: wt.ID, Concurrency: &WorkflowTemplateConcurrency{Limit: 2}}, uids
+}
+
+// testWorkflowExecutionPhase returns the phase of the execution with uid
+func testWorkflowExecutionPhase(t *testing.T, c *Client, namespace, uid string) (phase wfv1.NodePhase) {
+	err := sb.Select("phase").
+		From("workflow_executions").
+		Where(sq.Eq{"namespace": namespace, "uid": uid}).
+		RunWith(c.DB).
+		QueryRow().
+		Scan(&phase)
+	assert.Nil(t, err)
+
+	return
+}
+
+func TestClient_claimQueuedWorkflowExecutions_Limit(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	workflowTemplate, uids := testQueueWorkflowExecutions(t, c, namespace, 4)
+
+	released, err := c.claimQueuedWorkflowExecutions(namespace, workflowTemplate)
+	assert.Nil(t, err)
+	assert.Equal(t, uids[:2], released)
+
+	// The limit is full until a released execution finishes
+	released, err = c.claimQueuedWorkflowExecutions(namespace, workflowTemplate)
+	assert.Nil(t, err)
+	assert.Empty(t, released)
+
+	_, err = sb.Update("workflow_executions").
+		Set("phase", wfv1.NodeSucceeded).
+		Where(sq.Eq{"uid": uids[0]}).
+		RunWith(c.DB).
+		Exec()
+	assert.Nil(t, err)
+
+	released, err = c.claimQueuedWorkflowExecutions(namespace, workflowTemplate)
+	assert.Nil(t, err)
+	assert.Equal(t, uids[2:3], released)
+}
+
+func TestClient_claimQueuedWorkflowExecutions_Concurrent(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	workflowTemplate, _ := testQueueWorkflowExecutions(t, c, namespace, 10)
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	claimed := make([]string, 0)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			released, err := c.claimQueuedWorkflowExecutions(namespace, workflowTemplate)
+			assert.Nil(t, err)
+
+			mutex.Lock()
+			claimed = append(claimed, released...)
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, claimed, 2)
+}
+
+func TestClient_ReleaseQueuedWorkflowExecutions(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	workflowTemplate, uids := testQueueWorkflowExecutions(t, c, namespace, 3)
+	_, err := sb.Update("workflow_templates").
+		Set("concurrency", workflowTemplate.Concurrency).
+		Where(sq.Eq{"id": workflowTemplate.ID}).
+		RunWith(c.DB).
+		Exec()
+	assert.Nil(t, err)
+
+	// The second execution can't be resumed, so it goes back in the queue instead of holding a slot
+	err = c.ArgoprojV1alpha1().Workflows(namespace).Delete(uids[1], &metav1.DeleteOptions{})
+	assert.Nil(t, err)
+
+	err = c.ReleaseQueuedWorkflowExecutions(namespace)
+	assert.Nil(t, err)
+
+	assert.Equal(t, wfv1.NodePending, testWorkflowExecutionPhase(t, c, namespace, uids[0]))
+	assert.Equal(t, WorkflowExecutionPhaseQueued, testWorkflowExecutionPhase(t, c, namespace, uids[1]))
+	assert.Equal(t, WorkflowExecutionPhaseQueued, testWorkflowExecutionPhase(t, c, namespace, uids[2]))
+
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uids[0], metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.False(t, wf.Spec.Suspend != nil && *wf.Spec.Suspend)
+
+	// The slot of the requeued execution is free for the next release
+	_, err = c.ArgoprojV1alpha1().Workflows(namespace).Create(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: uids[1]},
+		Spec:       wfv1.WorkflowSpec{Suspend: ptr.Bool(true)},
+	})
+	assert.Nil(t, err)
+
+	err = c.ReleaseQueuedWorkflowExecutions(namespace)
+	assert.Nil(t, err)
+	assert.Equal(t, wfv1.NodePending, testWorkflowExecutionPhase(t, c, namespace, uids[1]))
+	assert.Equal(t, WorkflowExecutionPhaseQueued, testWorkflowExecutionPhase(t, c, namespace, uids[2]))
+}
+
+func TestClient_UpdateWorkflowExecutionStatus_Queued(t *testing.T) {
+	c := DefaultTestClient()
+	clearDatabase(t)
+
+	namespace := "onepanel"
+	_, uids := testQueueWorkflowExecutions(t, c, namespace, 1)
+
+	// A status sent before a cron workflow execution was suspended leaves it queued
+	err := c.UpdateWorkflowExecutionStatus(namespace, uids[0], &WorkflowExecutionStatus{Phase: wfv1.NodeRunning})
+	assert.Nil(t, err)
+	assert.Equal(t, WorkflowExecutionPhaseQueued, testWorkflowExecutionPhase(t, c, namespace, uids[0]))
+
+	err = c.UpdateWorkflowExecutionStatus(namespace, uids[0], &WorkflowExecutionStatus{Phase: wfv1.NodeFailed})
+	assert.Nil(t, err)
+	assert.Equal(t, wfv1.NodeFailed, testWorkflowExecutionPhase(t, c, namespace, uids[0]))
+}
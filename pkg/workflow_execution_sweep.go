@@ -0,0 +1,182 @@
+package v1
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/google/uuid"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/onepanelio/core/pkg/util/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+)
+
+// CreateWorkflowExecutionSweep creates an execution of workflowTemplate for each combination of parameter values in spec.
+// workflow holds the parameters, labels and priority shared by all of the executions.
+// The executions are linked by a sweep label, if any execution can't be created the ones already created are terminated.
+func (c *Client) CreateWorkflowExecutionSweep(namespace string, workflow *WorkflowExecution, workflowTemplate *WorkflowTemplate, spec *WorkflowExecutionSweepSpec) (*WorkflowExecutionSweep, error) {
+	combinations, err := spec.Combinations()
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	for _, param := range spec.Parameters {
+		found := false
+		for _, templateParam := range workflowTemplate.Parameters {
+			if templateParam.Name == param.Name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, util.NewUserError(codes.InvalidArgument, "Parameter '"+param.Name+"' does not exist in the workflow template.")
+		}
+	}
+
+	sweep := &WorkflowExecutionSweep{
+		UID:        uuid.New().String(),
+		Namespace:  namespace,
+		Executions: make([]*WorkflowExecution, 0, len(combinations)),
+	}
+
+	for _, combination := range combinations {
+		labels := types.JSONLabels{}
+		for key, value := range workflow.Labels {
+			labels[key] = value
+		}
+		labels[label.Sweep] = sweep.UID
+
+		execution := &WorkflowExecution{
+			Labels:     labels,
+			Parameters: mergeSweepParameters(workflow.Parameters, combination),
+			Priority:   workflow.Priority,
+			Ownership:  workflow.Ownership,
+		}
+
+		createdExecution, err := c.CreateWorkflowExecution(namespace, execution, workflowTemplate)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Sweep":     sweep.UID,
+				"Error":     err.Error(),
+			}).Error("Unable to create sweep workflow execution.")
+
+			if len(sweep.Executions) > 0 {
+				if terminateErr := c.TerminateWorkflowExecutionSweep(namespace, sweep.UID); terminateErr != nil {
+					log.WithFields(log.Fields{
+						"Namespace": namespace,
+						"Sweep":     sweep.UID,
+						"Error":     terminateErr.Error(),
+					}).Error("Unable to terminate partially created sweep.")
+				}
+			}
+
+			return nil, err
+		}
+		createdExecution.Namespace = namespace
+
+		sweep.Executions = append(sweep.Executions, createdExecution)
+	}
+
+	sweep.Statistics, err = c.GetWorkflowExecutionSweepStatistics(namespace, sweep.UID)
+	if err != nil {
+		return nil, err
+	}
+
+	return sweep, nil
+}
+
+// GetWorkflowExecutionSweep returns the executions of a sweep, most recently created first, along with their statistics
+func (c *Client) GetWorkflowExecutionSweep(namespace, uid string) (*WorkflowExecutionSweep, error) {
+	sb := workflowExecutionsSelectBuilder(namespace, "", "", true).
+		Where("we.labels->>? = ?", label.Sweep, uid).
+		OrderBy("we.created_at DESC", "we.id DESC")
+
+	executions := make([]*WorkflowExecution, 0)
+	if err := c.DB.Selectx(&executions, sb); err != nil {
+		return nil, err
+	}
+
+	if len(executions) == 0 {
+		return nil, util.NewUserError(codes.NotFound, "Sweep not found.")
+	}
+
+	for _, execution := range executions {
+		execution.Namespace = namespace
+	}
+
+	statistics, err := c.GetWorkflowExecutionSweepStatistics(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkflowExecutionSweep{
+		UID:        uid,
+		Namespace:  namespace,
+		Executions: executions,
+		Statistics: statistics,
+	}, nil
+}
+
+// GetWorkflowExecutionSweepStatistics returns the statistics on the executions of a sweep.
+// These are the same statistics GetWorkflowExecutionStatisticsForTemplates reports for a workflow template.
+func (c *Client) GetWorkflowExecutionSweepStatistics(namespace, uid string) (report *WorkflowExecutionStatisticReport, err error) {
+	sb := sb.Select(workflowExecutionStatisticsSelect).
+		From("workflow_executions we").
+		Join("workflow_template_versions wtv ON wtv.id = we.workflow_template_version_id").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.is_archived": false,
+		}).
+		Where("we.labels->>? = ?", label.Sweep, uid).
+		GroupBy("wtv.workflow_template_id")
+
+	reports := make([]*WorkflowExecutionStatisticReport, 0)
+	if err := c.DB.Selectx(&reports, sb); err != nil {
+		return nil, err
+	}
+
+	if len(reports) == 0 {
+		return &WorkflowExecutionStatisticReport{}, nil
+	}
+
+	return reports[0], nil
+}
+
+// TerminateWorkflowExecutionSweep terminates every execution of the sweep that has not finished.
+// Queued executions are terminated first, so terminating a running execution doesn't start one of them.
+func (c *Client) TerminateWorkflowExecutionSweep(namespace, uid string) error {
+	sb := sb.Select("we.uid").
+		From("workflow_executions we").
+		Where(sq.Eq{
+			"we.namespace":   namespace,
+			"we.is_archived": false,
+			"we.phase":       []wfv1.NodePhase{WorkflowExecutionPhaseQueued, wfv1.NodePending, wfv1.NodeRunning},
+		}).
+		Where("we.labels->>? = ?", label.Sweep, uid).
+		OrderBy("we.phase = 'Queued' DESC", "we.created_at DESC")
+
+	uids := make([]string, 0)
+	if err := c.DB.Selectx(&uids, sb); err != nil {
+		return err
+	}
+
+	var result error
+	for _, executionUID := range uids {
+		if err := c.TerminateWorkflowExecution(namespace, executionUID); err != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"Sweep":     uid,
+				"UID":       executionUID,
+				"Error":     err.Error(),
+			}).Error("Unable to terminate sweep workflow execution.")
+
+			if result == nil {
+				result = err
+			}
+		}
+	}
+
+	return result
+}
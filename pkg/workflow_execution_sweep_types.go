@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+)
+
+const (
+	// WorkflowExecutionSweepStrategyGrid creates an execution for every combination of the parameter values
+	WorkflowExecutionSweepStrategyGrid = "grid"
+	// WorkflowExecutionSweepStrategyRandom creates executions for a random sample of the combinations of the parameter values
+	WorkflowExecutionSweepStrategyRandom = "random"
+
+	// maxWorkflowExecutionSweepSize is the maximum number of executions a single sweep can create
+	maxWorkflowExecutionSweepSize = 500
+)
+
+// WorkflowExecutionSweepParameter is a parameter of a workflow template and the values a sweep runs it with
+type WorkflowExecutionSweepParameter struct {
+	Name   string
+	Values []string
+}
+
+// WorkflowExecutionSweepSpec describes the combinations of parameter values a sweep runs a workflow template with
+type WorkflowExecutionSweepSpec struct {
+	// Strategy is either grid or random, it defaults to grid
+	Strategy   string
+	Parameters []WorkflowExecutionSweepParameter
+	// MaxCount is the maximum number of executions to create. It is required for the random strategy.
+	MaxCount int
+	// Seed is the seed used by the random strategy, a random seed is used if it is 0
+	Seed int64
+}
+
+// WorkflowExecutionSweep is a group of executions of the same workflow template with different parameter values
+type WorkflowExecutionSweep struct {
+	UID        string
+	Namespace  string
+	Executions []*WorkflowExecution
+	Statistics *WorkflowExecutionStatisticReport
+}
+
+// GetStrategy returns the strategy of the sweep, defaulting to grid
+func (s *WorkflowExecutionSweepSpec) GetStrategy() string {
+	if s.Strategy == "" {
+		return WorkflowExecutionSweepStrategyGrid
+	}
+
+	return s.Strategy
+}
+
+// Validate checks that the sweep spec is well formed and does not create too many executions
+func (s *WorkflowExecutionSweepSpec) Validate() error {
+	switch s.GetStrategy() {
+	case WorkflowExecutionSweepStrategyGrid, WorkflowExecutionSweepStrategyRandom:
+	default:
+		return fmt.Errorf("unsupported sweep strategy '%v'", s.Strategy)
+	}
+
+	if len(s.Parameters) == 0 {
+		return fmt.Errorf("sweep requires at least one parameter")
+	}
+
+	names := make(map[string]bool)
+	for _, param := range s.Parameters {
+		if param.Name == "" {
+			return fmt.Errorf("sweep parameter name is required")
+		}
+		if names[param.Name] {
+			return fmt.Errorf("sweep parameter '%v' is repeated", param.Name)
+		}
+		if len(param.Values) == 0 {
+			return fmt.Errorf("sweep parameter '%v' has no values", param.Name)
+		}
+		names[param.Name] = true
+	}
+
+	if s.MaxCount < 0 {
+		return fmt.Errorf("sweep max count can not be negative")
+	}
+	if s.MaxCount > maxWorkflowExecutionSweepSize {
+		return fmt.Errorf("sweep max count can not be more than %v", maxWorkflowExecutionSweepSize)
+	}
+
+	if s.GetStrategy() == WorkflowExecutionSweepStrategyRandom && s.MaxCount == 0 {
+		return fmt.Errorf("random sweeps require a max count")
+	}
+
+	if s.MaxCount == 0 && s.size() > maxWorkflowExecutionSweepSize {
+		return fmt.Errorf("sweep has more than %v combinations, set a max count", maxWorkflowExecutionSweepSize)
+	}
+
+	return nil
+}
+
+// size returns the number of combinations of parameter values.
+// The result is capped so it does not overflow, anything over maxWorkflowExecutionSweepSize is too large for a grid
+// anyway. Random sweeps sample the value of each parameter instead, so they are not limited to the first combinations.
+func (s *WorkflowExecutionSweepSpec) size() int {
+	size := 1
+	for _, param := range s.Parameters {
+		size *= len(param.Values)
+		if size > maxWorkflowExecutionSweepSize {
+			return maxWorkflowExecutionSweepSize + 1
+		}
+	}
+
+	return size
+}
+
+// Combinations returns the parameter values of each execution in the sweep.
+// Grid sweeps return the combinations in order, varying the last parameter fastest.
+// Random sweeps return MaxCount distinct combinations.
+func (s *WorkflowExecutionSweepSpec) Combinations() ([][]Parameter, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	size := s.size()
+	count := size
+	if s.MaxCount > 0 && s.MaxCount < count {
+		count = s.MaxCount
+	}
+
+	sampled := make([][]int, 0, count)
+	if s.GetStrategy() == WorkflowExecutionSweepStrategyRandom && count < size {
+		seed := s.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		random := rand.New(rand.NewSource(seed))
+
+		// The value of each parameter is drawn on its own, as size is capped and can't be sampled from
+		picked := make(map[string]bool)
+		for len(sampled) < count {
+			valueIndexes := make([]int, len(s.Parameters))
+			for i, param := range s.Parameters {
+				valueIndexes[i] = random.Intn(len(param.Values))
+			}
+			key := fmt.Sprint(valueIndexes)
+			if picked[key] {
+				continue
+			}
+			picked[key] = true
+			sampled = append(sampled, valueIndexes)
+		}
+	} else {
+		for i := 0; i < count; i++ {
+			sampled = append(sampled, s.valueIndexes(i))
+		}
+	}
+
+	combinations := make([][]Parameter, 0, count)
+	for _, valueIndexes := range sampled {
+		combinations = append(combinations, s.combination(valueIndexes))
+	}
+
+	return combinations, nil
+}
+
+// valueIndexes returns the index of the value of each parameter in the combination at index, treating the parameters
+// as the digits of a mixed radix number with the last parameter as the least significant digit
+func (s *WorkflowExecutionSweepSpec) valueIndexes(index int) []int {
+	result := make([]int, len(s.Parameters))
+	for i := len(s.Parameters) - 1; i >= 0; i-- {
+		result[i] = index % len(s.Parameters[i].Values)
+		index /= len(s.Parameters[i].Values)
+	}
+
+	return result
+}
+
+// combination returns the parameter values at valueIndexes
+func (s *WorkflowExecutionSweepSpec) combination(valueIndexes []int) []Parameter {
+	result := make([]Parameter, len(s.Parameters))
+	for i, param := range s.Parameters {
+		result[i] = Parameter{
+			Name:  param.Name,
+			Value: ptr.String(param.Values[valueIndexes[i]]),
+		}
+	}
+
+	return result
+}
+
+// mergeSweepParameters returns a copy of parameters with the values in overrides.
+// Overrides that are not in parameters are appended.
+func mergeSweepParameters(parameters []Parameter, overrides []Parameter) []Parameter {
+	result := make([]Parameter, len(parameters))
+	copy(result, parameters)
+
+	for _, override := range overrides {
+		found := false
+		for i := range result {
+			if result[i].Name == override.Name {
+				result[i].Value = override.Value
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			result = append(result, override)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWorkflowExecutionSweepSpec_Validate tests validating sweep specs
+func TestWorkflowExecutionSweepSpec_Validate(t *testing.T) {
+	spec := &WorkflowExecutionSweepSpec{}
+	assert.NotNil(t, spec.Validate())
+
+	spec = &WorkflowExecutionSweepSpec{
+		Parameters: []WorkflowExecutionSweepParameter{{Name: "lr", Values: []string{"0.1", "0.01"}}},
+	}
+	assert.Nil(t, spec.Validate())
+
+	spec.Strategy = "bayesian"
+	assert.NotNil(t, spec.Validate())
+
+	spec.Strategy = WorkflowExecutionSweepStrategyRandom
+	assert.NotNil(t, spec.Validate())
+
+	spec.MaxCount = 1
+	assert.Nil(t, spec.Validate())
+
+	spec = &WorkflowExecutionSweepSpec{
+		Parameters: []WorkflowExecutionSweepParameter{
+			{Name: "lr", Values: []string{"0.1"}},
+			{Name: "lr", Values: []string{"0.01"}},
+		},
+	}
+	assert.NotNil(t, spec.Validate())
+
+	values := make([]string, 30)
+	spec = &WorkflowExecutionSweepSpec{
+		Parameters: []WorkflowExecutionSweepParameter{
+			{Name: "a", Values: values},
+			{Name: "b", Values: values},
+		},
+	}
+	assert.NotNil(t, spec.Validate())
+
+	spec.MaxCount = 10
+	assert.Nil(t, spec.Validate())
+}
+
+// TestWorkflowExecutionSweepSpec_Combinations tests generating grid and random combinations
+func TestWorkflowExecutionSweepSpec_Combinations(t *testing.T) {
+	spec := &WorkflowExecutionSweepSpec{
+		Parameters: []WorkflowExecutionSweepParameter{
+			{Name: "lr", Values: []string{"0.1", "0.01"}},
+			{Name: "batch", Values: []string{"16", "32", "64"}},
+		},
+	}
+
+	combinations, err := spec.Combinations()
+	assert.Nil(t, err)
+	assert.Len(t, combinations, 6)
+	assert.Equal(t, []Parameter{{Name: "lr", Value: ptr.String("0.1")}, {Name: "batch", Value: ptr.String("16")}}, combinations[0])
+	assert.Equal(t, []Parameter{{Name: "lr", Value: ptr.String("0.1")}, {Name: "batch", Value: ptr.String("32")}}, combinations[1])
+	assert.Equal(t, []Parameter{{Name: "lr", Value: ptr.String("0.01")}, {Name: "batch", Value: ptr.String("64")}}, combinations[5])
+
+	spec.MaxCount = 4
+	combinations, err = spec.Combinations()
+	assert.Nil(t, err)
+	assert.Len(t, combinations, 4)
+
+	spec.Strategy = WorkflowExecutionSweepStrategyRandom
+	spec.Seed = 42
+	combinations, err = spec.Combinations()
+	assert.Nil(t, err)
+	assert.Len(t, combinations, 4)
+
+	seen := make(map[string]bool)
+	for _, combination := range combinations {
+		key := *combination[0].Value + "/" + *combination[1].Value
+		assert.False(t, seen[key])
+		seen[key] = true
+	}
+
+	again, err := spec.Combinations()
+	assert.Nil(t, err)
+	assert.Equal(t, combinations, again)
+}
+
+// TestWorkflowExecutionSweepSpec_Combinations_RandomLarge tests that random sweeps over more combinations than a grid
+// can have vary every parameter, not only the last ones
+func TestWorkflowExecutionSweepSpec_Combinations_RandomLarge(t *testing.T) {
+	spec := &WorkflowExecutionSweepSpec{
+		Strategy: WorkflowExecutionSweepStrategyRandom,
+		MaxCount: 50,
+		Seed:     42,
+	}
+	values := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	for i := 0; i < 5; i++ {
+		spec.Parameters = append(spec.Parameters, WorkflowExecutionSweepParameter{Name: fmt.Sprintf("p%v", i), Values: values})
+	}
+
+	combinations, err := spec.Combinations()
+	assert.Nil(t, err)
+	assert.Len(t, combinations, 50)
+
+	for i := range spec.Parameters {
+		distinct := make(map[string]bool)
+		for _, combination := range combinations {
+			distinct[*combination[i].Value] = true
+		}
+		assert.Greater(t, len(distinct), 1, spec.Parameters[i].Name)
+	}
+}
+
+// Test_mergeSweepParameters tests overriding shared parameters with sweep values
+func Test_mergeSweepParameters(t *testing.T) {
+	parameters := []Parameter{
+		{Name: "lr", Value: ptr.String("0.1")},
+		{Name: "epochs", Value: ptr.String("10")},
+	}
+
+	result := mergeSweepParameters(parameters, []Parameter{
+		{Name: "lr", Value: ptr.String("0.5")},
+		{Name: "batch", Value: ptr.String("32")},
+	})
+
+	assert.Len(t, result, 3)
+	assert.Equal(t, "0.5", *result[0].Value)
+	assert.Equal(t, "10", *result[1].Value)
+	assert.Equal(t, "batch", result[2].Name)
+	assert.Equal(t, "0.1", *parameters[0].Value)
+}
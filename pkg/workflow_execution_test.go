@@ -1,7 +1,12 @@
 package v1
 
 import (
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	argoutil "github.com/argoproj/argo/workflow/util"
+	"github.com/onepanelio/core/pkg/util/ptr"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"testing"
 )
 
@@ -97,3 +102,58 @@ func TestClient_ArchiveWorkflowExecution_Exist(t *testing.T) {
 	err = c.ArchiveWorkflowExecution(namespace, weName)
 	assert.Nil(t, err)
 }
+
+// Test_getNodeFieldSelector makes sure the generated selector matches the node it was created from and no other
+func Test_getNodeFieldSelector(t *testing.T) {
+	node := wfv1.NodeStatus{
+		ID:           "train-abc-123",
+		DisplayName:  "train, model",
+		TemplateName: "train",
+	}
+
+	selector, err := fields.ParseSelector(getNodeFieldSelector(node))
+	assert.Nil(t, err)
+	assert.True(t, argoutil.SelectorMatchesNode(selector, node))
+
+	other := wfv1.NodeStatus{
+		ID:           "train-abc-456",
+		DisplayName:  "export",
+		TemplateName: "train",
+	}
+	assert.False(t, argoutil.SelectorMatchesNode(selector, other))
+}
+
+// TestClient_overrideArgoWorkflowParameters tests that overridden parameters can be restored with the returned values
+func TestClient_overrideArgoWorkflowParameters(t *testing.T) {
+	c := DefaultTestClient()
+
+	workflows := c.ArgoprojV1alpha1().Workflows("onepanel")
+	_, err := workflows.Create(&wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "train"},
+		Spec: wfv1.WorkflowSpec{
+			Arguments: wfv1.Arguments{
+				Parameters: []wfv1.Parameter{
+					{Name: "epochs", Value: wfv1.AnyStringPtr("10")},
+					{Name: "lr", Value: wfv1.AnyStringPtr("0.1")},
+				},
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	previous, err := c.overrideArgoWorkflowParameters("onepanel", "train", []Parameter{{Name: "epochs", Value: ptr.String("20")}})
+	assert.Nil(t, err)
+	assert.Len(t, previous, 1)
+	assert.Equal(t, "10", *previous[0].Value)
+
+	wf, err := workflows.Get("train", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "20", wf.Spec.Arguments.Parameters[0].Value.String())
+
+	_, err = c.overrideArgoWorkflowParameters("onepanel", "train", previous)
+	assert.Nil(t, err)
+	wf, err = workflows.Get("train", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "10", wf.Spec.Arguments.Parameters[0].Value.String())
+	assert.Equal(t, "0.1", wf.Spec.Arguments.Parameters[1].Value.String())
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// getWorkflowExecutionPodsAndEvents returns the pods of a workflow that still exist, and the events of its pods,
+// keyed by pod name
+func (c *Client) getWorkflowExecutionPodsAndEvents(namespace string, wf *wfv1.Workflow) (pods map[string]*corev1.Pod, events map[string][]*KubernetesEvent, err error) {
+	podList, err := c.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("workflows.argoproj.io/workflow=%v", wf.Name),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pods = make(map[string]*corev1.Pod, len(podList.Items))
+	for i := range podList.Items {
+		pods[podList.Items[i].Name] = &podList.Items[i]
+	}
+
+	// Events are listed for each pod of the workflow, since field selectors can't match a set of names
+	events = make(map[string][]*KubernetesEvent)
+	for _, node := range getWorkflowExecutionPodNodes(wf) {
+		eventList, err := c.CoreV1().Events(namespace).List(metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(fields.Set{
+				"involvedObject.kind": "Pod",
+				"involvedObject.name": node.ID,
+			}).String(),
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i := range eventList.Items {
+			events[node.ID] = append(events[node.ID], kubernetesEventFromEvent(&eventList.Items[i]))
+		}
+	}
+
+	return pods, events, nil
+}
+
+// getStoredWorkflowExecutionTimeline returns the timeline stored for a workflow execution, or nil if there is none
+func (c *Client) getStoredWorkflowExecutionTimeline(namespace, uid string) (*WorkflowExecutionTimeline, error) {
+	sb := sb.Select("timeline").
+		From("workflow_executions").
+		Where(sq.And{
+			sq.Eq{
+				"namespace": namespace,
+				"uid":       uid,
+			},
+			sq.NotEq{"timeline": nil},
+		})
+
+	timelines := make([]*WorkflowExecutionTimeline, 0)
+	if err := c.DB.Selectx(&timelines, sb); err != nil {
+		return nil, err
+	}
+
+	if len(timelines) == 0 {
+		return nil, nil
+	}
+
+	return timelines[0], nil
+}
+
+// saveWorkflowExecutionTimeline stores the timeline of a workflow execution
+func (c *Client) saveWorkflowExecutionTimeline(namespace, uid string, timeline *WorkflowExecutionTimeline) error {
+	_, err := sb.Update("workflow_executions").
+		Set("timeline", timeline).
+		Where(sq.Eq{
+			"namespace": namespace,
+			"uid":       uid,
+		}).
+		RunWith(c.DB).
+		Exec()
+
+	return err
+}
+
+// getWorkflowExecutionTimeline creates the timeline of a workflow using its pods and their events, filling
+// in the times of pods that no longer exist from the stored timeline. The timeline is stored when the workflow
+// is archived, see ArchiveWorkflowExecutionStatus.
+func (c *Client) getWorkflowExecutionTimeline(namespace string, wf *wfv1.Workflow) (*WorkflowExecutionTimeline, error) {
+	previous, err := c.getStoredWorkflowExecutionTimeline(namespace, wf.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, events, err := c.getWorkflowExecutionPodsAndEvents(namespace, wf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildWorkflowExecutionTimeline(wf, pods, events, previous, time.Now().UTC()), nil
+}
+
+// GetWorkflowExecutionTimeline returns when every node of a workflow execution was queued, scheduled, started and
+// finished, and its critical path. Once the workflow is archived, the timeline stored when it finished is returned.
+func (c *Client) GetWorkflowExecutionTimeline(namespace, uid string) (*WorkflowExecutionTimeline, error) {
+	wf, err := c.ArgoprojV1alpha1().Workflows(namespace).Get(uid, metav1.GetOptions{})
+	if err != nil {
+		timeline, dbErr := c.getStoredWorkflowExecutionTimeline(namespace, uid)
+		if dbErr != nil {
+			log.WithFields(log.Fields{
+				"Namespace": namespace,
+				"UID":       uid,
+				"Error":     dbErr.Error(),
+			}).Error("Unable to get stored workflow execution timeline.")
+			return nil, util.NewUserError(codes.Unknown, "Unable to get workflow execution timeline.")
+		}
+		if timeline == nil {
+			return nil, util.NewUserError(codes.NotFound, "Workflow not found.")
+		}
+
+		return timeline, nil
+	}
+
+	timeline, err := c.getWorkflowExecutionTimeline(namespace, wf)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to get workflow execution timeline.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get workflow execution timeline.")
+	}
+
+	return timeline, nil
+}
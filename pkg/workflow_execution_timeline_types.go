@@ -0,0 +1,325 @@
+package v1
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// WorkflowExecutionTimelineNode has the times a node of a workflow execution went through each stage.
+// QueuedAt is when the node was created, ScheduledAt when its pod was assigned to a node, StartedAt when its main
+// container started and FinishedAt when it completed. Nodes that don't run a pod only have QueuedAt, StartedAt and
+// FinishedAt. Times that are not known, like those of pods that were garbage collected before they were recorded,
+// are nil.
+type WorkflowExecutionTimelineNode struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	DisplayName  string     `json:"displayName"`
+	TemplateName string     `json:"templateName"`
+	Type         string     `json:"type"`
+	Phase        string     `json:"phase"`
+	BoundaryID   string     `json:"boundaryId,omitempty"`
+	Children     []string   `json:"children,omitempty"`
+	QueuedAt     *time.Time `json:"queuedAt,omitempty"`
+	ScheduledAt  *time.Time `json:"scheduledAt,omitempty"`
+	StartedAt    *time.Time `json:"startedAt,omitempty"`
+	FinishedAt   *time.Time `json:"finishedAt,omitempty"`
+	// WaitingSeconds is the time from being queued to starting, RunningSeconds the time from starting to finishing.
+	// Nodes that are not finished are measured up to when the timeline was created.
+	WaitingSeconds float64 `json:"waitingSeconds"`
+	RunningSeconds float64 `json:"runningSeconds"`
+	CriticalPath   bool    `json:"criticalPath"`
+}
+
+// WorkflowExecutionTimeline has the stages of every node of a workflow execution, and its critical path:
+// the chain of dependent pods, and suspend nodes, that determined when the workflow execution finished
+type WorkflowExecutionTimeline struct {
+	UID        string                           `json:"uid"`
+	Phase      string                           `json:"phase"`
+	StartedAt  *time.Time                       `json:"startedAt,omitempty"`
+	FinishedAt *time.Time                       `json:"finishedAt,omitempty"`
+	Nodes      []*WorkflowExecutionTimelineNode `json:"nodes"`
+	// CriticalPath are the ids of the nodes on the critical path, in the order they ran
+	CriticalPath               []string `json:"criticalPath"`
+	CriticalPathSeconds        float64  `json:"criticalPathSeconds"`
+	CriticalPathWaitingSeconds float64  `json:"criticalPathWaitingSeconds"`
+	CriticalPathRunningSeconds float64  `json:"criticalPathRunningSeconds"`
+	// WaitingSeconds and RunningSeconds are the totals of all of the pod and suspend nodes
+	WaitingSeconds float64 `json:"waitingSeconds"`
+	RunningSeconds float64 `json:"runningSeconds"`
+}
+
+// Value returns t as a value.
+// This is to support WorkflowExecutionTimeline working with JSONB column types in sql
+func (t WorkflowExecutionTimeline) Value() (driver.Value, error) {
+	return json.Marshal(t)
+}
+
+// Scan scans a JSONB column into t.
+// This is to support WorkflowExecutionTimeline working with JSONB column types in sql
+func (t *WorkflowExecutionTimeline) Scan(src interface{}) error {
+	var source []byte
+	switch typed := src.(type) {
+	case string:
+		source = []byte(typed)
+	case []byte:
+		source = typed
+	default:
+		return errors.New("incompatible type for WorkflowExecutionTimeline")
+	}
+
+	return json.Unmarshal(source, t)
+}
+
+// isTimelineWorkNode returns true for the nodes that take time themselves, rather than by containing other nodes
+func isTimelineWorkNode(nodeType string) bool {
+	return nodeType == string(wfv1.NodeTypePod) || nodeType == string(wfv1.NodeTypeSuspend)
+}
+
+// timePointer returns a pointer to t in UTC, or nil if t is zero
+func timePointer(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	utc := t.UTC()
+	return &utc
+}
+
+// getPodScheduledAt returns when a pod was assigned to a node, from its PodScheduled condition or Scheduled event
+func getPodScheduledAt(pod *corev1.Pod, events []*KubernetesEvent) *time.Time {
+	if pod != nil {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionTrue {
+				return timePointer(condition.LastTransitionTime.Time)
+			}
+		}
+	}
+
+	for _, event := range events {
+		if event.Reason == "Scheduled" && event.LastTimestamp != nil {
+			return event.LastTimestamp
+		}
+	}
+
+	return nil
+}
+
+// getPodStartedAt returns when the main container of a pod started, from its status or Started event
+func getPodStartedAt(pod *corev1.Pod, events []*KubernetesEvent) *time.Time {
+	if pod != nil {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name != defaultWorkflowExecutionLogContainer {
+				continue
+			}
+
+			if status.State.Running != nil {
+				return timePointer(status.State.Running.StartedAt.Time)
+			}
+			if status.State.Terminated != nil {
+				return timePointer(status.State.Terminated.StartedAt.Time)
+			}
+		}
+	}
+
+	for _, event := range events {
+		if event.Reason == "Started" && strings.HasSuffix(event.Message, " "+defaultWorkflowExecutionLogContainer) {
+			if event.FirstTimestamp != nil {
+				return event.FirstTimestamp
+			}
+			return event.LastTimestamp
+		}
+	}
+
+	return nil
+}
+
+// secondsBetween returns the seconds from start to end, or 0 if either is unknown or end is before start
+func secondsBetween(start, end *time.Time) float64 {
+	if start == nil || end == nil || end.Before(*start) {
+		return 0
+	}
+
+	return end.Sub(*start).Seconds()
+}
+
+// buildWorkflowExecutionTimeline creates the timeline of a workflow. pods and events are the pods of the workflow and
+// their events, keyed by pod name. Times missing from them are taken from previous, an earlier timeline of the same
+// workflow execution, if it is not nil. now is the time unfinished nodes are measured up to.
+func buildWorkflowExecutionTimeline(wf *wfv1.Workflow, pods map[string]*corev1.Pod, events map[string][]*KubernetesEvent, previous *WorkflowExecutionTimeline, now time.Time) *WorkflowExecutionTimeline {
+	timeline := &WorkflowExecutionTimeline{
+		UID:          wf.Name,
+		Phase:        string(wf.Status.Phase),
+		StartedAt:    timePointer(wf.Status.StartedAt.Time),
+		FinishedAt:   timePointer(wf.Status.FinishedAt.Time),
+		Nodes:        make([]*WorkflowExecutionTimelineNode, 0, len(wf.Status.Nodes)),
+		CriticalPath: make([]string, 0),
+	}
+
+	previousNodes := make(map[string]*WorkflowExecutionTimelineNode)
+	if previous != nil {
+		for _, node := range previous.Nodes {
+			previousNodes[node.ID] = node
+		}
+	}
+
+	nodes := make(map[string]*WorkflowExecutionTimelineNode, len(wf.Status.Nodes))
+	for _, status := range wf.Status.Nodes {
+		node := &WorkflowExecutionTimelineNode{
+			ID:           status.ID,
+			Name:         status.Name,
+			DisplayName:  status.DisplayName,
+			TemplateName: status.TemplateName,
+			Type:         string(status.Type),
+			Phase:        string(status.Phase),
+			BoundaryID:   status.BoundaryID,
+			Children:     status.Children,
+			QueuedAt:     timePointer(status.StartedAt.Time),
+			FinishedAt:   timePointer(status.FinishedAt.Time),
+		}
+
+		if status.Type == wfv1.NodeTypePod {
+			node.ScheduledAt = getPodScheduledAt(pods[status.ID], events[status.ID])
+			node.StartedAt = getPodStartedAt(pods[status.ID], events[status.ID])
+
+			if previousNode, ok := previousNodes[status.ID]; ok {
+				if node.ScheduledAt == nil {
+					node.ScheduledAt = previousNode.ScheduledAt
+				}
+				if node.StartedAt == nil {
+					node.StartedAt = previousNode.StartedAt
+				}
+			}
+		} else {
+			node.StartedAt = node.QueuedAt
+		}
+
+		end := node.FinishedAt
+		if end == nil && node.QueuedAt != nil {
+			end = &now
+		}
+		started := node.StartedAt
+		if started == nil && !status.Fulfilled() {
+			// The main container hasn't started yet
+			started = end
+		} else if started == nil {
+			// When the main container started is not known, it is assumed to have started once the pod was scheduled
+			started = node.ScheduledAt
+			if started == nil {
+				started = node.QueuedAt
+			}
+		}
+		node.WaitingSeconds = secondsBetween(node.QueuedAt, started)
+		node.RunningSeconds = secondsBetween(started, end)
+
+		if isTimelineWorkNode(node.Type) {
+			timeline.WaitingSeconds += node.WaitingSeconds
+			timeline.RunningSeconds += node.RunningSeconds
+		}
+
+		nodes[node.ID] = node
+		timeline.Nodes = append(timeline.Nodes, node)
+	}
+
+	sort.Slice(timeline.Nodes, func(i, j int) bool {
+		a, b := timeline.Nodes[i], timeline.Nodes[j]
+		if a.QueuedAt == nil || b.QueuedAt == nil || a.QueuedAt.Equal(*b.QueuedAt) {
+			if (a.QueuedAt == nil) != (b.QueuedAt == nil) {
+				return b.QueuedAt == nil
+			}
+			return a.ID < b.ID
+		}
+		return a.QueuedAt.Before(*b.QueuedAt)
+	})
+
+	setWorkflowExecutionCriticalPath(timeline, nodes, now)
+
+	return timeline
+}
+
+// setWorkflowExecutionCriticalPath finds the critical path of a timeline. A work node precedes another if the other can
+// be reached through its children without passing through another work node. The critical path ends at the work node
+// that finished last, and goes back through the predecessor that finished last each time.
+func setWorkflowExecutionCriticalPath(timeline *WorkflowExecutionTimeline, nodes map[string]*WorkflowExecutionTimelineNode, now time.Time) {
+	finishedAt := func(node *WorkflowExecutionTimelineNode) time.Time {
+		if node.FinishedAt != nil {
+			return *node.FinishedAt
+		}
+		if node.QueuedAt != nil {
+			return now
+		}
+		return time.Time{}
+	}
+
+	predecessors := make(map[string][]*WorkflowExecutionTimelineNode)
+	for _, node := range timeline.Nodes {
+		if !isTimelineWorkNode(node.Type) {
+			continue
+		}
+
+		visited := map[string]bool{node.ID: true}
+		queue := append([]string{}, node.Children...)
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			if visited[id] {
+				continue
+			}
+			visited[id] = true
+
+			child, ok := nodes[id]
+			if !ok {
+				continue
+			}
+			if isTimelineWorkNode(child.Type) {
+				predecessors[id] = append(predecessors[id], node)
+				continue
+			}
+			queue = append(queue, child.Children...)
+		}
+	}
+
+	var last *WorkflowExecutionTimelineNode
+	for _, node := range timeline.Nodes {
+		if !isTimelineWorkNode(node.Type) || node.QueuedAt == nil {
+			continue
+		}
+		if last == nil || finishedAt(node).After(finishedAt(last)) {
+			last = node
+		}
+	}
+
+	path := make([]*WorkflowExecutionTimelineNode, 0)
+	onPath := make(map[string]bool)
+	for node := last; node != nil && !onPath[node.ID]; {
+		path = append(path, node)
+		onPath[node.ID] = true
+
+		var next *WorkflowExecutionTimelineNode
+		for _, predecessor := range predecessors[node.ID] {
+			if next == nil || finishedAt(predecessor).After(finishedAt(next)) {
+				next = predecessor
+			}
+		}
+		node = next
+	}
+
+	for i := len(path) - 1; i >= 0; i-- {
+		node := path[i]
+		node.CriticalPath = true
+		timeline.CriticalPath = append(timeline.CriticalPath, node.ID)
+		timeline.CriticalPathWaitingSeconds += node.WaitingSeconds
+		timeline.CriticalPathRunningSeconds += node.RunningSeconds
+	}
+
+	if last != nil {
+		end := finishedAt(last)
+		timeline.CriticalPathSeconds = secondsBetween(timeline.StartedAt, &end)
+	}
+}
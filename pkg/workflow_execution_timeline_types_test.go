@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testTimelineWorkflow returns a DAG where a runs first, then b and c, and d once both are done. c takes longer than b.
+func testTimelineWorkflow(start time.Time) *wfv1.Workflow {
+	at := func(minutes int) metav1.Time {
+		return metav1.NewTime(start.Add(time.Duration(minutes) * time.Minute))
+	}
+
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf"},
+		Status: wfv1.WorkflowStatus{
+			Phase:      wfv1.NodeSucceeded,
+			StartedAt:  at(0),
+			FinishedAt: at(40),
+			Nodes: wfv1.Nodes{
+				"wf":   {ID: "wf", Type: wfv1.NodeTypeDAG, Phase: wfv1.NodeSucceeded, StartedAt: at(0), FinishedAt: at(40), Children: []string{"wf-a"}},
+				"wf-a": {ID: "wf-a", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, StartedAt: at(0), FinishedAt: at(10), Children: []string{"wf-b", "wf-c"}},
+				"wf-b": {ID: "wf-b", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, StartedAt: at(10), FinishedAt: at(15), Children: []string{"wf-d"}},
+				"wf-c": {ID: "wf-c", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, StartedAt: at(10), FinishedAt: at(30), Children: []string{"wf-d"}},
+				"wf-d": {ID: "wf-d", Type: wfv1.NodeTypePod, Phase: wfv1.NodeSucceeded, StartedAt: at(30), FinishedAt: at(40)},
+			},
+		},
+	}
+}
+
+// Test_buildWorkflowExecutionTimeline tests the stages of nodes and the critical path
+func Test_buildWorkflowExecutionTimeline(t *testing.T) {
+	start := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	wf := testTimelineWorkflow(start)
+
+	pods := map[string]*corev1.Pod{
+		"wf-a": {
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(start.Add(2 * time.Minute))},
+				},
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "main", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{StartedAt: metav1.NewTime(start.Add(4 * time.Minute))}}},
+				},
+			},
+		},
+	}
+	scheduledC := start.Add(15 * time.Minute)
+	startedC := start.Add(16 * time.Minute)
+	events := map[string][]*KubernetesEvent{
+		"wf-c": {
+			{Reason: "Scheduled", LastTimestamp: &scheduledC},
+			{Reason: "Started", Message: "Started container wait", FirstTimestamp: &scheduledC},
+			{Reason: "Started", Message: "Started container main", FirstTimestamp: &startedC},
+		},
+	}
+
+	timeline := buildWorkflowExecutionTimeline(wf, pods, events, nil, start.Add(time.Hour))
+
+	assert.Equal(t, "wf", timeline.UID)
+	assert.Len(t, timeline.Nodes, 5)
+	assert.Equal(t, []string{"wf-a", "wf-c", "wf-d"}, timeline.CriticalPath)
+	assert.Equal(t, float64(40*60), timeline.CriticalPathSeconds)
+
+	nodes := make(map[string]*WorkflowExecutionTimelineNode)
+	for _, node := range timeline.Nodes {
+		nodes[node.ID] = node
+	}
+
+	a := nodes["wf-a"]
+	assert.Equal(t, start.Add(2*time.Minute), *a.ScheduledAt)
+	assert.Equal(t, start.Add(4*time.Minute), *a.StartedAt)
+	assert.Equal(t, float64(4*60), a.WaitingSeconds)
+	assert.Equal(t, float64(6*60), a.RunningSeconds)
+	assert.True(t, a.CriticalPath)
+
+	c := nodes["wf-c"]
+	assert.Equal(t, scheduledC, *c.ScheduledAt)
+	assert.Equal(t, startedC, *c.StartedAt)
+	assert.Equal(t, float64(6*60), c.WaitingSeconds)
+	assert.Equal(t, float64(14*60), c.RunningSeconds)
+
+	// Without its pod or events, b is assumed to have run from when it was queued
+	b := nodes["wf-b"]
+	assert.Nil(t, b.StartedAt)
+	assert.Equal(t, float64(0), b.WaitingSeconds)
+	assert.Equal(t, float64(5*60), b.RunningSeconds)
+	assert.False(t, b.CriticalPath)
+
+	assert.Equal(t, float64(10*60), timeline.CriticalPathWaitingSeconds)
+	assert.Equal(t, float64(30*60), timeline.CriticalPathRunningSeconds)
+	assert.Equal(t, float64(0), nodes["wf"].WaitingSeconds)
+}
+
+// Test_buildWorkflowExecutionTimeline_Previous tests keeping the times of pods that no longer exist
+func Test_buildWorkflowExecutionTimeline_Previous(t *testing.T) {
+	start := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	wf := testTimelineWorkflow(start)
+
+	startedB := start.Add(12 * time.Minute)
+	previous := &WorkflowExecutionTimeline{
+		Nodes: []*WorkflowExecutionTimelineNode{{ID: "wf-b", StartedAt: &startedB}},
+	}
+
+	timeline := buildWorkflowExecutionTimeline(wf, nil, nil, previous, start.Add(time.Hour))
+	for _, node := range timeline.Nodes {
+		if node.ID == "wf-b" {
+			assert.Equal(t, startedB, *node.StartedAt)
+			assert.Equal(t, float64(2*60), node.WaitingSeconds)
+		}
+	}
+}
+
+// Test_buildWorkflowExecutionTimeline_Running tests measuring unfinished nodes up to now
+func Test_buildWorkflowExecutionTimeline_Running(t *testing.T) {
+	start := time.Date(2021, 4, 15, 10, 0, 0, 0, time.UTC)
+	wf := &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "wf"},
+		Status: wfv1.WorkflowStatus{
+			Phase:     wfv1.NodeRunning,
+			StartedAt: metav1.NewTime(start),
+			Nodes: wfv1.Nodes{
+				"wf-a": {ID: "wf-a", Type: wfv1.NodeTypePod, Phase: wfv1.NodePending, StartedAt: metav1.NewTime(start)},
+			},
+		},
+	}
+
+	timeline := buildWorkflowExecutionTimeline(wf, nil, nil, nil, start.Add(5*time.Minute))
+	assert.Equal(t, float64(5*60), timeline.Nodes[0].WaitingSeconds)
+	assert.Equal(t, float64(0), timeline.Nodes[0].RunningSeconds)
+	assert.Equal(t, []string{"wf-a"}, timeline.CriticalPath)
+	assert.Equal(t, float64(5*60), timeline.CriticalPathSeconds)
+}
@@ -1,8 +1,12 @@
 package v1
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/label"
 	"github.com/onepanelio/core/pkg/util/sql"
 	"github.com/onepanelio/core/pkg/util/types"
 	uid2 "github.com/onepanelio/core/pkg/util/uid"
@@ -26,7 +30,96 @@ type WorkflowExecution struct {
 	WorkflowTemplate *WorkflowTemplate `db:"workflow_template"`
 	Labels           types.JSONLabels
 	Metrics          Metrics
+	Retries          WorkflowExecutionRetries
+	Priority         int32
 	ArgoWorkflow     *wfv1.Workflow
+	Ownership
+}
+
+// WorkflowExecutionRetry records a retry of a workflow execution
+type WorkflowExecutionRetry struct {
+	// NodeID is the node the execution was retried from. Empty means only failed nodes were retried.
+	NodeID string `json:"nodeId,omitempty"`
+	// Phase is the phase of the execution before it was retried
+	Phase wfv1.NodePhase `json:"phase"`
+	// Parameters are the parameter overrides applied for the retry
+	Parameters []Parameter `json:"parameters,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt"`
+}
+
+// WorkflowExecutionRetries is the retry lineage of a workflow execution, oldest first
+type WorkflowExecutionRetries []*WorkflowExecutionRetry
+
+// Value returns j as a value.
+// This is to support WorkflowExecutionRetries working with JSONB column types in sql
+func (r WorkflowExecutionRetries) Value() (driver.Value, error) {
+	if r == nil {
+		return json.Marshal(make([]*WorkflowExecutionRetry, 0))
+	}
+
+	return json.Marshal(r)
+}
+
+// Scan stores the src in r.  No validation is done.
+// This is to support WorkflowExecutionRetries working with JSONB column types in sql
+func (r *WorkflowExecutionRetries) Scan(src interface{}) error {
+	var source []byte
+	switch t := src.(type) {
+	case string:
+		source = []byte(t)
+	case []byte:
+		if len(t) == 0 {
+			source = []byte("[]")
+		} else {
+			source = t
+		}
+	case nil:
+		*r = make([]*WorkflowExecutionRetry, 0)
+		return nil
+	default:
+		return errors.New("incompatible type for WorkflowExecutionRetries")
+	}
+
+	return json.Unmarshal(source, r)
+}
+
+// WorkflowExecutionApproval is a record of a user approving an approval gate of a workflow execution.
+// An approval gate is a suspend template annotated with onepanel.io/approval-required: "true"
+type WorkflowExecutionApproval struct {
+	ID                  uint64
+	WorkflowExecutionID uint64    `db:"workflow_execution_id"`
+	NodeID              string    `db:"node_id"`
+	ApprovedBy          string    `db:"approved_by"`
+	Comment             string    `db:"comment"`
+	CreatedAt           time.Time `db:"created_at"`
+}
+
+// IsApprovalGate returns true if node is a suspend node whose template requires approval to be resumed
+func IsApprovalGate(wf *wfv1.Workflow, node wfv1.NodeStatus) bool {
+	if node.Type != wfv1.NodeTypeSuspend {
+		return false
+	}
+
+	template := getNodeTemplate(wf, &node)
+	if template == nil {
+		return false
+	}
+
+	return template.Metadata.Annotations[label.ApprovalRequired] == "true"
+}
+
+// getNodeTemplate returns the template node ran. Templates of workflow templates the node references with a
+// templateRef are found in the stored templates of the workflow, keyed by their scope, resource and name.
+func getNodeTemplate(wf *wfv1.Workflow, node *wfv1.NodeStatus) *wfv1.Template {
+	scope, resourceName := node.GetTemplateScope()
+	if template := wf.GetStoredTemplate(scope, resourceName, node); template != nil {
+		return template
+	}
+	if node.TemplateRef != nil || node.TemplateName == "" {
+		return nil
+	}
+
+	return wf.GetTemplateByName(node.TemplateName)
 }
 
 // WorkflowExecutionOptions are options you have for an executing workflow
@@ -40,6 +133,9 @@ type WorkflowExecutionOptions struct {
 	ListOptions         *ListOptions
 	PodGCStrategy       *PodGCStrategy
 	WorkflowTemplateUID string
+	Queued              bool // if true, the workflow is created suspended and in the Queued phase
+	Priority            int32
+	Ownership           Ownership
 }
 
 // WorkflowExecutionStatistic is a record keeping track of what happened to a workflow execution
@@ -93,6 +189,27 @@ func (we *WorkflowExecution) LoadParametersFromBytes() ([]Parameter, error) {
 	return we.Parameters, err
 }
 
+// OverrideParameters sets the values of parameters to the values in overrides, matched by name.
+// An error is returned if an override does not match any parameter.
+func (we *WorkflowExecution) OverrideParameters(overrides []Parameter) error {
+	for _, override := range overrides {
+		found := false
+		for i := range we.Parameters {
+			if we.Parameters[i].Name == override.Name {
+				we.Parameters[i].Value = override.Value
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("parameter '%v' does not exist", override.Name)
+		}
+	}
+
+	return nil
+}
+
 // GetParameterValue returns the value of the parameter with the given name, or nil if there is no such parameter
 func (we *WorkflowExecution) GetParameterValue(name string) *string {
 	for _, p := range we.Parameters {
@@ -118,7 +235,10 @@ func getWorkflowExecutionColumns(aliasAndDestination ...string) []string {
 		"finished_at",
 		"labels",
 		"metrics",
+		"retries",
+		"priority",
 	}
+	columns = append(columns, ownershipColumns...)
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
@@ -133,6 +253,7 @@ func getWorkflowExecutionColumnsMap(camelCase bool) map[string]string {
 		"phase":      "phase",
 		"labels":     "labels",
 		"metrics":    "metrics",
+		"priority":   "priority",
 	}
 
 	if camelCase {
@@ -147,3 +268,31 @@ func getWorkflowExecutionColumnsMap(camelCase bool) map[string]string {
 
 	return result
 }
+
+// WorkflowExecutionNodeDiagnostics explains the state of a pending, running or failed node of a workflow execution
+type WorkflowExecutionNodeDiagnostics struct {
+	ID           string
+	DisplayName  string
+	TemplateName string
+	Phase        string
+	Message      string
+	PodPhase     string
+	Conditions   []*PodCondition
+	Events       []*KubernetesEvent
+	// ExitCode, OOMKilled and Logs are only set for failed nodes
+	ExitCode  *int32
+	OOMKilled bool
+	Logs      []*LogEntry
+	Findings  []*DiagnosticFinding
+}
+
+// WorkflowExecutionDiagnostics explains the state of the nodes of a workflow execution that are not done, or failed
+type WorkflowExecutionDiagnostics struct {
+	UID     string
+	Phase   string
+	Message string
+	// NodePool is the value of the sys-node-pool parameter, if the execution has one
+	NodePool string
+	Nodes    []*WorkflowExecutionNodeDiagnostics
+	Findings []*DiagnosticFinding
+}
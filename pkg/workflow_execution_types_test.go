@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
+	"github.com/onepanelio/core/pkg/util/label"
+	"github.com/onepanelio/core/pkg/util/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWorkflowExecution_OverrideParameters tests overriding parameter values by name
+func TestWorkflowExecution_OverrideParameters(t *testing.T) {
+	we := &WorkflowExecution{
+		Parameters: []Parameter{
+			{Name: "batch-size", Value: ptr.String("64")},
+			{Name: "sys-node-pool", Value: ptr.String("Standard_D2s_v3")},
+		},
+	}
+
+	err := we.OverrideParameters([]Parameter{
+		{Name: "batch-size", Value: ptr.String("16")},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "16", *we.GetParameterValue("batch-size"))
+	assert.Equal(t, "Standard_D2s_v3", *we.GetParameterValue("sys-node-pool"))
+
+	// Unknown parameters are rejected
+	err = we.OverrideParameters([]Parameter{
+		{Name: "epochs", Value: ptr.String("10")},
+	})
+	assert.NotNil(t, err)
+}
+
+// TestWorkflowExecutionRetries_Scan tests the retry lineage round trips through its database representation
+func TestWorkflowExecutionRetries_Scan(t *testing.T) {
+	retries := WorkflowExecutionRetries{
+		{
+			NodeID:     "train-abc-123",
+			Phase:      wfv1.NodeFailed,
+			Parameters: []Parameter{{Name: "batch-size", Value: ptr.String("16")}},
+			CreatedAt:  time.Date(2021, 4, 12, 9, 31, 12, 0, time.UTC),
+		},
+	}
+
+	value, err := retries.Value()
+	assert.Nil(t, err)
+
+	result := WorkflowExecutionRetries{}
+	assert.Nil(t, result.Scan(value))
+	assert.Equal(t, retries, result)
+
+	// Null column gives an empty lineage
+	assert.Nil(t, result.Scan(nil))
+	assert.Len(t, result, 0)
+}
+
+// TestIsApprovalGate tests that only suspend nodes with an annotated template are approval gates
+func TestIsApprovalGate(t *testing.T) {
+	wf := &wfv1.Workflow{
+		Spec: wfv1.WorkflowSpec{
+			Templates: []wfv1.Template{
+				{
+					Name:    "approve-export",
+					Suspend: &wfv1.SuspendTemplate{},
+					Metadata: wfv1.Metadata{
+						Annotations: map[string]string{
+							label.ApprovalRequired: "true",
+						},
+					},
+				},
+				{
+					Name:    "wait",
+					Suspend: &wfv1.SuspendTemplate{},
+				},
+			},
+		},
+	}
+
+	assert.True(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:         wfv1.NodeTypeSuspend,
+		TemplateName: "approve-export",
+	}))
+	assert.False(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:         wfv1.NodeTypeSuspend,
+		TemplateName: "wait",
+	}))
+	assert.False(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:         wfv1.NodeTypeSuspend,
+		TemplateName: "missing",
+	}))
+	assert.False(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:         wfv1.NodeTypePod,
+		TemplateName: "approve-export",
+	}))
+}
+
+// TestIsApprovalGate_TemplateRef tests approval gates of workflow templates referenced with a templateRef
+func TestIsApprovalGate_TemplateRef(t *testing.T) {
+	wf := &wfv1.Workflow{
+		Status: wfv1.WorkflowStatus{
+			StoredTemplates: map[string]wfv1.Template{
+				"namespaced/release/approve": {
+					Name:    "approve",
+					Suspend: &wfv1.SuspendTemplate{},
+					Metadata: wfv1.Metadata{
+						Annotations: map[string]string{
+							label.ApprovalRequired: "true",
+						},
+					},
+				},
+				"namespaced/other/approve": {
+					Name:    "approve",
+					Suspend: &wfv1.SuspendTemplate{},
+				},
+			},
+		},
+	}
+
+	assert.True(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:        wfv1.NodeTypeSuspend,
+		TemplateRef: &wfv1.TemplateRef{Name: "release", Template: "approve"},
+	}))
+	assert.False(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:        wfv1.NodeTypeSuspend,
+		TemplateRef: &wfv1.TemplateRef{Name: "other", Template: "approve"},
+	}))
+	assert.False(t, IsApprovalGate(wf, wfv1.NodeStatus{
+		Type:        wfv1.NodeTypeSuspend,
+		TemplateRef: &wfv1.TemplateRef{Name: "missing", Template: "approve"},
+	}))
+}
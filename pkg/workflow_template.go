@@ -224,11 +224,12 @@ func (c *Client) createWorkflowTemplate(namespace string, workflowTemplate *Work
 
 	err = sb.Insert("workflow_templates").
 		SetMap(sq.Eq{
-			"uid":       workflowTemplate.UID,
-			"name":      workflowTemplate.Name,
-			"namespace": namespace,
-			"is_system": workflowTemplate.IsSystem,
-			"labels":    workflowTemplate.Labels,
+			"uid":         workflowTemplate.UID,
+			"name":        workflowTemplate.Name,
+			"namespace":   namespace,
+			"is_system":   workflowTemplate.IsSystem,
+			"labels":      workflowTemplate.Labels,
+			"concurrency": workflowTemplate.Concurrency,
 		}).
 		Suffix("RETURNING id").
 		RunWith(tx).
@@ -602,6 +603,10 @@ func (c *Client) CountWorkflowTemplates(namespace string, request *request.Reque
 }
 
 func (c *Client) validateWorkflowTemplate(namespace string, workflowTemplate *WorkflowTemplate) (err error) {
+	if err = workflowTemplate.Concurrency.Validate(); err != nil {
+		return
+	}
+
 	// validate workflow template
 	finalBytes, err := workflowTemplate.WrapSpec()
 	if err != nil {
@@ -725,9 +730,10 @@ func (c *Client) CreateWorkflowTemplateVersion(namespace string, workflowTemplat
 		return nil, err
 	}
 
-	// Make sure the associated workflow template has the latest labels
+	// Make sure the associated workflow template has the latest labels and concurrency settings
 	_, err = sb.Update("workflow_templates").
 		Set("labels", workflowTemplate.Labels).
+		Set("concurrency", workflowTemplate.Concurrency).
 		Where(sq.Eq{
 			"id": workflowTemplateDB.ID,
 		}).
@@ -1,7 +1,10 @@
 package v1
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"time"
 
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -41,6 +44,103 @@ type WorkflowTemplate struct {
 	ResourceUID                      *string // see Resource field
 	Parameters                       []Parameter
 	Description                      string `db:"description"`
+	Concurrency                      *WorkflowTemplateConcurrency
+}
+
+// Concurrency scopes determine which running executions count towards a WorkflowTemplateConcurrency limit
+const (
+	// ConcurrencyScopeTemplate counts the running executions of the workflow template
+	ConcurrencyScopeTemplate = "template"
+	// ConcurrencyScopeNamespace counts the running executions of all non-system workflow templates in the namespace
+	ConcurrencyScopeNamespace = "namespace"
+	// ConcurrencyScopeLabel counts the running executions of the workflow template with the same value for LabelKey
+	ConcurrencyScopeLabel = "label"
+)
+
+// Concurrency orders determine which queued execution is started first
+const (
+	ConcurrencyOrderFIFO     = "fifo"
+	ConcurrencyOrderPriority = "priority"
+)
+
+// WorkflowTemplateConcurrency limits how many executions of a workflow template can run at the same time.
+// Executions over the limit are queued and started as running executions finish.
+type WorkflowTemplateConcurrency struct {
+	Limit    int32  `json:"limit"`
+	Scope    string `json:"scope,omitempty"`
+	LabelKey string `json:"labelKey,omitempty"`
+	Order    string `json:"order,omitempty"`
+}
+
+// IsEnabled returns true if there is a concurrency limit
+func (wtc *WorkflowTemplateConcurrency) IsEnabled() bool {
+	return wtc != nil && wtc.Limit > 0
+}
+
+// Validate returns an error if the concurrency settings are invalid
+func (wtc *WorkflowTemplateConcurrency) Validate() error {
+	if wtc == nil {
+		return nil
+	}
+
+	if wtc.Limit < 0 {
+		return fmt.Errorf("concurrency limit can not be negative")
+	}
+
+	switch wtc.Scope {
+	case "", ConcurrencyScopeTemplate, ConcurrencyScopeNamespace:
+	case ConcurrencyScopeLabel:
+		if wtc.LabelKey == "" {
+			return fmt.Errorf("concurrency labelKey is required for the label scope")
+		}
+	default:
+		return fmt.Errorf("invalid concurrency scope '%v'", wtc.Scope)
+	}
+
+	switch wtc.Order {
+	case "", ConcurrencyOrderFIFO, ConcurrencyOrderPriority:
+	default:
+		return fmt.Errorf("invalid concurrency order '%v'", wtc.Order)
+	}
+
+	return nil
+}
+
+// GetScope returns the scope, defaulting to ConcurrencyScopeTemplate
+func (wtc *WorkflowTemplateConcurrency) GetScope() string {
+	if wtc.Scope == "" {
+		return ConcurrencyScopeTemplate
+	}
+
+	return wtc.Scope
+}
+
+// Value returns wtc as a value.
+// This is to support WorkflowTemplateConcurrency working with JSONB column types in sql
+func (wtc *WorkflowTemplateConcurrency) Value() (driver.Value, error) {
+	if wtc == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(wtc)
+}
+
+// Scan stores the src in wtc.  No validation is done.
+// This is to support WorkflowTemplateConcurrency working with JSONB column types in sql
+func (wtc *WorkflowTemplateConcurrency) Scan(src interface{}) error {
+	switch t := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(t), wtc)
+	case []byte:
+		if len(t) == 0 {
+			return nil
+		}
+		return json.Unmarshal(t, wtc)
+	case nil:
+		return nil
+	}
+
+	return errors.New("incompatible type for WorkflowTemplateConcurrency")
 }
 
 // GenerateUID generates a uid from the input name and sets it on the workflow template
@@ -277,6 +377,6 @@ func (wt *WorkflowTemplate) AddWorkflowTemplateParametersFromAnnotations(spec ma
 // getWorkflowTemplateColumns returns all of the columns for workflowTemplate modified by alias, destination.
 // see formatColumnSelect
 func getWorkflowTemplateColumns(aliasAndDestination ...string) []string {
-	columns := []string{"id", "created_at", "uid", "name", "namespace", "modified_at", "is_archived", "labels"}
+	columns := []string{"id", "created_at", "uid", "name", "namespace", "modified_at", "is_archived", "labels", "concurrency"}
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
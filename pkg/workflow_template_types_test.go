@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestWorkflowTemplateConcurrency_Validate tests validating concurrency settings
+func TestWorkflowTemplateConcurrency_Validate(t *testing.T) {
+	var concurrency *WorkflowTemplateConcurrency
+	assert.Nil(t, concurrency.Validate())
+	assert.False(t, concurrency.IsEnabled())
+
+	concurrency = &WorkflowTemplateConcurrency{Limit: 2}
+	assert.Nil(t, concurrency.Validate())
+	assert.True(t, concurrency.IsEnabled())
+	assert.Equal(t, ConcurrencyScopeTemplate, concurrency.GetScope())
+
+	concurrency = &WorkflowTemplateConcurrency{Limit: -1}
+	assert.NotNil(t, concurrency.Validate())
+
+	concurrency = &WorkflowTemplateConcurrency{Limit: 2, Scope: ConcurrencyScopeLabel}
+	assert.NotNil(t, concurrency.Validate())
+
+	concurrency = &WorkflowTemplateConcurrency{Limit: 2, Scope: ConcurrencyScopeLabel, LabelKey: "dataset"}
+	assert.Nil(t, concurrency.Validate())
+
+	concurrency = &WorkflowTemplateConcurrency{Limit: 2, Scope: "cluster"}
+	assert.NotNil(t, concurrency.Validate())
+
+	concurrency = &WorkflowTemplateConcurrency{Limit: 2, Order: "lifo"}
+	assert.NotNil(t, concurrency.Validate())
+}
+
+// TestWorkflowTemplateConcurrency_Scan tests the concurrency settings round trip through their database representation
+func TestWorkflowTemplateConcurrency_Scan(t *testing.T) {
+	concurrency := &WorkflowTemplateConcurrency{
+		Limit: 5,
+		Scope: ConcurrencyScopeNamespace,
+		Order: ConcurrencyOrderPriority,
+	}
+
+	value, err := concurrency.Value()
+	assert.Nil(t, err)
+
+	result := &WorkflowTemplateConcurrency{}
+	assert.Nil(t, result.Scan(value))
+	assert.Equal(t, concurrency, result)
+
+	var empty *WorkflowTemplateConcurrency
+	value, err = empty.Value()
+	assert.Nil(t, err)
+	assert.Nil(t, value)
+}
@@ -1,13 +1,10 @@
 package v1
 
 import (
-	"bufio"
 	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	sq "github.com/Masterminds/squirrel"
 	wfv1 "github.com/argoproj/argo/pkg/apis/workflow/v1alpha1"
@@ -27,8 +24,9 @@ import (
 
 // WorkspaceFilter represents the available ways we can filter Workspaces
 type WorkspaceFilter struct {
-	Labels []*Label
-	Phase  string // empty string means none
+	Labels    []*Label
+	Phase     string           // empty string means none
+	Ownership *OwnershipFilter // nil means all workspaces, regardless of their visibility
 }
 
 // GetLabels gets the labels of the filter
@@ -46,6 +44,10 @@ func applyWorkspaceFilter(sb sq.SelectBuilder, request *request.Request) (sq.Sel
 		return sb, nil
 	}
 
+	if filter.Ownership != nil {
+		sb = filter.Ownership.apply(sb, "w")
+	}
+
 	if filter.Phase != "" {
 		sb = sb.Where(sq.Eq{
 			"phase": filter.Phase,
@@ -259,6 +261,7 @@ func (c *Client) createWorkspace(namespace string, parameters []byte, workspace
 
 	_, err = c.CreateWorkflowExecution(namespace, &WorkflowExecution{
 		Parameters: workspace.Parameters,
+		Ownership:  workspace.Ownership,
 	}, workflowTemplate)
 	if err != nil {
 		return nil, err
@@ -275,6 +278,10 @@ func (c *Client) createWorkspace(namespace string, parameters []byte, workspace
 			"workspace_template_id":      workspace.WorkspaceTemplate.ID,
 			"workspace_template_version": workspace.WorkspaceTemplate.Version,
 			"labels":                     workspace.Labels,
+			"owner":                      workspace.Owner,
+			"visibility":                 workspace.Visibility,
+			"shared_users":               workspace.SharedUsers,
+			"shared_groups":              workspace.SharedGroups,
 		}).
 		Suffix("RETURNING id, created_at").
 		RunWith(c.DB).
@@ -462,6 +469,7 @@ func (c *Client) startWorkspace(namespace string, parameters []byte, workspace *
 
 	_, err = c.CreateWorkflowExecution(namespace, &WorkflowExecution{
 		Parameters: workspace.Parameters,
+		Ownership:  workspace.Ownership,
 	}, workflowTemplate)
 	if err != nil {
 		return nil, err
@@ -491,6 +499,9 @@ func (c *Client) CreateWorkspace(namespace string, workspace *Workspace) (*Works
 	if err := workspace.GenerateUID(workspace.Name); err != nil {
 		return nil, err
 	}
+	if err := workspace.Ownership.Validate(); err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
 
 	existingWorkspace, err := c.GetWorkspace(namespace, workspace.UID)
 	if err != nil {
@@ -831,6 +842,7 @@ func (c *Client) updateWorkspace(namespace, uid, workspaceAction, resourceAction
 
 	_, err = c.CreateWorkflowExecution(namespace, &WorkflowExecution{
 		Parameters: workspace.Parameters,
+		Ownership:  workspace.Ownership,
 	}, workspaceTemplate.WorkflowTemplate)
 	if err != nil {
 		return
@@ -906,89 +918,6 @@ func (c *Client) GetWorkspaceStatisticsForNamespace(namespace string) (report *W
 	return
 }
 
-// GetWorkspaceContainerLogs returns logs for a given container name in a Workspace
-func (c *Client) GetWorkspaceContainerLogs(namespace, uid, containerName string, sinceTime time.Time) (<-chan []*LogEntry, error) {
-	var stream io.ReadCloser
-
-	k8sSinceTime := metav1.NewTime(sinceTime)
-
-	stream, err := c.CoreV1().Pods(namespace).GetLogs(uid+"-0", &corev1.PodLogOptions{
-		Container:  containerName,
-		Follow:     true,
-		Timestamps: true,
-		SinceTime:  &k8sSinceTime,
-	}).Stream()
-
-	if err != nil {
-		log.WithFields(log.Fields{
-			"Namespace":     namespace,
-			"UID":           uid,
-			"ContainerName": containerName,
-			"Error":         err.Error(),
-		}).Error("Error with logs.")
-		return nil, util.NewUserError(codes.NotFound, "Log not found.")
-	}
-
-	logWatcher := make(chan []*LogEntry)
-	go func() {
-		buffer := make([]byte, 4096)
-		reader := bufio.NewReader(stream)
-
-		lastChunkSent := -1
-		lastLine := ""
-		for {
-			bytesRead, err := reader.Read(buffer)
-			if err != nil && err.Error() != "EOF" {
-				break
-			}
-			content := lastLine + string(buffer[:bytesRead])
-			lastLine = ""
-
-			chunk := make([]*LogEntry, 0)
-			lines := strings.Split(content, "\n")
-			for lineIndex, line := range lines {
-				if lineIndex == len(lines)-1 {
-					lastLine = line
-					continue
-				}
-
-				newLogEntry := LogEntryFromLine(&line)
-				if newLogEntry == nil {
-					continue
-				}
-
-				chunk = append(chunk, newLogEntry)
-			}
-
-			if lastChunkSent == 0 && lastLine != "" {
-				newLogEntry := LogEntryFromLine(&lastLine)
-				if newLogEntry != nil {
-					chunk = append(chunk, newLogEntry)
-					lastLine = ""
-				}
-			}
-
-			if len(chunk) > 0 {
-				logWatcher <- chunk
-			}
-			lastChunkSent = len(chunk)
-
-			if err != nil && err.Error() == "EOF" {
-				break
-			}
-		}
-
-		newLogEntry := LogEntryFromLine(&lastLine)
-		if newLogEntry != nil {
-			logWatcher <- []*LogEntry{newLogEntry}
-		}
-
-		close(logWatcher)
-	}()
-
-	return logWatcher, err
-}
-
 // ListWorkspacesField loads all of the distinct field values for workspaces
 func (c *Client) ListWorkspacesField(namespace, field string) (value []string, err error) {
 	columnName := ""
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// getLatestWorkspaceWorkflowExecutionUID returns the uid of the most recent workflow execution
+// that performed an action on the workspace, or an empty string if there is none
+func (c *Client) getLatestWorkspaceWorkflowExecutionUID(namespace, uid string) (string, error) {
+	parameters, err := json.Marshal([]Parameter{{Name: "sys-uid", Value: &uid}})
+	if err != nil {
+		return "", err
+	}
+
+	sb := workflowExecutionsSelectBuilderNoColumns(namespace, "", "", true).
+		Columns("we.uid").
+		Where("we.parameters @> ?::jsonb", string(parameters)).
+		OrderBy("we.created_at DESC", "we.id DESC").
+		Limit(1)
+
+	uids := make([]string, 0)
+	if err := c.DB.Selectx(&uids, sb); err != nil {
+		return "", err
+	}
+
+	if len(uids) == 0 {
+		return "", nil
+	}
+
+	return uids[0], nil
+}
+
+// GetWorkspaceDiagnostics collects the events of the kubernetes objects created for a workspace, the status of its pod
+// and of the workflow that performed its latest action, and explains known problems with them as findings
+func (c *Client) GetWorkspaceDiagnostics(namespace, uid string) (*WorkspaceDiagnostics, error) {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+	if workspace == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace not found.")
+	}
+
+	diagnostics := &WorkspaceDiagnostics{
+		Phase:      workspace.Status.Phase,
+		Conditions: make([]*PodCondition, 0),
+		Containers: make([]*WorkspaceContainer, 0),
+		Findings:   make([]*DiagnosticFinding, 0),
+	}
+
+	objects := []diagnosticObject{
+		{Kind: "StatefulSet", Name: uid},
+		{Kind: "Service", Name: uid},
+		{Kind: "VirtualService", Name: uid},
+	}
+
+	// A workspace that is paused or still launching may not have a pod
+	pod, err := c.getWorkspacePod(namespace, uid)
+	if err == nil {
+		diagnostics.PodName = pod.Name
+		diagnostics.PodPhase = string(pod.Status.Phase)
+		diagnostics.Conditions = podConditions(pod)
+		diagnostics.Containers = getWorkspaceContainers(pod)
+		diagnostics.Findings = append(diagnostics.Findings, findingsFromPod(pod)...)
+
+		objects = append(objects, diagnosticObject{Kind: "Pod", Name: pod.Name})
+	}
+
+	pvcs, err := c.CoreV1().PersistentVolumeClaims(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%v", uid),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to list workspace persistent volume claims.")
+		pvcs = &corev1.PersistentVolumeClaimList{}
+	}
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		diagnostics.Findings = append(diagnostics.Findings, findingsFromPersistentVolumeClaim(pvc)...)
+
+		objects = append(objects, diagnosticObject{Kind: "PersistentVolumeClaim", Name: pvc.Name})
+	}
+
+	diagnostics.Events = c.listObjectEvents(namespace, objects)
+	diagnostics.Findings = append(diagnostics.Findings, findingsFromEvents(diagnostics.Events)...)
+
+	workflowUID, err := c.getLatestWorkspaceWorkflowExecutionUID(namespace, uid)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to get workspace workflow execution.")
+	}
+	if workflowUID != "" {
+		wf, err := c.getArgoWorkflow(namespace, workflowUID)
+		if err != nil {
+			log.WithFields(log.Fields{
+				"Namespace":   namespace,
+				"UID":         uid,
+				"WorkflowUID": workflowUID,
+				"Error":       err.Error(),
+			}).Error("Unable to get workspace workflow.")
+		} else {
+			diagnostics.Workflow = summarizeWorkflowStatus(wf)
+			diagnostics.Findings = append(diagnostics.Findings, findingsFromWorkflowStatus(diagnostics.Workflow)...)
+		}
+	}
+
+	diagnostics.Findings = uniqueFindings(diagnostics.Findings)
+
+	return diagnostics, nil
+}
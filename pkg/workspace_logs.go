@@ -0,0 +1,229 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/onepanelio/core/pkg/util"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workspaceLogsFlushInterval is how often entries of merged workspace log streams are sent while following
+const workspaceLogsFlushInterval = 500 * time.Millisecond
+
+// getWorkspacePod returns the pod of a workspace. If the workspace has more than one pod, like while it is being
+// restarted, the running pod that is not being deleted is preferred, and then the most recently created one.
+func (c *Client) getWorkspacePod(namespace, uid string) (*corev1.Pod, error) {
+	pods, err := c.CoreV1().Pods(namespace).List(metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("onepanel.io/entity-type=Workspace,onepanel.io/entity-uid=%v", uid),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace": namespace,
+			"UID":       uid,
+			"Error":     err.Error(),
+		}).Error("Unable to list workspace pods.")
+		return nil, util.NewUserError(codes.Unknown, "Unable to get workspace pod.")
+	}
+
+	if len(pods.Items) == 0 {
+		return nil, util.NewUserError(codes.NotFound, "Workspace pod not found.")
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool {
+		a, b := &pods.Items[i], &pods.Items[j]
+		if (a.DeletionTimestamp == nil) != (b.DeletionTimestamp == nil) {
+			return a.DeletionTimestamp == nil
+		}
+		if (a.Status.Phase == corev1.PodRunning) != (b.Status.Phase == corev1.PodRunning) {
+			return a.Status.Phase == corev1.PodRunning
+		}
+		return b.CreationTimestamp.Before(&a.CreationTimestamp)
+	})
+
+	return &pods.Items[0], nil
+}
+
+// ListWorkspaceContainers returns the init containers and containers of a workspace's pod, with their status
+func (c *Client) ListWorkspaceContainers(namespace, uid string) (podName string, containers []*WorkspaceContainer, err error) {
+	pod, err := c.getWorkspacePod(namespace, uid)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return pod.Name, getWorkspaceContainers(pod), nil
+}
+
+// getWorkspaceContainers returns the init containers and containers of pod, in the order they are in the spec
+func getWorkspaceContainers(pod *corev1.Pod) []*WorkspaceContainer {
+	statuses := make(map[string]corev1.ContainerStatus)
+	for _, status := range pod.Status.InitContainerStatuses {
+		statuses[status.Name] = status
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		statuses[status.Name] = status
+	}
+
+	containers := make([]*WorkspaceContainer, 0)
+	add := func(container corev1.Container, init bool) {
+		workspaceContainer := &WorkspaceContainer{
+			Name:  container.Name,
+			Image: container.Image,
+			Init:  init,
+		}
+
+		if status, ok := statuses[container.Name]; ok {
+			workspaceContainer.Ready = status.Ready
+			workspaceContainer.RestartCount = status.RestartCount
+
+			switch {
+			case status.State.Running != nil:
+				workspaceContainer.State = "Running"
+			case status.State.Waiting != nil:
+				workspaceContainer.State = "Waiting"
+				workspaceContainer.Reason = status.State.Waiting.Reason
+			case status.State.Terminated != nil:
+				workspaceContainer.State = "Terminated"
+				workspaceContainer.Reason = status.State.Terminated.Reason
+			}
+		}
+
+		containers = append(containers, workspaceContainer)
+	}
+
+	for _, container := range pod.Spec.InitContainers {
+		add(container, true)
+	}
+	for _, container := range pod.Spec.Containers {
+		add(container, false)
+	}
+
+	return containers
+}
+
+// openWorkspaceContainerLogs opens the logs of a container of the workspace pod
+func (c *Client) openWorkspaceContainerLogs(namespace, uid, podName, containerName string, opts *WorkspaceLogOptions) (io.ReadCloser, error) {
+	podLogOptions := &corev1.PodLogOptions{
+		Container:  containerName,
+		Follow:     opts.Follow && !opts.Previous,
+		Previous:   opts.Previous,
+		Timestamps: true,
+	}
+	if !opts.SinceTime.IsZero() {
+		sinceTime := metav1.NewTime(opts.SinceTime)
+		podLogOptions.SinceTime = &sinceTime
+	}
+	if opts.TailLines > 0 {
+		podLogOptions.TailLines = &opts.TailLines
+	}
+
+	stream, err := c.CoreV1().Pods(namespace).GetLogs(podName, podLogOptions).Stream()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Namespace":     namespace,
+			"UID":           uid,
+			"PodName":       podName,
+			"ContainerName": containerName,
+			"Error":         err.Error(),
+		}).Error("Error with logs.")
+		return nil, util.NewUserError(codes.NotFound, "Log not found.")
+	}
+
+	return stream, nil
+}
+
+// GetWorkspaceContainerLogs returns logs for a given container name in a Workspace
+func (c *Client) GetWorkspaceContainerLogs(ctx context.Context, namespace, uid, containerName string, opts *WorkspaceLogOptions) (<-chan []*LogEntry, error) {
+	pod, err := c.getWorkspacePod(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := c.openWorkspaceContainerLogs(namespace, uid, pod.Name, containerName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return streamLogEntries(ctx, stream, containerName, c.getWorkspaceLogParsers(namespace, uid)), nil
+}
+
+// GetWorkspaceLogs returns the logs of several containers of a workspace merged into a single stream ordered by
+// timestamp. If containerNames is empty, the logs of all of the containers, excluding init containers, are returned.
+// When following, entries are ordered within each batch that is sent.
+func (c *Client) GetWorkspaceLogs(ctx context.Context, namespace, uid string, containerNames []string, opts *WorkspaceLogOptions) (<-chan []*LogEntry, error) {
+	pod, err := c.getWorkspacePod(namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	containers := getWorkspaceContainers(pod)
+	if len(containerNames) == 0 {
+		for _, container := range containers {
+			if !container.Init {
+				containerNames = append(containerNames, container.Name)
+			}
+		}
+	}
+
+	for _, containerName := range containerNames {
+		found := false
+		for _, container := range containers {
+			if container.Name == containerName {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, util.NewUserError(codes.NotFound, fmt.Sprintf("Container '%v' not found.", containerName))
+		}
+	}
+
+	streams := make([]io.ReadCloser, 0, len(containerNames))
+	for _, containerName := range containerNames {
+		stream, err := c.openWorkspaceContainerLogs(namespace, uid, pod.Name, containerName, opts)
+		if err != nil {
+			for _, opened := range streams {
+				opened.Close()
+			}
+			return nil, err
+		}
+
+		streams = append(streams, stream)
+	}
+
+	parsers := c.getWorkspaceLogParsers(namespace, uid)
+	watchers := make([]<-chan []*LogEntry, len(streams))
+	for i, stream := range streams {
+		watchers[i] = streamLogEntries(ctx, stream, containerNames[i], parsers)
+	}
+
+	flushInterval := time.Duration(0)
+	if opts.Follow && !opts.Previous {
+		flushInterval = workspaceLogsFlushInterval
+	}
+
+	return mergeLogEntries(ctx, watchers, flushInterval), nil
+}
+
+// getWorkspaceLogParsers returns the log parsers for the containers of a workspace,
+// using the log format of its workspace template if it has one
+func (c *Client) getWorkspaceLogParsers(namespace, uid string) []LogParser {
+	workspace, err := c.GetWorkspace(namespace, uid)
+	if err != nil || workspace == nil || workspace.WorkspaceTemplate == nil {
+		return DefaultLogParsers
+	}
+
+	spec, err := parseWorkspaceSpec(workspace.WorkspaceTemplate.Manifest)
+	if err != nil {
+		return DefaultLogParsers
+	}
+
+	return GetLogParsers(spec.LogFormat)
+}
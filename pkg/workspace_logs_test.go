@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Test_getWorkspaceContainers tests listing the containers of a workspace pod with their status
+func Test_getWorkspaceContainers(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", Image: "busybox"}},
+			Containers: []corev1.Container{
+				{Name: "cvat", Image: "onepanel/cvat"},
+				{Name: "cvat-ui", Image: "onepanel/cvat-ui"},
+			},
+		},
+		Status: corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "init", State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "Completed"}}},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "cvat", RestartCount: 4, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+				{Name: "cvat-ui", Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		},
+	}
+
+	containers := getWorkspaceContainers(pod)
+	assert.Len(t, containers, 3)
+
+	assert.Equal(t, &WorkspaceContainer{Name: "init", Image: "busybox", Init: true, State: "Terminated", Reason: "Completed"}, containers[0])
+	assert.Equal(t, &WorkspaceContainer{Name: "cvat", Image: "onepanel/cvat", RestartCount: 4, State: "Waiting", Reason: "CrashLoopBackOff"}, containers[1])
+	assert.Equal(t, &WorkspaceContainer{Name: "cvat-ui", Image: "onepanel/cvat-ui", Ready: true, State: "Running"}, containers[2])
+}
@@ -945,6 +945,12 @@ func (c *Client) generateWorkspaceTemplateWorkflowTemplate(workspaceTemplate *Wo
 		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
 	}
 
+	if workspaceSpec.LogFormat != "" {
+		if _, err := NewRegexLogParser(workspaceSpec.LogFormat); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	if workspaceSpec.Arguments != nil {
 		modifiedParameters, err := c.replaceSysNodePoolOptions(workspaceSpec.Arguments.Parameters)
 		if err != nil {
@@ -91,6 +91,31 @@ func (wt *WorkspaceTemplate) InjectRuntimeParameters(config SystemConfig) error
 	return nil
 }
 
+// UpdateParameters replaces the parameters in the workflow template manifest with the result of update
+func (wt *WorkspaceTemplate) UpdateParameters(update func(parameters []Parameter) []Parameter) error {
+	if wt.WorkflowTemplate == nil {
+		return fmt.Errorf("workflow Template is nil for workspace template")
+	}
+
+	manifest := struct {
+		Arguments Arguments `json:"arguments"`
+		wfv1.WorkflowSpec
+	}{}
+	if err := yaml.Unmarshal([]byte(wt.WorkflowTemplate.Manifest), &manifest); err != nil {
+		return err
+	}
+
+	manifest.Arguments.Parameters = update(manifest.Arguments.Parameters)
+
+	resultManifest, err := yaml.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	wt.WorkflowTemplate.Manifest = string(resultManifest)
+
+	return nil
+}
+
 // GetServices returns an array of WorkspaceServices
 func (wt *WorkspaceTemplate) GetServices() ([]*WorkspaceService, error) {
 	result := make([]*WorkspaceService, 0)
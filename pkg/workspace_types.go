@@ -52,6 +52,7 @@ type Workspace struct {
 	WorkspaceTemplateID      uint64                   `db:"workspace_template_id"`
 	WorkspaceTemplateVersion uint64                   `db:"workspace_template_version"`
 	WorkflowTemplateVersion  *WorkflowTemplateVersion `db:"workflow_template_version"` // helper to store data from workflow template version
+	Ownership
 }
 
 type WorkspaceSpec struct {
@@ -62,6 +63,8 @@ type WorkspaceSpec struct {
 	Routes                []*networking.HTTPRoute        `json:"routes" protobuf:"bytes,5,opt,name=routes"`
 	VolumeClaimTemplates  []corev1.PersistentVolumeClaim `json:"volumeClaimTemplates" protobuf:"bytes,6,opt,name=volumeClaimTemplates"`
 	PostExecutionWorkflow *wfv1.WorkflowTemplateSpec     `json:"postExecutionWorkflow" protobuf:"bytes,7,opt,name=postExecutionWorkflow"`
+	// LogFormat is a regular expression with named groups used to parse the logs of the workspace's containers
+	LogFormat string `json:"logFormat,omitempty"`
 }
 
 // GetURL returns a url that can be used to access the workspace in a browser.
@@ -103,6 +106,7 @@ func GenerateWorkspaceUID(name string) (string, error) {
 // see formatColumnSelect
 func getWorkspaceColumns(aliasAndDestination ...string) []string {
 	columns := []string{"id", "created_at", "modified_at", "uid", "name", "namespace", "parameters", "workspace_template_id", "workspace_template_version", "labels"}
+	columns = append(columns, ownershipColumns...)
 	return sql.FormatColumnSelect(columns, aliasAndDestination...)
 }
 
@@ -152,3 +156,40 @@ func getWorkspaceColumnsMap(camelCase bool) map[string]string {
 
 	return result
 }
+
+// WorkspaceContainer is a container, or init container, of a workspace's pod and its status
+type WorkspaceContainer struct {
+	Name         string
+	Image        string
+	Init         bool
+	Ready        bool
+	RestartCount int32
+	// State is Waiting, Running or Terminated, it is empty if the container has not been created
+	State  string
+	Reason string
+}
+
+// WorkspaceLogOptions are the options for reading the logs of workspace containers
+type WorkspaceLogOptions struct {
+	// SinceTime only returns logs after this time if it is set
+	SinceTime time.Time
+	// TailLines only returns this many of the most recent lines of each container if it is greater than 0
+	TailLines int64
+	// Previous returns the logs of the previous instance of the containers, from before they restarted
+	Previous bool
+	// Follow keeps streaming new logs. Previous logs are never followed.
+	Follow bool
+}
+
+// WorkspaceDiagnostics explains the state of a workspace using the kubernetes objects and workflow created for it
+type WorkspaceDiagnostics struct {
+	Phase      WorkspacePhase
+	PodName    string
+	PodPhase   string
+	Conditions []*PodCondition
+	Containers []*WorkspaceContainer
+	Events     []*KubernetesEvent
+	// Workflow is the status of the workflow that performed the workspace's latest action, like launching or pausing it
+	Workflow *WorkflowStatusSummary
+	Findings []*DiagnosticFinding
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/request/pagination"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// AuditServer is an implementation of the grpc AuditServer
+type AuditServer struct {
+	api.UnimplementedAuditServiceServer
+}
+
+// NewAuditServer creates a new AuditServer
+func NewAuditServer() *AuditServer {
+	return &AuditServer{}
+}
+
+// parseAuditTimestamp parses an optional RFC3339 timestamp of a filter
+func parseAuditTimestamp(name, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, fmt.Sprintf("%v must be an RFC3339 timestamp.", name))
+	}
+
+	return &timestamp, nil
+}
+
+// authorizeAuditEvents converts the filter of a request and checks the user can list the audit events of its namespace,
+// or of all namespaces if it doesn't have one
+func authorizeAuditEvents(client *v1.Client, filter *api.AuditEventFilter) (*v1.AuditEventFilter, error) {
+	if filter == nil {
+		filter = &api.AuditEventFilter{}
+	}
+
+	allowed, err := auth.IsAuthorized(client, filter.Namespace, "list", "onepanel.io", "auditevents", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	since, err := parseAuditTimestamp("since", filter.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseAuditTimestamp("until", filter.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.AuditEventFilter{
+		Namespace:    filter.Namespace,
+		Username:     filter.Username,
+		ResourceType: filter.ResourceType,
+		ResourceUID:  filter.ResourceUid,
+		Method:       filter.Method,
+		Action:       filter.Action,
+		Code:         filter.Code,
+		Since:        since,
+		Until:        until,
+	}, nil
+}
+
+// ListAuditEvents returns a page of the audit events matching the filter, newest first
+func (s *AuditServer) ListAuditEvents(ctx context.Context, req *api.ListAuditEventsRequest) (*api.ListAuditEventsResponse, error) {
+	client := getClient(ctx)
+	filter, err := authorizeAuditEvents(client, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := pagination.New(req.Page, req.PageSize)
+	events, err := client.ListAuditEvents(filter, paginator)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := client.CountAuditEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	apiEvents := make([]*api.AuditEvent, len(events))
+	for i, event := range events {
+		apiEvents[i] = converter.AuditEventToAPI(event)
+	}
+
+	return &api.ListAuditEventsResponse{
+		Count:       int32(len(apiEvents)),
+		AuditEvents: apiEvents,
+		Page:        int32(paginator.Page),
+		Pages:       paginator.CalculatePages(count),
+		TotalCount:  int32(count),
+	}, nil
+}
+
+// ExportAuditEvents returns the audit events matching the filter as a JSON lines file
+func (s *AuditServer) ExportAuditEvents(ctx context.Context, req *api.ExportAuditEventsRequest) (*api.AuditEventsExport, error) {
+	client := getClient(ctx)
+	filter, err := authorizeAuditEvents(client, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.ExportAuditEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.AuditEventsExport{
+		Name:        fmt.Sprintf("audit-events-%v.jsonl", time.Now().UTC().Format("20060102150405")),
+		ContentType: "application/x-ndjson",
+		Data:        data,
+	}, nil
+}
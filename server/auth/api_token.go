@@ -0,0 +1,217 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// APITokenScope returns the resource and action a scope must allow to call a gRPC method,
+// e.g. /api.WorkflowService/CreateWorkflowExecution requires workflows:create.
+// ok is false if API tokens can't call the method at all, API tokens can't manage tokens.
+func APITokenScope(fullMethod string) (resource, action string, ok bool) {
+	resource, action, ok = MethodResourceAction(fullMethod)
+	if !ok || resource == "tokens" {
+		return "", "", false
+	}
+
+	return resource, action, true
+}
+
+// namespaced is implemented by requests of namespaced resources
+type namespaced interface {
+	GetNamespace() string
+}
+
+// bootstrapped is implemented by requests that bootstrap a namespace from a source namespace
+type bootstrapped interface {
+	GetBootstrap() *api.NamespaceBootstrap
+}
+
+// requestNamespaces returns every namespace a request touches: its own, the namespace it copies from
+// and the namespace it creates, if any
+func requestNamespaces(req interface{}) []string {
+	namespaces := make([]string, 0, 2)
+	add := func(namespace string) {
+		if namespace == "" {
+			return
+		}
+		for _, existing := range namespaces {
+			if existing == namespace {
+				return
+			}
+		}
+		namespaces = append(namespaces, namespace)
+	}
+
+	if nsReq, ok := req.(namespaced); ok {
+		add(nsReq.GetNamespace())
+	}
+	if createReq, ok := req.(interface{ GetNamespace() *api.Namespace }); ok {
+		add(createReq.GetNamespace().GetName())
+	}
+	if sourceReq, ok := req.(interface{ GetSourceNamespace() string }); ok {
+		add(sourceReq.GetSourceNamespace())
+	}
+	if bootstrapReq, ok := req.(bootstrapped); ok {
+		add(bootstrapReq.GetBootstrap().GetSourceNamespace())
+	}
+
+	return namespaces
+}
+
+// authorizeAPIToken returns an error if the API token in ctx, if any, can't call fullMethod with req
+func authorizeAPIToken(ctx context.Context, fullMethod string, req interface{}) error {
+	apiToken, ok := ctx.Value(ContextAPITokenKey).(*v1.APIToken)
+	if !ok {
+		return nil
+	}
+
+	resource, action, ok := APITokenScope(fullMethod)
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "API tokens can't call %v.", fullMethod)
+	}
+	if !apiToken.HasScope(resource, action) {
+		return status.Errorf(codes.PermissionDenied, "API token is missing the %v:%v scope.", resource, action)
+	}
+
+	return authorizeAPITokenNamespace(apiToken, req)
+}
+
+// authorizeAPITokenNamespace returns an error if req touches a namespace the API token can't be used in
+func authorizeAPITokenNamespace(apiToken *v1.APIToken, req interface{}) error {
+	for _, namespace := range requestNamespaces(req) {
+		if !apiToken.AllowsNamespace(namespace) {
+			return status.Errorf(codes.PermissionDenied, "API token can't be used in namespace '%v'.", namespace)
+		}
+	}
+
+	return nil
+}
+
+// serviceAccountUsernamePrefix starts the usernames of service accounts, system:serviceaccount:<namespace>:<name>
+const serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+// CanVerifyAPITokenOwner returns true if verifyAPITokenOwner can check that username still exists and is
+// a member of its groups, which is required to create API tokens
+func CanVerifyAPITokenOwner(username string) bool {
+	return strings.HasPrefix(username, serviceAccountUsernamePrefix) || strings.HasPrefix(username, oidcUsernamePrefix)
+}
+
+// verifyAPITokenOwner returns an error if the owner of an API token no longer exists or is no longer a member
+// of the groups the token impersonates.
+// Service accounts are looked up, and their groups only depend on their namespace. Users of the identity provider
+// can only be checked against the current group mappings, so their tokens don't outlive the session they were
+// created with.
+func verifyAPITokenOwner(client *v1.Client, sysConfig v1.SystemConfig, apiToken *v1.APIToken) error {
+	var ownerGroups []string
+	switch {
+	case strings.HasPrefix(apiToken.Owner, serviceAccountUsernamePrefix):
+		parts := strings.Split(strings.TrimPrefix(apiToken.Owner, serviceAccountUsernamePrefix), ":")
+		if len(parts) != 2 {
+			return status.Error(codes.Unauthenticated, "The owner of the API token is invalid.")
+		}
+		_, err := client.CoreV1().ServiceAccounts(parts[0]).Get(parts[1], metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return status.Error(codes.Unauthenticated, "The owner of the API token no longer exists.")
+		}
+		if err != nil {
+			return err
+		}
+		ownerGroups = []string{"system:serviceaccounts", "system:serviceaccounts:" + parts[0]}
+	case strings.HasPrefix(apiToken.Owner, oidcUsernamePrefix):
+		oidcConfig, err := sysConfig.OIDC()
+		if err != nil {
+			return err
+		}
+		if oidcConfig == nil {
+			return status.Error(codes.Unauthenticated, "The owner of the API token can no longer log in.")
+		}
+		for _, mapping := range oidcConfig.GroupMappings {
+			ownerGroups = append(ownerGroups, OIDCImpersonationGroup(mapping))
+		}
+	default:
+		return status.Error(codes.Unauthenticated, "The owner of the API token can't be verified.")
+	}
+
+	for _, group := range apiToken.OwnerGroups {
+		found := false
+		for _, ownerGroup := range ownerGroups {
+			if group == ownerGroup {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return status.Errorf(codes.Unauthenticated, "The owner of the API token is no longer a member of '%v'.", group)
+		}
+	}
+
+	return nil
+}
+
+// getAPITokenClient returns a context with a client that impersonates the owner of an API token, and the token.
+// Like sessions, the client uses the server's own credentials to impersonate the owner.
+func getAPITokenClient(ctx context.Context, token string, db *v1.DB, sysConfig v1.SystemConfig) (context.Context, error) {
+	defaultClient, err := v1.NewClientWithContext(ctx, v1.NewConfig(), db, sysConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	apiToken, err := defaultClient.AuthenticateAPIToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyAPITokenOwner(defaultClient, sysConfig, apiToken); err != nil {
+		return nil, err
+	}
+
+	config, err := newImpersonatingConfig(apiToken.Owner, apiToken.OwnerGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v1.NewClientWithContext(ctx, config, db, sysConfig)
+	if err != nil {
+		return nil, err
+	}
+	client.Token = token
+
+	ctx = context.WithValue(ctx, ContextAPITokenKey, apiToken)
+
+	return context.WithValue(ctx, ContextClientKey, client), nil
+}
+
+// GetAPIToken returns the API token the request in ctx was made with, or nil if it was not made with one
+func GetAPIToken(ctx context.Context) *v1.APIToken {
+	apiToken, _ := ctx.Value(ContextAPITokenKey).(*v1.APIToken)
+
+	return apiToken
+}
+
+// apiTokenServerStream checks the namespace of the messages received on a stream made with an API token
+type apiTokenServerStream struct {
+	grpc.ServerStream
+	apiToken *v1.APIToken
+}
+
+// RecvMsg receives a message and checks its namespace
+func (s *apiTokenServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if err := authorizeAPITokenNamespace(s.apiToken, m); err != nil {
+		return err
+	}
+
+	return nil
+}
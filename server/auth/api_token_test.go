@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func Test_APITokenScope(t *testing.T) {
+	tests := map[string]string{
+		"/api.WorkflowService/CreateWorkflowExecution":    "workflows:create",
+		"/api.WorkflowService/GetWorkflowExecutionLogs":   "workflows:read",
+		"/api.WorkflowService/TerminateWorkflowExecution": "workflows:update",
+		"/api.SecretService/SecretExists":                 "secrets:read",
+		"/api.SecretService/DeleteSecretKey":              "secrets:delete",
+		"/api.WorkspaceService/PauseWorkspace":            "workspaces:update",
+	}
+	for method, scope := range tests {
+		resource, action, ok := APITokenScope(method)
+		assert.True(t, ok, method)
+		assert.Equal(t, scope, resource+":"+action, method)
+	}
+
+	_, _, ok := APITokenScope("/api.TokenService/CreateAPIToken")
+	assert.False(t, ok)
+}
+
+func Test_authorizeAPIToken(t *testing.T) {
+	apiToken := &v1.APIToken{
+		Namespaces: []string{"team-a"},
+		Scopes:     []string{"workflows:create"},
+	}
+	ctx := context.WithValue(context.Background(), ContextAPITokenKey, apiToken)
+
+	assert.Nil(t, authorizeAPIToken(ctx, "/api.WorkflowService/CreateWorkflowExecution", &api.CreateWorkflowExecutionRequest{Namespace: "team-a"}))
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.WorkflowService/CreateWorkflowExecution", &api.CreateWorkflowExecutionRequest{Namespace: "team-b"}))
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.SecretService/GetSecret", &api.GetSecretRequest{Namespace: "team-a"}))
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.TokenService/ListAPITokens", &api.ListAPITokensRequest{}))
+
+	// Requests made without an API token are not limited
+	assert.Nil(t, authorizeAPIToken(context.Background(), "/api.SecretService/GetSecret", &api.GetSecretRequest{Namespace: "team-b"}))
+}
+
+func Test_authorizeAPIToken_SourceNamespace(t *testing.T) {
+	apiToken := &v1.APIToken{
+		Namespaces: []string{"team-a"},
+		Scopes:     []string{"namespaces:*"},
+	}
+	ctx := context.WithValue(context.Background(), ContextAPITokenKey, apiToken)
+
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.NamespaceService/CopyResources", &api.CopyResourcesRequest{Namespace: "team-a", SourceNamespace: "team-b"}))
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.NamespaceService/BootstrapNamespace", &api.BootstrapNamespaceRequest{
+		Namespace: "team-a",
+		Bootstrap: &api.NamespaceBootstrap{SourceNamespace: "team-b"},
+	}))
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.NamespaceService/CreateNamespace", &api.CreateNamespaceRequest{
+		Namespace: &api.Namespace{Name: "team-b"},
+	}))
+	assert.NotNil(t, authorizeAPIToken(ctx, "/api.NamespaceService/CreateNamespace", &api.CreateNamespaceRequest{
+		Namespace: &api.Namespace{Name: "team-a"},
+		Bootstrap: &api.NamespaceBootstrap{SourceNamespace: "team-b"},
+	}))
+	assert.Nil(t, authorizeAPIToken(ctx, "/api.NamespaceService/BootstrapNamespace", &api.BootstrapNamespaceRequest{
+		Namespace: "team-a",
+		Bootstrap: &api.NamespaceBootstrap{SourceNamespace: "team-a"},
+	}))
+}
+
+func Test_verifyAPITokenOwner(t *testing.T) {
+	client := &v1.Client{Interface: fake.NewSimpleClientset(&corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "onepanel", Name: "ci"},
+	})}
+	sysConfig := v1.SystemConfig{
+		"ONEPANEL_API_URL": "https://app.onepanel.test/api",
+		"oidcIssuerURL":    "https://idp.onepanel.test",
+		"oidcGroupMappings": `
+- group: data-science
+  namespace: team-a
+  role: onepanel-editor`,
+	}
+
+	assert.Nil(t, verifyAPITokenOwner(client, sysConfig, &v1.APIToken{
+		Owner:       "system:serviceaccount:onepanel:ci",
+		OwnerGroups: []string{"system:serviceaccounts", "system:serviceaccounts:onepanel"},
+	}))
+	assert.NotNil(t, verifyAPITokenOwner(client, sysConfig, &v1.APIToken{
+		Owner: "system:serviceaccount:onepanel:deleted",
+	}))
+	assert.NotNil(t, verifyAPITokenOwner(client, sysConfig, &v1.APIToken{
+		Owner:       "system:serviceaccount:onepanel:ci",
+		OwnerGroups: []string{"system:masters"},
+	}))
+
+	assert.Nil(t, verifyAPITokenOwner(client, sysConfig, &v1.APIToken{
+		Owner:       "oidc:jane@example.com",
+		OwnerGroups: []string{"onepanel:team-a:onepanel-editor"},
+	}))
+	assert.NotNil(t, verifyAPITokenOwner(client, sysConfig, &v1.APIToken{
+		Owner:       "oidc:jane@example.com",
+		OwnerGroups: []string{"onepanel:team-b:onepanel-admin"},
+	}))
+
+	assert.NotNil(t, verifyAPITokenOwner(client, sysConfig, &v1.APIToken{Owner: "admin"}))
+}
@@ -0,0 +1,305 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	v1 "github.com/onepanelio/core/pkg"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// auditRedacted replaces the values of fields that are not recorded in audited requests
+	auditRedacted = "[REDACTED]"
+	// auditMaxStringLength is the longest a string in an audited request can be, e.g. manifests are truncated
+	auditMaxStringLength = 1024
+	// unauthenticatedAuditInterval is how often a denied unauthenticated request of a method is recorded.
+	// The ones in between are counted in the next one that is, so anonymous clients can't grow the audit log
+	// without limit.
+	unauthenticatedAuditInterval = time.Minute
+)
+
+// auditFields are the fields of requests whose values are recorded, the values of other fields are redacted.
+// They identify resources or choose options, rather than hold content that could be secret.
+// The fields of nested messages and the keys of maps, e.g. the keys of secret data, are always kept.
+var auditFields = map[string]bool{
+	"namespace":                true,
+	"sourceNamespace":          true,
+	"namespaces":               true,
+	"name":                     true,
+	"uid":                      true,
+	"secretName":               true,
+	"secrets":                  true,
+	"key":                      true,
+	"keys":                     true,
+	"labelKey":                 true,
+	"kind":                     true,
+	"phase":                    true,
+	"action":                   true,
+	"role":                     true,
+	"roles":                    true,
+	"subjectKind":              true,
+	"subjectName":              true,
+	"scopes":                   true,
+	"ttl":                      true,
+	"visibility":               true,
+	"owner":                    true,
+	"resourceType":             true,
+	"resourceUid":              true,
+	"resourceQuota":            true,
+	"templateName":             true,
+	"workflowTemplateUid":      true,
+	"workflowTemplateVersion":  true,
+	"version":                  true,
+	"workspaceTemplateUid":     true,
+	"workspaceTemplateVersion": true,
+	"workflowTemplates":        true,
+	"workspaceTemplates":       true,
+	"cronWorkflows":            true,
+	"allVersions":              true,
+	"copyTemplates":            true,
+	"onConflict":               true,
+	"dryRun":                   true,
+	"force":                    true,
+	"concurrency":              true,
+}
+
+// auditValue returns a scalar value of the field name, or auditRedacted if the field is not in auditFields.
+// Long strings are truncated, e.g. manifests.
+func auditValue(name string, fd protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	if !auditFields[name] {
+		return auditRedacted
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if v := value.String(); len(v) > auditMaxStringLength {
+			return v[:auditMaxStringLength] + "..."
+		}
+		return value.String()
+	case protoreflect.BytesKind:
+		return auditRedacted
+	case protoreflect.EnumKind:
+		if enumValue := fd.Enum().Values().ByNumber(value.Enum()); enumValue != nil {
+			return string(enumValue.Name())
+		}
+		return value.Enum()
+	}
+
+	return value.Interface()
+}
+
+// auditField returns a value of the field name, with nested messages audited recursively.
+// fd describes the value, which is the value type of the field if it is a map.
+func auditField(name string, fd protoreflect.FieldDescriptor, value protoreflect.Value) interface{} {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return auditMessage(value.Message())
+	}
+
+	return auditValue(name, fd, value)
+}
+
+// auditMessage returns the set fields of a message with the values of fields that are not in auditFields redacted
+func auditMessage(message protoreflect.Message) map[string]interface{} {
+	result := make(map[string]interface{})
+	message.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			entries := make(map[string]interface{})
+			value.Map().Range(func(key protoreflect.MapKey, item protoreflect.Value) bool {
+				entries[key.String()] = auditField(fd.JSONName(), fd.MapValue(), item)
+				return true
+			})
+			result[fd.JSONName()] = entries
+		case fd.IsList():
+			list := value.List()
+			items := make([]interface{}, list.Len())
+			for i := range items {
+				items[i] = auditField(fd.JSONName(), fd, list.Get(i))
+			}
+			result[fd.JSONName()] = items
+		default:
+			result[fd.JSONName()] = auditField(fd.JSONName(), fd, value)
+		}
+		return true
+	})
+
+	return result
+}
+
+// auditRequest returns the request as JSON with the values of fields that are not in auditFields redacted,
+// or nil if it can't be encoded
+func auditRequest(req interface{}) *string {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(auditMessage(message.ProtoReflect()))
+	if err != nil {
+		return nil
+	}
+
+	result := string(data)
+
+	return &result
+}
+
+// auditResourceUID returns the uid, or name, of the resource of a request. Created resources are in the response.
+func auditResourceUID(req, resp interface{}) string {
+	for _, value := range []interface{}{req, resp} {
+		if r, ok := value.(interface{ GetUid() string }); ok && r.GetUid() != "" {
+			return r.GetUid()
+		}
+	}
+
+	if r, ok := req.(interface{ GetName() string }); ok {
+		return r.GetName()
+	}
+	if r, ok := req.(interface{ GetSecretName() string }); ok {
+		return r.GetSecretName()
+	}
+
+	return ""
+}
+
+// requestUser returns the user that made the request in ctx
+func requestUser(ctx context.Context, client *v1.Client) (*User, error) {
+	if apiToken := GetAPIToken(ctx); apiToken != nil {
+		return &User{Username: apiToken.Owner, Groups: apiToken.OwnerGroups}, nil
+	}
+
+	return GetUser(client)
+}
+
+// auditState is shared by the interceptors of an audited request. UnaryInterceptor sets ctx to the context
+// it authenticated the request with, so requests denied after authentication are recorded with their user.
+type auditState struct {
+	ctx context.Context
+}
+
+// auditAuthenticated returns a handler that sets the context of the audited request, if any, before calling handler
+func auditAuthenticated(handler grpc.UnaryHandler) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		if ctx != nil {
+			if state, ok := ctx.Value(contextAuditKey).(*auditState); ok {
+				state.ctx = ctx
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// recordAuditEvent stores an audit event for a request. ctx is the authenticated context of the request,
+// or nil if it was not authenticated, in which case the user is unknown.
+// Errors are logged rather than returned so the request is not affected.
+func recordAuditEvent(ctx context.Context, db *v1.DB, fullMethod, resource, action string, req, resp interface{}, err error) {
+	event := &v1.AuditEvent{
+		ResourceType: resource,
+		ResourceUID:  auditResourceUID(req, resp),
+		Method:       fullMethod,
+		Action:       action,
+		Request:      auditRequest(req),
+		Username:     "unknown",
+	}
+
+	if nsReq, ok := req.(namespaced); ok {
+		event.Namespace = nsReq.GetNamespace()
+	}
+
+	s := status.Convert(err)
+	event.Code = s.Code().String()
+	if err != nil {
+		event.Message = s.Message()
+	}
+
+	if ctx != nil {
+		if client, ok := ctx.Value(ContextClientKey).(*v1.Client); ok && client != nil {
+			if user, userErr := requestUser(ctx, client); userErr == nil {
+				event.Username = user.Username
+				event.Groups = user.Groups
+			}
+		}
+	}
+
+	client := &v1.Client{DB: db}
+	if err := client.CreateAuditEvent(event); err != nil {
+		log.WithFields(log.Fields{
+			"Method": fullMethod,
+			"Error":  err.Error(),
+		}).Error("Unable to record audit event.")
+	}
+}
+
+// auditLimiter limits how often denied unauthenticated requests are recorded, by method
+type auditLimiter struct {
+	mutex      sync.Mutex
+	recordedAt map[string]time.Time
+	skipped    map[string]int
+}
+
+// newAuditLimiter creates an auditLimiter that hasn't recorded any requests
+func newAuditLimiter() *auditLimiter {
+	return &auditLimiter{
+		recordedAt: make(map[string]time.Time),
+		skipped:    make(map[string]int),
+	}
+}
+
+// allow returns true if a denied unauthenticated request of method is recorded at now,
+// along with how many were not recorded since the last one that was
+func (l *auditLimiter) allow(method string, now time.Time) (bool, int) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if recordedAt, ok := l.recordedAt[method]; ok && now.Sub(recordedAt) < unauthenticatedAuditInterval {
+		l.skipped[method]++
+		return false, 0
+	}
+
+	skipped := l.skipped[method]
+	l.recordedAt[method] = now
+	delete(l.skipped, method)
+
+	return true, skipped
+}
+
+// AuditUnaryInterceptor records an audit event for each mutating request, with its result.
+// It must come before UnaryInterceptor, so requests that are denied by it or by the interceptors after it
+// are recorded too. Requests denied before they are authenticated are recorded at most once every
+// unauthenticatedAuditInterval for each method.
+func AuditUnaryInterceptor(db *v1.DB) grpc.UnaryServerInterceptor {
+	limiter := newAuditLimiter()
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		resource, action, ok := MethodResourceAction(info.FullMethod)
+		if !ok || action == "read" {
+			return handler(ctx, req)
+		}
+
+		state := &auditState{}
+		resp, err = handler(context.WithValue(ctx, contextAuditKey, state), req)
+
+		auditErr := err
+		if state.ctx == nil && err != nil {
+			record, skipped := limiter.allow(info.FullMethod, time.Now())
+			if !record {
+				return resp, err
+			}
+			if skipped > 0 {
+				s := status.Convert(err)
+				auditErr = status.Errorf(s.Code(), "%v (%v more unauthenticated requests were denied since the last one recorded)", s.Message(), skipped)
+			}
+		}
+		recordAuditEvent(state.ctx, db, info.FullMethod, resource, action, req, resp, auditErr)
+
+		return resp, err
+	}
+}
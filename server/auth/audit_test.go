@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/onepanelio/core/api/gen"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func Test_auditRequest(t *testing.T) {
+	request := auditRequest(&api.CreateSecretRequest{
+		Namespace: "onepanel",
+		Secret: &api.Secret{
+			Name: "aws",
+			Data: map[string]string{"AWS_SECRET_ACCESS_KEY": "hunter2"},
+		},
+	})
+	if !assert.NotNil(t, request) {
+		return
+	}
+	assert.NotContains(t, *request, "hunter2")
+
+	decoded := make(map[string]interface{})
+	assert.Nil(t, json.Unmarshal([]byte(*request), &decoded))
+	assert.Equal(t, "onepanel", decoded["namespace"])
+	assert.Equal(t, map[string]interface{}{
+		"name": "aws",
+		"data": map[string]interface{}{"AWS_SECRET_ACCESS_KEY": auditRedacted},
+	}, decoded["secret"])
+
+	request = auditRequest(&api.CreateWorkflowTemplateRequest{
+		Namespace:        "onepanel",
+		WorkflowTemplate: &api.WorkflowTemplate{Name: strings.Repeat("a", 2*auditMaxStringLength), Manifest: "password: hunter2"},
+	})
+	if assert.NotNil(t, request) {
+		assert.Less(t, len(*request), 2*auditMaxStringLength)
+		assert.NotContains(t, *request, "hunter2")
+	}
+
+	// Fields that are not allowed are redacted, even if they are not known to be secret
+	request = auditRequest(&api.CreateWorkspaceRequest{
+		Namespace: "onepanel",
+		Body: &api.CreateWorkspaceBody{
+			WorkspaceTemplateUid: "jupyterlab",
+			Parameters:           []*api.Parameter{{Name: "api-key", Value: "hunter2"}},
+		},
+	})
+	if assert.NotNil(t, request) {
+		assert.NotContains(t, *request, "hunter2")
+		assert.Contains(t, *request, "api-key")
+	}
+}
+
+func Test_auditResourceUID(t *testing.T) {
+	assert.Equal(t, "uid", auditResourceUID(&api.DeleteWorkspaceRequest{Uid: "uid"}, nil))
+	assert.Equal(t, "created", auditResourceUID(&api.CreateWorkspaceRequest{}, &api.Workspace{Uid: "created"}))
+	assert.Equal(t, "aws", auditResourceUID(&api.DeleteSecretRequest{Name: "aws"}, nil))
+	assert.Equal(t, "aws", auditResourceUID(&api.DeleteSecretKeyRequest{SecretName: "aws"}, nil))
+}
+
+func Test_AuditUnaryInterceptor_Read(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	// Reads are not recorded, so there is no need for a client
+	_, err := AuditUnaryInterceptor(nil)(context.Background(), &api.GetWorkspaceRequest{}, &grpc.UnaryServerInfo{
+		FullMethod: "/api.WorkspaceService/GetWorkspace",
+	}, handler)
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func Test_auditLimiter(t *testing.T) {
+	limiter := newAuditLimiter()
+	now := time.Now()
+	method := "/api.SecretService/DeleteSecret"
+
+	record, skipped := limiter.allow(method, now)
+	assert.True(t, record)
+	assert.Equal(t, 0, skipped)
+
+	for i := 0; i < 3; i++ {
+		record, _ = limiter.allow(method, now.Add(time.Second))
+		assert.False(t, record)
+	}
+
+	// Other methods are limited on their own
+	record, _ = limiter.allow("/api.SecretService/CreateSecret", now)
+	assert.True(t, record)
+
+	record, skipped = limiter.allow(method, now.Add(unauthenticatedAuditInterval))
+	assert.True(t, record)
+	assert.Equal(t, 3, skipped)
+
+	_, skipped = limiter.allow(method, now.Add(2*unauthenticatedAuditInterval))
+	assert.Equal(t, 0, skipped)
+}
+
+func Test_MethodResourceAction(t *testing.T) {
+	resource, action, ok := MethodResourceAction("/api.TokenService/RevokeAPIToken")
+	assert.True(t, ok)
+	assert.Equal(t, "tokens", resource)
+	assert.Equal(t, "delete", action)
+
+	resource, action, ok = MethodResourceAction("/api.LabelService/ReplaceLabels")
+	assert.True(t, ok)
+	assert.Equal(t, "labels:update", resource+":"+action)
+
+	resource, action, ok = MethodResourceAction("/api.WorkflowService/ApproveWorkflowNode")
+	assert.True(t, ok)
+	assert.Equal(t, "workflows:approve", resource+":"+action)
+
+	_, _, ok = MethodResourceAction("/grpc.health.v1.Health/Check")
+	assert.False(t, ok)
+}
+
+func Test_auditAuthenticated(t *testing.T) {
+	state := &auditState{}
+	ctx := context.WithValue(context.Background(), contextAuditKey, state)
+	authenticated := context.WithValue(ctx, ContextAPITokenKey, "token")
+
+	handler := auditAuthenticated(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	})
+	_, err := handler(authenticated, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, authenticated, state.ctx)
+
+	// Requests that are not audited are left as is
+	_, err = handler(context.Background(), nil)
+	assert.Nil(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"net/http"
 	"strings"
+	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	v1 "github.com/onepanelio/core/pkg"
@@ -19,14 +20,31 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/rest"
 )
 
 type key int
 
+// User is the user a request is made as
+type User struct {
+	Username string
+	Groups   []string
+	// ExpiresAt is when the session of the user expires, it is nil unless the user logged in with a session
+	ExpiresAt *time.Time
+}
+
 const (
 	// ContextClientKey is the key used to identify the Client value in Context
 	ContextClientKey key = iota
+	// ContextAPITokenKey is the key used to identify the APIToken value in Context, if the request was made with one
+	ContextAPITokenKey
+	// ContextRolesKey is the key used to identify the Onepanel roles that authorized the request in Context, if any,
+	// by namespace
+	ContextRolesKey
+	// contextAuditKey is the key used to identify the auditState of a request in Context, if it is audited
+	contextAuditKey
 )
 
 func getBearerToken(ctx context.Context) (*string, bool) {
@@ -56,7 +74,7 @@ func getBearerToken(ctx context.Context) (*string, bool) {
 		req := &http.Request{
 			Header: header,
 		}
-		t, _ := req.Cookie("auth-token")
+		t, _ := req.Cookie(SessionCookieName)
 		if t != nil {
 			return &t.Value, true
 		}
@@ -89,9 +107,16 @@ func getClient(ctx context.Context, kubeConfig *v1.Config, db *v1.DB, sysConfig
 		return nil, status.Error(codes.Unauthenticated, "Bearer token is nil")
 	}
 
+	if IsSessionToken(*bearerToken) {
+		return getSessionClient(ctx, *bearerToken, db, sysConfig)
+	}
+	if v1.IsAPIToken(*bearerToken) {
+		return getAPITokenClient(ctx, *bearerToken, db, sysConfig)
+	}
+
 	kubeConfig.BearerToken = *bearerToken
 
-	client, err := v1.NewClient(kubeConfig, db, sysConfig)
+	client, err := v1.NewClientWithContext(ctx, kubeConfig, db, sysConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -100,6 +125,44 @@ func getClient(ctx context.Context, kubeConfig *v1.Config, db *v1.DB, sysConfig
 	return context.WithValue(ctx, ContextClientKey, client), nil
 }
 
+// getSessionClient returns a context with a client that impersonates the user of a session token.
+// The client uses the server's own credentials, so its service account must be allowed to impersonate users and groups.
+func getSessionClient(ctx context.Context, token string, db *v1.DB, sysConfig v1.SystemConfig) (context.Context, error) {
+	session, err := ParseSessionToken(sysConfig.HMACKey(), token, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid or expired session, please log in again.")
+	}
+
+	config, err := newImpersonatingConfig(session.Username, session.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := v1.NewClientWithContext(ctx, config, db, sysConfig)
+	if err != nil {
+		return nil, err
+	}
+	client.Token = token
+
+	return context.WithValue(ctx, ContextClientKey, client), nil
+}
+
+// newImpersonatingConfig returns a config that impersonates userName and groups.
+// Without a user name the client would act as the server itself, so an empty one is refused.
+func newImpersonatingConfig(userName string, groups []string) (*v1.Config, error) {
+	if userName == "" {
+		return nil, status.Error(codes.Unauthenticated, "Unable to impersonate a user without a name.")
+	}
+
+	config := v1.NewConfig()
+	config.Impersonate = rest.ImpersonationConfig{
+		UserName: userName,
+		Groups:   groups,
+	}
+
+	return config, nil
+}
+
 func IsAuthorized(c *v1.Client, namespace, verb, group, resource, name string) (allowed bool, err error) {
 	review, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
 		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
@@ -125,6 +188,65 @@ func IsAuthorized(c *v1.Client, namespace, verb, group, resource, name string) (
 	return
 }
 
+// GetUsername returns the name of the user the client's token belongs to, e.g. system:serviceaccount:onepanel:admin
+func GetUsername(c *v1.Client) (username string, err error) {
+	user, err := GetUser(c)
+	if err != nil {
+		return "", err
+	}
+
+	return user.Username, nil
+}
+
+// GetUser returns the user the client's token belongs to.
+// The token is verified with a TokenReview made by the default client as users can't usually create TokenReviews.
+// Session tokens are verified with the system's hmac key instead, and API tokens belong to the user that created them.
+func GetUser(c *v1.Client) (user *User, err error) {
+	if IsSessionToken(c.Token) {
+		sysConfig, err := c.GetSystemConfig()
+		if err != nil {
+			return nil, err
+		}
+		session, err := ParseSessionToken(sysConfig.HMACKey(), c.Token, time.Now())
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid or expired session.")
+		}
+		return &User{Username: session.Username, Groups: session.Groups, ExpiresAt: &session.ExpiresAt}, nil
+	}
+
+	if v1.IsAPIToken(c.Token) {
+		apiToken, err := c.AuthenticateAPIToken(c.Token)
+		if err != nil {
+			return nil, err
+		}
+		return &User{Username: apiToken.Owner, Groups: apiToken.OwnerGroups}, nil
+	}
+
+	defaultClient, err := v1.GetDefaultClientWithDB(c.DB)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := defaultClient.AuthenticationV1().TokenReviews().Create(&authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{
+			Token: c.Token,
+		},
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": "GetUser",
+			"Error":  err.Error(),
+		}).Error("Unable to review token")
+		return nil, status.Error(codes.Unauthenticated, "Unable to verify token.")
+	}
+
+	if !review.Status.Authenticated {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token.")
+	}
+
+	return &User{Username: review.Status.User.Username, Groups: review.Status.User.Groups}, nil
+}
+
 func verifyLogin(client *v1.Client, tokenRequest *api.GetAccessTokenRequest) (rawToken string, err error) {
 	accountsList, err := client.CoreV1().ServiceAccounts("onepanel").List(v1.ListOptions{})
 	if err != nil {
@@ -168,6 +290,8 @@ func verifyLogin(client *v1.Client, tokenRequest *api.GetAccessTokenRequest) (ra
 //   2. Is there a token? There should be a token for everything except logging in.
 func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfig) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		handler = auditAuthenticated(handler)
+
 		// Check if the provided token is valid. This does not require a token in the header.
 		if info.FullMethod == "/api.AuthService/GetAccessToken" {
 			md, ok := metadata.FromIncomingContext(ctx)
@@ -280,6 +404,10 @@ func UnaryInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemConfi
 			return
 		}
 
+		if err = authorizeAPIToken(ctx, info.FullMethod, req); err != nil {
+			return
+		}
+
 		return handler(ctx, req)
 	}
 }
@@ -291,8 +419,16 @@ func StreamingInterceptor(kubeConfig *v1.Config, db *v1.DB, sysConfig v1.SystemC
 		if err != nil {
 			return
 		}
+		// The request of a stream is only received by the handler, so its namespace is checked as it is received
+		if err = authorizeAPIToken(ctx, info.FullMethod, nil); err != nil {
+			return
+		}
+
 		wrapped := grpc_middleware.WrapServerStream(ss)
 		wrapped.WrappedContext = ctx
+		if apiToken := GetAPIToken(ctx); apiToken != nil {
+			return handler(srv, &apiTokenServerStream{ServerStream: wrapped, apiToken: apiToken})
+		}
 
 		return handler(srv, wrapped)
 	}
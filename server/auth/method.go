@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"strings"
+
+	v1 "github.com/onepanelio/core/pkg"
+)
+
+// serviceResources are the resources the methods of a service act on
+var serviceResources = map[string]string{
+	"api.WorkflowService":          "workflows",
+	"api.WorkflowTemplateService":  "workflowtemplates",
+	"api.CronWorkflowService":      "cronworkflows",
+	"api.WorkspaceService":         "workspaces",
+	"api.WorkspaceTemplateService": "workspacetemplates",
+	"api.SecretService":            "secrets",
+	"api.NamespaceService":         "namespaces",
+	"api.LabelService":             "labels",
+	"api.ConfigService":            "config",
+	"api.ServiceService":           "services",
+	"api.AuthService":              "auth",
+	"api.TokenService":             "tokens",
+	"api.AuditService":             "auditevents",
+	"api.RoleService":              "roles",
+}
+
+// clusterResources are not in a namespace, so the roles bound in one don't apply to them
+var clusterResources = map[string]bool{
+	"namespaces": true,
+}
+
+// methodActionPrefixes map the start of method names to their action.
+// Methods that don't start with any of them are updates.
+var methodActionPrefixes = []struct {
+	prefix string
+	action string
+}{
+	{"Get", "read"},
+	{"List", "read"},
+	{"Watch", "read"},
+	{"Search", "read"},
+	{"Download", "read"},
+	{"Export", "read"},
+	{"Generate", "read"},
+	{"Is", "read"},
+	{"SecretExists", "read"},
+	{"Create", "create"},
+	{"Clone", "create"},
+	{"Add", "create"},
+	{"Delete", "delete"},
+	{"Revoke", "delete"},
+	// Approving a gate is its own action, so approvers can be kept apart from those who run workflows
+	{"Approve", "approve"},
+}
+
+// kubernetesVerbActions map Kubernetes verbs to the actions of roles. Other verbs, like approve, are their own action.
+var kubernetesVerbActions = map[string]string{
+	"get":              "read",
+	"list":             "read",
+	"watch":            "read",
+	"create":           "create",
+	"update":           "update",
+	"patch":            "update",
+	"delete":           "delete",
+	"deletecollection": "delete",
+}
+
+// KubernetesRoleRequest returns the role request of a Kubernetes authorization check of verb on resource,
+// e.g. get on workspaces when a workspace's URL is opened
+func KubernetesRoleRequest(verb, resource string) *v1.RoleRequest {
+	action, ok := kubernetesVerbActions[verb]
+	if !ok {
+		action = verb
+	}
+
+	return &v1.RoleRequest{
+		Resource: resource,
+		Action:   action,
+	}
+}
+
+// MethodResourceAction returns the resource a gRPC method acts on and its action: read, create, update, delete or approve.
+// e.g. /api.WorkflowService/TerminateWorkflowExecution updates workflows.
+// ok is false if the service of the method is not known.
+func MethodResourceAction(fullMethod string) (resource, action string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	resource, ok = serviceResources[parts[0]]
+	if !ok {
+		return "", "", false
+	}
+
+	for _, actionPrefix := range methodActionPrefixes {
+		if strings.HasPrefix(parts[1], actionPrefix.prefix) {
+			return resource, actionPrefix.action, true
+		}
+	}
+
+	return resource, "update", true
+}
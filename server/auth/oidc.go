@@ -0,0 +1,455 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	v1 "github.com/onepanelio/core/pkg"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/oauth2"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// OIDCPathPrefix is where the OIDCHandler is served on the HTTP gateway
+	OIDCPathPrefix = "/auth/oidc/"
+	// SessionCookieName is the cookie sessions are stored in. getBearerToken and the websocket proxy read it.
+	SessionCookieName = "auth-token"
+	// oidcUsernamePrefix is prepended to the usernames of the identity provider so they can't be mistaken for
+	// Kubernetes users
+	oidcUsernamePrefix = "oidc:"
+	// oidcStateCookieName stores the state of a login until the identity provider redirects back
+	oidcStateCookieName = "oidc-state"
+	oidcStateDuration   = 10 * time.Minute
+)
+
+// oidcLoginState is kept in a signed cookie between redirecting to the identity provider and its callback
+type oidcLoginState struct {
+	State     string    `json:"state"`
+	Nonce     string    `json:"nonce"`
+	Redirect  string    `json:"redirect"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// OIDCHandler serves logging in with an OpenID Connect identity provider using the authorization code flow.
+// Users are given the roles of the namespaces their groups are mapped to, and a session cookie.
+type OIDCHandler struct {
+	systemConfig func() (v1.SystemConfig, error)
+	kubeClient   func() (kubernetes.Interface, error)
+	now          func() time.Time
+
+	mutex     sync.Mutex
+	providers map[string]*oidc.Provider
+}
+
+// NewOIDCHandler creates an OIDCHandler that reads the system config and manages role bindings with the
+// default client
+func NewOIDCHandler() *OIDCHandler {
+	return &OIDCHandler{
+		systemConfig: func() (v1.SystemConfig, error) {
+			client, err := v1.NewClient(v1.NewConfig(), nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			return client.GetSystemConfig()
+		},
+		kubeClient: func() (kubernetes.Interface, error) {
+			return v1.NewClient(v1.NewConfig(), nil, nil)
+		},
+		now:       time.Now,
+		providers: make(map[string]*oidc.Provider),
+	}
+}
+
+// getProvider returns the provider of an issuer, discovering its endpoints the first time
+func (h *OIDCHandler) getProvider(ctx context.Context, issuerURL string) (*oidc.Provider, error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if provider, ok := h.providers[issuerURL]; ok {
+		return provider, nil
+	}
+
+	provider, err := oidc.NewProvider(ctx, issuerURL)
+	if err != nil {
+		return nil, err
+	}
+	h.providers[issuerURL] = provider
+
+	return provider, nil
+}
+
+// randomString returns a random url safe string
+func randomString() (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// safeRedirect returns redirect if it is a path, or a URL on the Onepanel domain. Otherwise, the Onepanel URL.
+func safeRedirect(config v1.SystemConfig, redirect string) string {
+	defaultRedirect := "/"
+	if protocol, fqdn := config.APIProtocol(), config.FQDN(); protocol != nil && fqdn != nil {
+		defaultRedirect = *protocol + *fqdn
+	}
+
+	if redirect == "" {
+		return defaultRedirect
+	}
+	if strings.HasPrefix(redirect, "/") && !strings.HasPrefix(redirect, "//") && !strings.HasPrefix(redirect, "/\\") {
+		return redirect
+	}
+
+	parsed, err := url.Parse(redirect)
+	domain := config.Domain()
+	if err != nil || domain == nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+		return defaultRedirect
+	}
+	host := parsed.Hostname()
+	if host == *domain || strings.HasSuffix(host, "."+*domain) {
+		return redirect
+	}
+
+	return defaultRedirect
+}
+
+// OIDCImpersonationGroup is the Kubernetes group that members of a mapped identity provider group impersonate
+func OIDCImpersonationGroup(mapping *v1.OIDCGroupMapping) string {
+	return fmt.Sprintf("onepanel:%v:%v", mapping.Namespace, mapping.Role)
+}
+
+// mapOIDCGroups returns the mappings of the identity provider groups a user is a member of
+func mapOIDCGroups(mappings []*v1.OIDCGroupMapping, groups []string) []*v1.OIDCGroupMapping {
+	memberOf := make(map[string]bool)
+	for _, group := range groups {
+		memberOf[group] = true
+	}
+
+	result := make([]*v1.OIDCGroupMapping, 0)
+	for _, mapping := range mappings {
+		if memberOf[mapping.Group] {
+			result = append(result, mapping)
+		}
+	}
+
+	return result
+}
+
+// ensureOIDCRoleBinding binds the role of a mapping, in its namespace, to the group users of the mapping impersonate
+func ensureOIDCRoleBinding(kubeClient kubernetes.Interface, mapping *v1.OIDCGroupMapping) error {
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "onepanel-oidc-" + mapping.Role,
+			Namespace: mapping.Namespace,
+			Labels: map[string]string{
+				"onepanel.io/oidc": "true",
+			},
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:     rbacv1.GroupKind,
+				APIGroup: rbacv1.GroupName,
+				Name:     OIDCImpersonationGroup(mapping),
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     mapping.Role,
+		},
+	}
+
+	_, err := kubeClient.RbacV1().RoleBindings(mapping.Namespace).Create(roleBinding)
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// claimStrings returns a claim that is either a string or a list of strings
+func claimStrings(claims map[string]interface{}, name string) []string {
+	switch value := claims[name].(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		result := make([]string, 0, len(value))
+		for _, item := range value {
+			if str, ok := item.(string); ok {
+				result = append(result, str)
+			}
+		}
+		return result
+	}
+
+	return nil
+}
+
+// cookieDomain returns the domain of the session cookie, so it is sent to workspaces on subdomains too
+func cookieDomain(config v1.SystemConfig) string {
+	if domain := config.Domain(); domain != nil {
+		return *domain
+	}
+
+	return ""
+}
+
+// secureCookies returns true if Onepanel is served over https
+func secureCookies(config v1.SystemConfig) bool {
+	protocol := config.APIProtocol()
+	return protocol != nil && *protocol == "https://"
+}
+
+// ServeHTTP serves the login, callback and logout endpoints
+func (h *OIDCHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	config, err := h.systemConfig()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": "OIDCHandler.ServeHTTP",
+			"Error":  err.Error(),
+		}).Error("Unable to get system config.")
+		http.Error(w, "Unable to get configuration.", http.StatusInternalServerError)
+		return
+	}
+
+	oidcConfig, err := config.OIDC()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": "OIDCHandler.ServeHTTP",
+			"Error":  err.Error(),
+		}).Error("Invalid OIDC configuration.")
+		http.Error(w, "Invalid OIDC configuration.", http.StatusInternalServerError)
+		return
+	}
+	if oidcConfig == nil {
+		http.Error(w, "OIDC login is not configured.", http.StatusNotFound)
+		return
+	}
+
+	switch strings.TrimPrefix(r.URL.Path, OIDCPathPrefix) {
+	case "login":
+		h.login(w, r, config, oidcConfig)
+	case "callback":
+		h.callback(w, r, config, oidcConfig)
+	case "logout":
+		http.SetCookie(w, &http.Cookie{
+			Name:     SessionCookieName,
+			Value:    "",
+			Path:     "/",
+			Domain:   cookieDomain(config),
+			MaxAge:   -1,
+			HttpOnly: true,
+			Secure:   secureCookies(config),
+		})
+		http.Redirect(w, r, safeRedirect(config, r.URL.Query().Get("redirect")), http.StatusFound)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// oauth2Config returns the configuration of the authorization code flow with provider
+func oauth2Config(oidcConfig *v1.OIDCConfig, provider *oidc.Provider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     oidcConfig.ClientID,
+		ClientSecret: oidcConfig.ClientSecret,
+		RedirectURL:  oidcConfig.RedirectURL,
+		Endpoint:     provider.Endpoint(),
+		Scopes:       oidcConfig.Scopes,
+	}
+}
+
+// login redirects to the identity provider, keeping the state of the login in a signed cookie
+func (h *OIDCHandler) login(w http.ResponseWriter, r *http.Request, config v1.SystemConfig, oidcConfig *v1.OIDCConfig) {
+	provider, err := h.getProvider(r.Context(), oidcConfig.IssuerURL)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": "OIDCHandler.login",
+			"Issuer": oidcConfig.IssuerURL,
+			"Error":  err.Error(),
+		}).Error("Unable to discover identity provider.")
+		http.Error(w, "Unable to reach the identity provider.", http.StatusBadGateway)
+		return
+	}
+
+	state, err := randomString()
+	if err != nil {
+		http.Error(w, "Unable to start login.", http.StatusInternalServerError)
+		return
+	}
+	nonce, err := randomString()
+	if err != nil {
+		http.Error(w, "Unable to start login.", http.StatusInternalServerError)
+		return
+	}
+
+	loginState, err := encodeSigned(config.HMACKey(), signingPurposeOIDCState, &oidcLoginState{
+		State:     state,
+		Nonce:     nonce,
+		Redirect:  safeRedirect(config, r.URL.Query().Get("redirect")),
+		ExpiresAt: h.now().Add(oidcStateDuration),
+	})
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": "OIDCHandler.login",
+			"Error":  err.Error(),
+		}).Error("Unable to sign login state.")
+		http.Error(w, "Unable to start login.", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    loginState,
+		Path:     OIDCPathPrefix,
+		MaxAge:   int(oidcStateDuration.Seconds()),
+		HttpOnly: true,
+		Secure:   secureCookies(config),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, oauth2Config(oidcConfig, provider).AuthCodeURL(state, oidc.Nonce(nonce)), http.StatusFound)
+}
+
+// callback exchanges the authorization code for an ID token, maps the user's groups to namespaces and
+// sets the session cookie
+func (h *OIDCHandler) callback(w http.ResponseWriter, r *http.Request, config v1.SystemConfig, oidcConfig *v1.OIDCConfig) {
+	query := r.URL.Query()
+	if errorCode := query.Get("error"); errorCode != "" {
+		http.Error(w, fmt.Sprintf("Login failed: %v %v", errorCode, query.Get("error_description")), http.StatusUnauthorized)
+		return
+	}
+
+	stateCookie, err := r.Cookie(oidcStateCookieName)
+	if err != nil {
+		http.Error(w, "Login expired, please try again.", http.StatusBadRequest)
+		return
+	}
+	loginState := &oidcLoginState{}
+	if err := decodeSigned(config.HMACKey(), signingPurposeOIDCState, stateCookie.Value, loginState); err != nil ||
+		loginState.State != query.Get("state") || !h.now().Before(loginState.ExpiresAt) {
+		http.Error(w, "Login expired, please try again.", http.StatusBadRequest)
+		return
+	}
+
+	provider, err := h.getProvider(r.Context(), oidcConfig.IssuerURL)
+	if err != nil {
+		http.Error(w, "Unable to reach the identity provider.", http.StatusBadGateway)
+		return
+	}
+
+	token, err := oauth2Config(oidcConfig, provider).Exchange(r.Context(), query.Get("code"))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"Method": "OIDCHandler.callback",
+			"Error":  err.Error(),
+		}).Error("Unable to exchange authorization code.")
+		http.Error(w, "Login failed.", http.StatusUnauthorized)
+		return
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok {
+		http.Error(w, "The identity provider did not return an ID token.", http.StatusUnauthorized)
+		return
+	}
+
+	verifier := provider.Verifier(&oidc.Config{ClientID: oidcConfig.ClientID, Now: h.now})
+	idToken, err := verifier.Verify(r.Context(), rawIDToken)
+	if err != nil || idToken.Nonce != loginState.Nonce {
+		if err == nil {
+			err = fmt.Errorf("nonce does not match")
+		}
+		log.WithFields(log.Fields{
+			"Method": "OIDCHandler.callback",
+			"Error":  err.Error(),
+		}).Error("Invalid ID token.")
+		http.Error(w, "Login failed.", http.StatusUnauthorized)
+		return
+	}
+
+	claims := make(map[string]interface{})
+	if err := idToken.Claims(&claims); err != nil {
+		http.Error(w, "Login failed.", http.StatusUnauthorized)
+		return
+	}
+
+	usernames := claimStrings(claims, oidcConfig.UsernameClaim)
+	if len(usernames) == 0 || usernames[0] == "" {
+		http.Error(w, fmt.Sprintf("The ID token has no %v claim.", oidcConfig.UsernameClaim), http.StatusUnauthorized)
+		return
+	}
+	username := oidcUsernamePrefix + usernames[0]
+
+	mappings := mapOIDCGroups(oidcConfig.GroupMappings, claimStrings(claims, oidcConfig.GroupsClaim))
+	if len(mappings) == 0 {
+		http.Error(w, "None of your groups have access to Onepanel.", http.StatusForbidden)
+		return
+	}
+
+	kubeClient, err := h.kubeClient()
+	if err != nil {
+		http.Error(w, "Unable to connect to Kubernetes.", http.StatusInternalServerError)
+		return
+	}
+
+	groups := make([]string, 0, len(mappings))
+	for _, mapping := range mappings {
+		if err := ensureOIDCRoleBinding(kubeClient, mapping); err != nil {
+			log.WithFields(log.Fields{
+				"Method":    "OIDCHandler.callback",
+				"Namespace": mapping.Namespace,
+				"Role":      mapping.Role,
+				"Error":     err.Error(),
+			}).Error("Unable to bind role.")
+			http.Error(w, "Unable to grant access to namespaces.", http.StatusInternalServerError)
+			return
+		}
+		groups = append(groups, OIDCImpersonationGroup(mapping))
+	}
+
+	expiresAt := h.now().Add(oidcConfig.SessionDuration)
+	sessionToken, err := NewSessionToken(config.HMACKey(), &Session{
+		Username:  username,
+		Groups:    groups,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		http.Error(w, "Unable to create session.", http.StatusInternalServerError)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookieName,
+		Value:    "",
+		Path:     OIDCPathPrefix,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   secureCookies(config),
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    sessionToken,
+		Path:     "/",
+		Domain:   cookieDomain(config),
+		Expires:  expiresAt,
+		HttpOnly: true,
+		Secure:   secureCookies(config),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, loginState.Redirect, http.StatusFound)
+}
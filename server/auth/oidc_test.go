@@ -0,0 +1,281 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/square/go-jose.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeIdentityProvider is an OpenID Connect provider that issues ID tokens for a single user
+type fakeIdentityProvider struct {
+	*httptest.Server
+	key    *rsa.PrivateKey
+	claims map[string]interface{}
+	nonce  string
+}
+
+func newFakeIdentityProvider(t *testing.T, claims map[string]interface{}) *fakeIdentityProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	idp := &fakeIdentityProvider{key: key, claims: claims}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                idp.URL,
+			"authorization_endpoint":                idp.URL + "/authorize",
+			"token_endpoint":                        idp.URL + "/token",
+			"jwks_uri":                              idp.URL + "/keys",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &key.PublicKey, KeyID: "test", Algorithm: "RS256", Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     idp.idToken(t),
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+
+	return idp
+}
+
+// idToken signs an ID token with the claims of the user and the nonce of the last login
+func (idp *fakeIdentityProvider) idToken(t *testing.T) string {
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: idp.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", "test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := map[string]interface{}{
+		"iss":   idp.URL,
+		"sub":   "user",
+		"aud":   "onepanel",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": idp.nonce,
+	}
+	for name, value := range idp.claims {
+		claims[name] = value
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := signed.CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return token
+}
+
+func testOIDCHandler(idp *fakeIdentityProvider, kubeClient kubernetes.Interface) *OIDCHandler {
+	config := v1.SystemConfig{
+		"hmac":             "secret",
+		"ONEPANEL_DOMAIN":  "onepanel.test",
+		"ONEPANEL_FQDN":    "app.onepanel.test",
+		"ONEPANEL_API_URL": "https://app.onepanel.test/api",
+		"oidcIssuerURL":    idp.URL,
+		"oidcClientID":     "onepanel",
+		"oidcClientSecret": "client-secret",
+		"oidcGroupMappings": `
+- group: data-science
+  namespace: team-a
+  role: onepanel-editor
+- group: admins
+  namespace: team-b
+  role: onepanel-admin`,
+	}
+
+	handler := NewOIDCHandler()
+	handler.systemConfig = func() (v1.SystemConfig, error) {
+		return config, nil
+	}
+	handler.kubeClient = func() (kubernetes.Interface, error) {
+		return kubeClient, nil
+	}
+
+	return handler
+}
+
+// login starts a login and returns the query of the redirect to the identity provider and the state cookie
+func login(t *testing.T, handler http.Handler, redirect string) (url.Values, *http.Cookie) {
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/auth/oidc/login?redirect="+url.QueryEscape(redirect), nil))
+	if !assert.Equal(t, http.StatusFound, recorder.Code) {
+		t.FailNow()
+	}
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	assert.Nil(t, err)
+
+	var stateCookie *http.Cookie
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == oidcStateCookieName {
+			stateCookie = cookie
+		}
+	}
+	if !assert.NotNil(t, stateCookie) {
+		t.FailNow()
+	}
+
+	return location.Query(), stateCookie
+}
+
+func callback(handler http.Handler, query string, stateCookie *http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?"+query, nil)
+	req.AddCookie(stateCookie)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func Test_OIDCHandler_Login(t *testing.T) {
+	idp := newFakeIdentityProvider(t, map[string]interface{}{
+		"email":  "jane@example.com",
+		"groups": []string{"data-science", "marketing"},
+	})
+	defer idp.Close()
+
+	kubeClient := fake.NewSimpleClientset()
+	handler := testOIDCHandler(idp, kubeClient)
+
+	query, stateCookie := login(t, handler, "/team-a/workflows")
+	assert.Equal(t, "onepanel", query.Get("client_id"))
+	assert.Equal(t, "https://app.onepanel.test/api/auth/oidc/callback", query.Get("redirect_uri"))
+	idp.nonce = query.Get("nonce")
+
+	recorder := callback(handler, "code=code&state="+query.Get("state"), stateCookie)
+	if !assert.Equal(t, http.StatusFound, recorder.Code, recorder.Body.String()) {
+		return
+	}
+	assert.Equal(t, "/team-a/workflows", recorder.Header().Get("Location"))
+
+	var sessionCookie *http.Cookie
+	for _, cookie := range recorder.Result().Cookies() {
+		if cookie.Name == SessionCookieName {
+			sessionCookie = cookie
+		}
+	}
+	if !assert.NotNil(t, sessionCookie) {
+		return
+	}
+	assert.Equal(t, "onepanel.test", sessionCookie.Domain)
+	assert.True(t, sessionCookie.HttpOnly)
+	assert.True(t, sessionCookie.Secure)
+
+	session, err := ParseSessionToken([]byte("secret"), sessionCookie.Value, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, "oidc:jane@example.com", session.Username)
+	assert.Equal(t, []string{"onepanel:team-a:onepanel-editor"}, session.Groups)
+
+	roleBinding, err := kubeClient.RbacV1().RoleBindings("team-a").Get("onepanel-oidc-onepanel-editor", metav1.GetOptions{})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "onepanel-editor", roleBinding.RoleRef.Name)
+		assert.Equal(t, "onepanel:team-a:onepanel-editor", roleBinding.Subjects[0].Name)
+	}
+	_, err = kubeClient.RbacV1().RoleBindings("team-b").Get("onepanel-oidc-onepanel-admin", metav1.GetOptions{})
+	assert.NotNil(t, err)
+
+	// Logging in again keeps the existing role binding
+	query, stateCookie = login(t, handler, "")
+	idp.nonce = query.Get("nonce")
+	recorder = callback(handler, "code=code&state="+query.Get("state"), stateCookie)
+	assert.Equal(t, http.StatusFound, recorder.Code, recorder.Body.String())
+	assert.Equal(t, "https://app.onepanel.test", recorder.Header().Get("Location"))
+}
+
+func Test_OIDCHandler_Callback_Rejected(t *testing.T) {
+	idp := newFakeIdentityProvider(t, map[string]interface{}{
+		"email":  "john@example.com",
+		"groups": []string{"marketing"},
+	})
+	defer idp.Close()
+
+	handler := testOIDCHandler(idp, fake.NewSimpleClientset())
+
+	// State doesn't match the cookie
+	query, stateCookie := login(t, handler, "")
+	idp.nonce = query.Get("nonce")
+	recorder := callback(handler, "code=code&state=other", stateCookie)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+
+	// Nonce doesn't match the login
+	idp.nonce = "other"
+	recorder = callback(handler, "code=code&state="+query.Get("state"), stateCookie)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// Invalid code
+	idp.nonce = query.Get("nonce")
+	recorder = callback(handler, "code=invalid&state="+query.Get("state"), stateCookie)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+
+	// None of the user's groups are mapped
+	recorder = callback(handler, "code=code&state="+query.Get("state"), stateCookie)
+	assert.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+func Test_safeRedirect(t *testing.T) {
+	config := v1.SystemConfig{
+		"ONEPANEL_DOMAIN":  "onepanel.test",
+		"ONEPANEL_FQDN":    "app.onepanel.test",
+		"ONEPANEL_API_URL": "https://app.onepanel.test/api",
+	}
+
+	assert.Equal(t, "https://app.onepanel.test", safeRedirect(config, ""))
+	assert.Equal(t, "/workflows", safeRedirect(config, "/workflows"))
+	assert.Equal(t, "https://ws--team-a.onepanel.test/", safeRedirect(config, "https://ws--team-a.onepanel.test/"))
+	assert.Equal(t, "https://app.onepanel.test", safeRedirect(config, "//evil.test"))
+	assert.Equal(t, "https://app.onepanel.test", safeRedirect(config, "https://evil.test/onepanel.test"))
+	assert.Equal(t, "https://app.onepanel.test", safeRedirect(config, "javascript:alert(1)"))
+}
+
+func Test_mapOIDCGroups(t *testing.T) {
+	mappings := []*v1.OIDCGroupMapping{
+		{Group: "data-science", Namespace: "team-a", Role: "onepanel-editor"},
+		{Group: "data-science", Namespace: "team-b", Role: "onepanel-viewer"},
+		{Group: "admins", Namespace: "team-a", Role: "onepanel-admin"},
+	}
+
+	result := mapOIDCGroups(mappings, []string{"data-science"})
+	if assert.Len(t, result, 2) {
+		assert.Equal(t, "onepanel:team-a:onepanel-editor", OIDCImpersonationGroup(result[0]))
+		assert.Equal(t, "onepanel:team-b:onepanel-viewer", OIDCImpersonationGroup(result[1]))
+	}
+
+	assert.Empty(t, mapOIDCGroups(mappings, nil))
+}
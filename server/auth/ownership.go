@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/onepanelio/core/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ownershipExemptMethods are called by workflows to report on themselves, as their service account, so they
+// are not limited to the owner of the resource when called by a service account of the resource's namespace
+var ownershipExemptMethods = map[string]bool{
+	"UpdateWorkspaceStatus":               true,
+	"UpdateWorkflowExecutionStatus":       true,
+	"AddWorkflowExecutionStatistics":      true,
+	"CronStartWorkflowExecutionStatistic": true,
+	"AddWorkflowExecutionMetrics":         true,
+	"UpdateWorkflowExecutionMetrics":      true,
+}
+
+// Caller is the user that made a request and whether they are an admin of the namespace of the request
+type Caller struct {
+	User
+	Admin bool
+}
+
+// GetRequestUser returns the user that made the request in ctx, e.g. to record them as the owner of a resource
+func GetRequestUser(ctx context.Context) (*User, error) {
+	client, ok := ctx.Value(ContextClientKey).(*v1.Client)
+	if !ok || client == nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthenticated.")
+	}
+
+	return requestUser(ctx, client)
+}
+
+// GetCaller returns the user that made the request in ctx and whether they are an admin of namespace.
+// Users with Onepanel roles are admins if they have the admin role. Otherwise, they are admins if Kubernetes RBAC
+// lets them manage role bindings in the namespace.
+func GetCaller(ctx context.Context, namespace string) (*Caller, error) {
+	user, err := GetRequestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	client := ctx.Value(ContextClientKey).(*v1.Client)
+
+	caller := &Caller{User: *user}
+	namespaceRoles, _ := ctx.Value(ContextRolesKey).(map[string][]*v1.Role)
+	if roles, ok := namespaceRoles[namespace]; ok {
+		for _, role := range roles {
+			if role.Name == "admin" {
+				caller.Admin = true
+			}
+		}
+		return caller, nil
+	}
+
+	// IsAuthorized returns an error when the review is denied, which only means the caller is not an admin
+	caller.Admin, _ = IsAuthorized(client, namespace, "create", "rbac.authorization.k8s.io", "rolebindings", "")
+
+	return caller, nil
+}
+
+// CanAccess returns true if the caller can see and change a resource with ownership
+func (c *Caller) CanAccess(ownership *v1.Ownership) bool {
+	return c.Admin || ownership.CanAccess(c.Username, c.Groups)
+}
+
+// isNamespaceServiceAccount returns true if user is a service account of namespace, like the ones workflows run as
+func isNamespaceServiceAccount(user *User, namespace string) bool {
+	return strings.HasPrefix(user.Username, serviceAccountUsernamePrefix+namespace+":")
+}
+
+// AuthorizeOwnership returns an error if the caller of ctx can't access the resource with uid in namespace.
+// Resources without ownership are not limited.
+func AuthorizeOwnership(ctx context.Context, resource, namespace, uid string) error {
+	client, ok := ctx.Value(ContextClientKey).(*v1.Client)
+	if !ok || client == nil {
+		return nil
+	}
+
+	ownership, err := client.GetOwnership(resource, namespace, uid)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	caller, err := GetCaller(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if !caller.CanAccess(ownership) {
+		return status.Errorf(codes.PermissionDenied, "%v is owned by another user and is not shared with you.", uid)
+	}
+
+	return nil
+}
+
+// authorizeOwnership returns an error if req is for a workspace, workflow execution or cron workflow
+// the caller can't access
+func authorizeOwnership(ctx context.Context, fullMethod string, req interface{}) error {
+	resource, _, ok := MethodResourceAction(fullMethod)
+	if !ok {
+		return nil
+	}
+	if _, ok := v1.OwnedResources[resource]; !ok {
+		return nil
+	}
+
+	nsReq, ok := req.(namespaced)
+	if !ok || nsReq.GetNamespace() == "" {
+		return nil
+	}
+	uidReq, ok := req.(interface{ GetUid() string })
+	if !ok || uidReq.GetUid() == "" {
+		return nil
+	}
+
+	if client, ok := ctx.Value(ContextClientKey).(*v1.Client); !ok || client == nil {
+		return nil
+	}
+
+	if ownershipExemptMethods[methodName(fullMethod)] {
+		user, err := GetRequestUser(ctx)
+		if err != nil {
+			return err
+		}
+		if isNamespaceServiceAccount(user, nsReq.GetNamespace()) {
+			return nil
+		}
+	}
+
+	return AuthorizeOwnership(ctx, resource, nsReq.GetNamespace(), uidReq.GetUid())
+}
+
+// OwnershipUnaryInterceptor rejects requests for workspaces, workflow executions and cron workflows the caller
+// can't access. It must come after RoleUnaryInterceptor, which adds the roles of the request to its context.
+func OwnershipUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		if err := authorizeOwnership(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// ownershipServerStream checks the resource of a stream once its request is received
+type ownershipServerStream struct {
+	grpc.ServerStream
+	fullMethod string
+	authorized bool
+}
+
+// RecvMsg receives a message and authorizes the first one
+func (s *ownershipServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if !s.authorized {
+		if err := authorizeOwnership(s.Context(), s.fullMethod, m); err != nil {
+			return err
+		}
+		s.authorized = true
+	}
+
+	return nil
+}
+
+// OwnershipStreamInterceptor rejects streaming requests, like logs, for resources the caller can't access.
+// It must come after RoleStreamInterceptor.
+func OwnershipStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &ownershipServerStream{
+			ServerStream: ss,
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
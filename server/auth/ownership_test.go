@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_authorizeOwnership_Skipped(t *testing.T) {
+	ctx := context.Background()
+
+	// Workflows report their status as a service account of their namespace
+	serviceAccountCtx := context.WithValue(ctx, ContextClientKey, &v1.Client{})
+	serviceAccountCtx = context.WithValue(serviceAccountCtx, ContextAPITokenKey, &v1.APIToken{Owner: "system:serviceaccount:onepanel:default"})
+	assert.Nil(t, authorizeOwnership(serviceAccountCtx, "/api.WorkspaceService/UpdateWorkspaceStatus", &api.UpdateWorkspaceStatusRequest{Namespace: "onepanel", Uid: "cvat"}))
+	// Secrets don't have owners
+	assert.Nil(t, authorizeOwnership(ctx, "/api.SecretService/DeleteSecret", &api.DeleteSecretRequest{Namespace: "onepanel"}))
+	// Lists are filtered instead
+	assert.Nil(t, authorizeOwnership(ctx, "/api.WorkspaceService/ListWorkspaces", &api.ListWorkspaceRequest{Namespace: "onepanel"}))
+	// Requests without a client are rejected by UnaryInterceptor
+	assert.Nil(t, authorizeOwnership(ctx, "/api.WorkspaceService/PauseWorkspace", &api.PauseWorkspaceRequest{Namespace: "onepanel", Uid: "cvat"}))
+}
+
+func TestCaller_CanAccess(t *testing.T) {
+	ownership := &v1.Ownership{Owner: "jane", Visibility: v1.VisibilityPrivate}
+
+	assert.True(t, (&Caller{User: User{Username: "jane"}}).CanAccess(ownership))
+	assert.False(t, (&Caller{User: User{Username: "bob"}}).CanAccess(ownership))
+	assert.True(t, (&Caller{User: User{Username: "bob"}, Admin: true}).CanAccess(ownership))
+}
+
+func Test_isNamespaceServiceAccount(t *testing.T) {
+	assert.True(t, isNamespaceServiceAccount(&User{Username: "system:serviceaccount:onepanel:default"}, "onepanel"))
+	assert.False(t, isNamespaceServiceAccount(&User{Username: "system:serviceaccount:onepanel-2:default"}, "onepanel"))
+	assert.False(t, isNamespaceServiceAccount(&User{Username: "system:serviceaccount:other:default"}, "onepanel"))
+	assert.False(t, isNamespaceServiceAccount(&User{Username: "oidc:jane@example.com"}, "onepanel"))
+}
@@ -0,0 +1,273 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/onepanelio/core/pkg"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodName returns the name of a gRPC method without its service, e.g. PauseWorkspace
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// authorizeNamespaceRoles checks the request against the role bindings of its namespace that apply to user.
+// It returns the roles if they allow the request, nil if the user has no roles in the namespace, which leaves
+// the request to Kubernetes RBAC, or an error if their roles don't allow it.
+func authorizeNamespaceRoles(bindings []*v1.RoleBinding, user *User, namespace, fullMethod string, roleReq *v1.RoleRequest) ([]*v1.Role, error) {
+	roles := v1.BoundRoles(bindings, user.Username, user.Groups)
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	allowed, err := RolesAllow(roles, roleReq)
+	if err != nil {
+		return nil, err
+	}
+	if allowed {
+		return roles, nil
+	}
+
+	return nil, status.Errorf(codes.PermissionDenied, "Your roles in namespace '%v' don't allow %v.", namespace, methodName(fullMethod))
+}
+
+// authorizeRoles checks the request against the roles bound to its user in every namespace the request touches.
+// Users without roles in a namespace are left to Kubernetes RBAC there, and ctx is returned as is if they have
+// no roles in any of them.
+// If their roles allow the request, the returned context has a client that impersonates the user and
+// the RoleImpersonationGroup of each of those namespaces, so users don't need Kubernetes permissions to act on
+// the namespaces' resources, and can't act on other namespaces.
+func authorizeRoles(ctx context.Context, fullMethod string, req interface{}) (context.Context, error) {
+	client, ok := ctx.Value(ContextClientKey).(*v1.Client)
+	if !ok || client == nil {
+		return ctx, nil
+	}
+
+	namespaces := requestNamespaces(req)
+	if len(namespaces) == 0 {
+		return ctx, nil
+	}
+
+	resource, action, ok := MethodResourceAction(fullMethod)
+	if !ok || clusterResources[resource] {
+		return ctx, nil
+	}
+
+	var user *User
+	namespaceRoles := make(map[string][]*v1.Role)
+	for _, namespace := range namespaces {
+		bindings, err := client.ListRoleBindings(namespace)
+		if err != nil {
+			return nil, err
+		}
+		if len(bindings) == 0 {
+			continue
+		}
+
+		if user == nil {
+			user, err = requestUser(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		roleReq := &v1.RoleRequest{
+			Resource: resource,
+			Action:   action,
+			Method:   methodName(fullMethod),
+		}
+		if uidReq, ok := req.(interface{ GetUid() string }); ok && resource == "workspaces" && uidReq.GetUid() != "" {
+			workspaceNamespace := namespace
+			roleReq.WorkspaceTemplate = func() (string, error) {
+				return client.GetWorkspaceTemplateUID(workspaceNamespace, uidReq.GetUid())
+			}
+		}
+
+		roles, err := authorizeNamespaceRoles(bindings, user, namespace, fullMethod, roleReq)
+		if err != nil {
+			return nil, err
+		}
+		if roles == nil {
+			continue
+		}
+
+		namespaceRoles[namespace] = roles
+	}
+
+	if len(namespaceRoles) == 0 {
+		return ctx, nil
+	}
+
+	sysConfig, err := client.GetSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+	serverClient, err := v1.NewClientWithContext(ctx, v1.NewConfig(), client.DB, sysConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Kubernetes adds system:authenticated to impersonated users itself
+	groups := make([]string, 0, len(user.Groups)+len(namespaceRoles))
+	for _, group := range user.Groups {
+		if group != "system:authenticated" {
+			groups = append(groups, group)
+		}
+	}
+	for namespace := range namespaceRoles {
+		if err := serverClient.EnsureRoleImpersonation(namespace); err != nil {
+			return nil, err
+		}
+		groups = append(groups, v1.RoleImpersonationGroup(namespace))
+	}
+
+	config, err := newImpersonatingConfig(user.Username, groups)
+	if err != nil {
+		return nil, err
+	}
+	roleClient, err := v1.NewClientWithContext(ctx, config, client.DB, sysConfig)
+	if err != nil {
+		return nil, err
+	}
+	roleClient.Token = client.Token
+
+	ctx = context.WithValue(ctx, ContextRolesKey, namespaceRoles)
+
+	return context.WithValue(ctx, ContextClientKey, roleClient), nil
+}
+
+// AuthorizeNamespaceResources checks that the roles bound to the user of ctx in namespace allow action on each of
+// resources, e.g. reading what a request copies from a namespace other than its own. Methods of cluster resources,
+// like those of namespaces, aren't checked against roles by RoleUnaryInterceptor, so their handlers call it.
+// Users without roles in namespace are left to Kubernetes RBAC.
+func AuthorizeNamespaceResources(ctx context.Context, namespace, action string, resources ...string) error {
+	roles, err := GetNamespaceRoles(ctx, namespace)
+	if err != nil || len(roles) == 0 {
+		return err
+	}
+
+	for _, resource := range resources {
+		if err := authorizeResourceRoles(roles, namespace, action, resource); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authorizeResourceRoles returns an error if none of roles allow action on resource
+func authorizeResourceRoles(roles []*v1.Role, namespace, action, resource string) error {
+	allowed, err := RolesAllow(roles, &v1.RoleRequest{
+		Resource: resource,
+		Action:   action,
+	})
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "Your roles in namespace '%v' don't allow %v on %v.", namespace, action, resource)
+	}
+
+	return nil
+}
+
+// GetNamespaceRoles returns the roles bound to the user of ctx in namespace, if any
+func GetNamespaceRoles(ctx context.Context, namespace string) ([]*v1.Role, error) {
+	client, ok := ctx.Value(ContextClientKey).(*v1.Client)
+	if !ok || client == nil {
+		return nil, nil
+	}
+
+	bindings, err := client.ListRoleBindings(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if len(bindings) == 0 {
+		return nil, nil
+	}
+
+	user, err := requestUser(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return v1.BoundRoles(bindings, user.Username, user.Groups), nil
+}
+
+// WithNamespaceRoles returns a context with roles as the roles of its user in namespace, like the context
+// RoleUnaryInterceptor passes on, so GetCaller uses them
+func WithNamespaceRoles(ctx context.Context, namespace string, roles []*v1.Role) context.Context {
+	return context.WithValue(ctx, ContextRolesKey, map[string][]*v1.Role{namespace: roles})
+}
+
+// RolesAllow returns true if one of roles allows roleReq
+func RolesAllow(roles []*v1.Role, roleReq *v1.RoleRequest) (bool, error) {
+	for _, role := range roles {
+		allowed, err := role.Allows(roleReq)
+		if err != nil || allowed {
+			return allowed, err
+		}
+	}
+
+	return false, nil
+}
+
+// RoleUnaryInterceptor enforces the roles bound to users in namespaces.
+// It must come after UnaryInterceptor, which adds the client of the request to its context.
+func RoleUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		ctx, err = authorizeRoles(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// roleServerStream enforces roles once the request of a stream is received, as it has the namespace
+type roleServerStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	fullMethod string
+	authorized bool
+}
+
+// Context returns the context of the stream, with the client chosen by authorizeRoles once the request is received
+func (s *roleServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// RecvMsg receives a message and authorizes the first one
+func (s *roleServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if !s.authorized {
+		ctx, err := authorizeRoles(s.ctx, s.fullMethod, m)
+		if err != nil {
+			return err
+		}
+		s.ctx = ctx
+		s.authorized = true
+	}
+
+	return nil
+}
+
+// RoleStreamInterceptor enforces the roles bound to users in namespaces for streaming requests.
+// It must come after StreamingInterceptor.
+func RoleStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &roleServerStream{
+			ServerStream: ss,
+			ctx:          ss.Context(),
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_methodName(t *testing.T) {
+	assert.Equal(t, "PauseWorkspace", methodName("/api.WorkspaceService/PauseWorkspace"))
+}
+
+func Test_authorizeRoles_NoClient(t *testing.T) {
+	ctx := context.Background()
+
+	result, err := authorizeRoles(ctx, "/api.WorkspaceService/PauseWorkspace", &api.PauseWorkspaceRequest{Namespace: "onepanel"})
+	assert.Nil(t, err)
+	assert.Equal(t, ctx, result)
+}
+
+func Test_authorizeRoles_ClusterResource(t *testing.T) {
+	// The client has no database, so role bindings must not be looked up
+	ctx := context.WithValue(context.Background(), ContextClientKey, &v1.Client{})
+
+	result, err := authorizeRoles(ctx, "/api.NamespaceService/DeleteNamespace", &api.DeleteNamespaceRequest{Namespace: "team-a"})
+	assert.Nil(t, err)
+	assert.Equal(t, ctx, result)
+}
+
+func Test_authorizeNamespaceRoles(t *testing.T) {
+	bindings := []*v1.RoleBinding{
+		{SubjectKind: v1.RoleSubjectUser, SubjectName: "jane", Role: "annotator"},
+	}
+	pause := func(templateUID string) *v1.RoleRequest {
+		return &v1.RoleRequest{
+			Resource: "workspaces",
+			Action:   "update",
+			Method:   "PauseWorkspace",
+			WorkspaceTemplate: func() (string, error) {
+				return templateUID, nil
+			},
+		}
+	}
+
+	roles, err := authorizeNamespaceRoles(bindings, &User{Username: "jane"}, "team-a", "/api.WorkspaceService/PauseWorkspace", pause("cvat"))
+	assert.Nil(t, err)
+	assert.Len(t, roles, 1)
+
+	_, err = authorizeNamespaceRoles(bindings, &User{Username: "jane"}, "team-a", "/api.WorkspaceService/PauseWorkspace", pause("jupyterlab"))
+	assert.NotNil(t, err)
+
+	// Users without roles are left to Kubernetes RBAC
+	roles, err = authorizeNamespaceRoles(bindings, &User{Username: "john"}, "team-a", "/api.WorkspaceService/PauseWorkspace", pause("jupyterlab"))
+	assert.Nil(t, err)
+	assert.Nil(t, roles)
+}
+
+func TestAuthorizeNamespaceResources_NoClient(t *testing.T) {
+	assert.Nil(t, AuthorizeNamespaceResources(context.Background(), "team-a", "read", "secrets"))
+}
+
+func Test_authorizeResourceRoles(t *testing.T) {
+	viewer := []*v1.Role{v1.GetRole("viewer")}
+	assert.Nil(t, authorizeResourceRoles(viewer, "team-a", "read", "workflowtemplates"))
+	assert.NotNil(t, authorizeResourceRoles(viewer, "team-a", "read", "secrets"))
+	assert.NotNil(t, authorizeResourceRoles(viewer, "team-a", "create", "workflowtemplates"))
+
+	// Rules limited to methods don't apply
+	annotator := []*v1.Role{v1.GetRole("annotator")}
+	assert.NotNil(t, authorizeResourceRoles(annotator, "team-a", "update", "workspaces"))
+
+	developer := []*v1.Role{v1.GetRole("developer")}
+	assert.Nil(t, authorizeResourceRoles(developer, "team-a", "read", "secrets"))
+	assert.NotNil(t, authorizeResourceRoles(developer, "team-a", "read", "roles"))
+	assert.Nil(t, authorizeResourceRoles(append(developer, v1.GetRole("admin")), "team-a", "read", "roles"))
+}
+
+func TestKubernetesRoleRequest(t *testing.T) {
+	assert.Equal(t, "read", KubernetesRoleRequest("get", "workspaces").Action)
+	assert.Equal(t, "update", KubernetesRoleRequest("patch", "workspaces").Action)
+	assert.Equal(t, "approve", KubernetesRoleRequest("approve", "workflows").Action)
+}
+
+// TestRolesAllow_WorkspaceURL tests that an annotator can open the URL of a CVAT workspace, which is checked
+// as get on the workspace in the onepanel.io group
+func TestRolesAllow_WorkspaceURL(t *testing.T) {
+	annotator := []*v1.Role{v1.GetRole("annotator")}
+	open := func(verb, resource, templateUID string) bool {
+		roleReq := KubernetesRoleRequest(verb, resource)
+		roleReq.WorkspaceTemplate = func() (string, error) {
+			return templateUID, nil
+		}
+		allowed, err := RolesAllow(annotator, roleReq)
+		assert.Nil(t, err)
+
+		return allowed
+	}
+
+	assert.True(t, open("get", "workspaces", "cvat"))
+	assert.False(t, open("delete", "workspaces", "cvat"))
+	assert.False(t, open("get", "workflows", ""))
+
+	allowed, err := RolesAllow(nil, KubernetesRoleRequest("get", "workspaces"))
+	assert.Nil(t, err)
+	assert.False(t, allowed)
+}
@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// SessionTokenPrefix starts every session token, so they can be told apart from Kubernetes tokens
+const SessionTokenPrefix = "onepanel-session."
+
+// Values are signed with a key derived for their purpose, so a value signed for one purpose,
+// like the OIDC login state, can't be passed off as another, like a session.
+const (
+	signingPurposeSession   = "session"
+	signingPurposeOIDCState = "oidc-state"
+)
+
+// Session is a user that logged in with an identity provider. Requests made with the session
+// impersonate Username and Groups in Kubernetes.
+type Session struct {
+	Username  string    `json:"username"`
+	Groups    []string  `json:"groups"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// IsSessionToken returns true if token is a session token rather than a Kubernetes token
+func IsSessionToken(token string) bool {
+	return strings.HasPrefix(token, SessionTokenPrefix)
+}
+
+// sign returns the signature of an encoded value for purpose
+func sign(key []byte, purpose, encoded string) string {
+	purposeMac := hmac.New(sha256.New, key)
+	purposeMac.Write([]byte(purpose))
+
+	mac := hmac.New(sha256.New, purposeMac.Sum(nil))
+	mac.Write([]byte(encoded))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// encodeSigned encodes value as JSON followed by its signature with key for purpose
+func encodeSigned(key []byte, purpose string, value interface{}) (string, error) {
+	if len(key) == 0 {
+		return "", errors.New("the hmac key is not set")
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(data)
+
+	return encoded + "." + sign(key, purpose, encoded), nil
+}
+
+// decodeSigned verifies the signature of a value encoded by encodeSigned for purpose and decodes it into value
+func decodeSigned(key []byte, purpose, signed string, value interface{}) error {
+	if len(key) == 0 {
+		return errors.New("the hmac key is not set")
+	}
+
+	parts := strings.Split(signed, ".")
+	if len(parts) != 2 {
+		return errors.New("malformed signed value")
+	}
+
+	if !hmac.Equal([]byte(sign(key, purpose, parts[0])), []byte(parts[1])) {
+		return errors.New("invalid signature")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, value)
+}
+
+// NewSessionToken encodes a session into a token signed with key
+func NewSessionToken(key []byte, session *Session) (string, error) {
+	encoded, err := encodeSigned(key, signingPurposeSession, session)
+	if err != nil {
+		return "", err
+	}
+
+	return SessionTokenPrefix + encoded, nil
+}
+
+// ParseSessionToken verifies the signature of a session token and returns its session, if it has not expired
+func ParseSessionToken(key []byte, token string, now time.Time) (*Session, error) {
+	if !IsSessionToken(token) {
+		return nil, errors.New("not a session token")
+	}
+
+	session := &Session{}
+	if err := decodeSigned(key, signingPurposeSession, strings.TrimPrefix(token, SessionTokenPrefix), session); err != nil {
+		return nil, err
+	}
+
+	if session.Username == "" {
+		return nil, errors.New("session has no username")
+	}
+
+	if !now.Before(session.ExpiresAt) {
+		return nil, errors.New("session has expired")
+	}
+
+	return session, nil
+}
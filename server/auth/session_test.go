@@ -0,0 +1,75 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ParseSessionToken(t *testing.T) {
+	key := []byte("secret")
+	now := time.Now()
+
+	token, err := NewSessionToken(key, &Session{
+		Username:  "oidc:jane@example.com",
+		Groups:    []string{"onepanel:team-a:onepanel-editor"},
+		ExpiresAt: now.Add(time.Hour),
+	})
+	assert.Nil(t, err)
+	assert.True(t, IsSessionToken(token))
+
+	session, err := ParseSessionToken(key, token, now)
+	assert.Nil(t, err)
+	assert.Equal(t, "oidc:jane@example.com", session.Username)
+	assert.Equal(t, []string{"onepanel:team-a:onepanel-editor"}, session.Groups)
+
+	_, err = ParseSessionToken([]byte("other"), token, now)
+	assert.NotNil(t, err)
+
+	_, err = ParseSessionToken(key, token, now.Add(2*time.Hour))
+	assert.NotNil(t, err)
+
+	_, err = ParseSessionToken(key, token+"x", now)
+	assert.NotNil(t, err)
+
+	_, err = NewSessionToken(nil, &Session{})
+	assert.NotNil(t, err)
+}
+
+func Test_ParseSessionToken_OtherPurpose(t *testing.T) {
+	key := []byte("secret")
+	now := time.Now()
+
+	loginState, err := encodeSigned(key, signingPurposeOIDCState, &oidcLoginState{
+		State:     "state",
+		ExpiresAt: now.Add(time.Hour),
+	})
+	assert.Nil(t, err)
+
+	_, err = ParseSessionToken(key, SessionTokenPrefix+loginState, now)
+	assert.NotNil(t, err)
+
+	state := &oidcLoginState{}
+	assert.Nil(t, decodeSigned(key, signingPurposeOIDCState, loginState, state))
+	assert.Equal(t, "state", state.State)
+}
+
+func Test_ParseSessionToken_NoUsername(t *testing.T) {
+	key := []byte("secret")
+	now := time.Now()
+
+	token, err := NewSessionToken(key, &Session{
+		Groups:    []string{"system:masters"},
+		ExpiresAt: now.Add(time.Hour),
+	})
+	assert.Nil(t, err)
+
+	_, err = ParseSessionToken(key, token, now)
+	assert.NotNil(t, err)
+}
+
+func Test_newImpersonatingConfig(t *testing.T) {
+	_, err := newImpersonatingConfig("", []string{"system:masters"})
+	assert.NotNil(t, err)
+}
@@ -40,11 +40,48 @@ func (a *AuthServer) IsAuthorized(ctx context.Context, request *api.IsAuthorized
 		return nil, err
 	}
 
-	//Check the request
-	allowed, err := auth.IsAuthorized(client, request.IsAuthorized.Namespace, request.IsAuthorized.Verb, request.IsAuthorized.Group, request.IsAuthorized.Resource, request.IsAuthorized.ResourceName)
+	isAuthorized := request.IsAuthorized
+	if isAuthorized == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "IsAuthorized is required.")
+	}
+
+	// Users with Onepanel roles in the namespace are authorized by them, like they are for API calls,
+	// as the namespace is nested in the request where the role interceptor doesn't look for it
+	roles, err := auth.GetNamespaceRoles(ctx, isAuthorized.Namespace)
 	if err != nil {
-		res.Authorized = false
-		return res, util.NewUserError(codes.PermissionDenied, fmt.Sprintf("Namespace: %v, Verb: %v, Group: \"%v\", Resource: %v. Source: %v", request.IsAuthorized.Namespace, request.IsAuthorized.Verb, request.IsAuthorized.Group, request.IsAuthorized.ResourceName, err))
+		return nil, err
+	}
+
+	allowed := false
+	if len(roles) > 0 {
+		roleReq := auth.KubernetesRoleRequest(isAuthorized.Verb, isAuthorized.Resource)
+		if isAuthorized.Resource == "workspaces" && isAuthorized.ResourceName != "" {
+			roleReq.WorkspaceTemplate = func() (string, error) {
+				return client.GetWorkspaceTemplateUID(isAuthorized.Namespace, isAuthorized.ResourceName)
+			}
+		}
+		allowed, err = auth.RolesAllow(roles, roleReq)
+		if err != nil {
+			return nil, err
+		}
+		ctx = auth.WithNamespaceRoles(ctx, isAuthorized.Namespace, roles)
+	} else {
+		//Check the request
+		allowed, err = auth.IsAuthorized(client, isAuthorized.Namespace, isAuthorized.Verb, isAuthorized.Group, isAuthorized.Resource, isAuthorized.ResourceName)
+		if err != nil {
+			res.Authorized = false
+			return res, util.NewUserError(codes.PermissionDenied, fmt.Sprintf("Namespace: %v, Verb: %v, Group: \"%v\", Resource: %v. Source: %v", isAuthorized.Namespace, isAuthorized.Verb, isAuthorized.Group, isAuthorized.ResourceName, err))
+		}
+	}
+
+	// Workspace URLs are checked with the workspace's uid, private workspaces are only for their owner and admins
+	if allowed && isAuthorized.Group == "onepanel.io" && isAuthorized.Resource == "workspaces" && isAuthorized.ResourceName != "" {
+		if err := auth.AuthorizeOwnership(ctx, "workspaces", isAuthorized.Namespace, isAuthorized.ResourceName); err != nil {
+			if status.Code(err) != codes.PermissionDenied {
+				return nil, err
+			}
+			allowed = false
+		}
 	}
 
 	res.Authorized = allowed
@@ -216,6 +216,7 @@ func WorkflowExecutionStatisticsReportToAPI(report *v1.WorkflowExecutionStatisti
 	stats := &api.WorkflowExecutionStatisticReport{
 		Total:        report.Total,
 		Running:      report.Running,
+		Queued:       report.Queued,
 		Completed:    report.Completed,
 		Failed:       report.Failed,
 		Terminated:   report.Terminated,
@@ -251,3 +252,261 @@ func WorkspaceStatisticsReportToAPI(report *v1.WorkspaceStatisticReport) *api.Wo
 
 	return stats
 }
+
+// WorkflowExecutionRetriesToAPI converts v1.WorkflowExecutionRetries to []*api.WorkflowExecutionRetry
+func WorkflowExecutionRetriesToAPI(retries v1.WorkflowExecutionRetries) []*api.WorkflowExecutionRetry {
+	result := make([]*api.WorkflowExecutionRetry, 0)
+
+	for _, retry := range retries {
+		result = append(result, &api.WorkflowExecutionRetry{
+			NodeId:     retry.NodeID,
+			Phase:      string(retry.Phase),
+			Parameters: ParametersToAPI(retry.Parameters),
+			CreatedAt:  TimestampToAPIString(&retry.CreatedAt),
+		})
+	}
+
+	return result
+}
+
+// WorkflowTemplateConcurrencyToAPI converts *v1.WorkflowTemplateConcurrency to *api.WorkflowTemplateConcurrency
+func WorkflowTemplateConcurrencyToAPI(concurrency *v1.WorkflowTemplateConcurrency) *api.WorkflowTemplateConcurrency {
+	if concurrency == nil {
+		return nil
+	}
+
+	return &api.WorkflowTemplateConcurrency{
+		Limit:    concurrency.Limit,
+		Scope:    concurrency.Scope,
+		LabelKey: concurrency.LabelKey,
+		Order:    concurrency.Order,
+	}
+}
+
+// APIWorkflowTemplateConcurrencyToInternal converts *api.WorkflowTemplateConcurrency to *v1.WorkflowTemplateConcurrency
+func APIWorkflowTemplateConcurrencyToInternal(concurrency *api.WorkflowTemplateConcurrency) *v1.WorkflowTemplateConcurrency {
+	if concurrency == nil {
+		return nil
+	}
+
+	return &v1.WorkflowTemplateConcurrency{
+		Limit:    concurrency.Limit,
+		Scope:    concurrency.Scope,
+		LabelKey: concurrency.LabelKey,
+		Order:    concurrency.Order,
+	}
+}
+
+// LogEntryToAPI converts a v1.LogEntry to an api.LogEntry
+func LogEntryToAPI(entry *v1.LogEntry) *api.LogEntry {
+	result := &api.LogEntry{
+		Content:       entry.Content,
+		Level:         entry.Level,
+		Logger:        entry.Logger,
+		Message:       entry.Message,
+		Fields:        entry.Fields,
+		ContainerName: entry.ContainerName,
+	}
+
+	if entry.Timestamp.After(time.Time{}) {
+		result.Timestamp = entry.Timestamp.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// KubernetesEventsToAPI converts []*v1.KubernetesEvent to []*api.KubernetesEvent
+func KubernetesEventsToAPI(events []*v1.KubernetesEvent) []*api.KubernetesEvent {
+	result := make([]*api.KubernetesEvent, len(events))
+	for i, event := range events {
+		result[i] = &api.KubernetesEvent{
+			Kind:           event.Kind,
+			Name:           event.Name,
+			Type:           event.Type,
+			Reason:         event.Reason,
+			Message:        event.Message,
+			Count:          event.Count,
+			FirstTimestamp: TimestampToAPIString(event.FirstTimestamp),
+			LastTimestamp:  TimestampToAPIString(event.LastTimestamp),
+		}
+	}
+
+	return result
+}
+
+// PodConditionsToAPI converts []*v1.PodCondition to []*api.PodCondition
+func PodConditionsToAPI(conditions []*v1.PodCondition) []*api.PodCondition {
+	result := make([]*api.PodCondition, len(conditions))
+	for i, condition := range conditions {
+		result[i] = &api.PodCondition{
+			Type:    condition.Type,
+			Status:  condition.Status,
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		}
+	}
+
+	return result
+}
+
+// DiagnosticFindingsToAPI converts []*v1.DiagnosticFinding to []*api.DiagnosticFinding
+func DiagnosticFindingsToAPI(findings []*v1.DiagnosticFinding) []*api.DiagnosticFinding {
+	result := make([]*api.DiagnosticFinding, len(findings))
+	for i, finding := range findings {
+		result[i] = &api.DiagnosticFinding{
+			Severity: finding.Severity,
+			Reason:   finding.Reason,
+			Message:  finding.Message,
+			Object:   finding.Object,
+		}
+	}
+
+	return result
+}
+
+// WorkflowStatusSummaryToAPI converts v1.WorkflowStatusSummary to api.WorkflowStatusSummary
+// if summary is nil, nil is returned
+func WorkflowStatusSummaryToAPI(summary *v1.WorkflowStatusSummary) *api.WorkflowStatusSummary {
+	if summary == nil {
+		return nil
+	}
+
+	result := &api.WorkflowStatusSummary{
+		Uid:         summary.UID,
+		Phase:       summary.Phase,
+		Message:     summary.Message,
+		StartedAt:   TimestampToAPIString(summary.StartedAt),
+		FinishedAt:  TimestampToAPIString(summary.FinishedAt),
+		FailedNodes: make([]*api.WorkflowNodeFailure, len(summary.FailedNodes)),
+	}
+	for i, node := range summary.FailedNodes {
+		result.FailedNodes[i] = &api.WorkflowNodeFailure{
+			Id:          node.ID,
+			DisplayName: node.DisplayName,
+			Phase:       node.Phase,
+			Message:     node.Message,
+		}
+	}
+
+	return result
+}
+
+// ResourceUsageToAPI converts v1.ResourceUsage to api.ResourceUsage
+// if usage is nil, nil is returned
+func ResourceUsageToAPI(usage *v1.ResourceUsage) *api.ResourceUsage {
+	if usage == nil {
+		return nil
+	}
+
+	return &api.ResourceUsage{
+		Samples:               int32(usage.Samples),
+		FirstSampledAt:        TimestampToAPIString(usage.FirstSampledAt),
+		LastSampledAt:         TimestampToAPIString(usage.LastSampledAt),
+		CpuAverage:            usage.CPUAverage,
+		CpuPeak:               usage.CPUPeak,
+		MemoryAverage:         usage.MemoryAverage,
+		MemoryPeak:            usage.MemoryPeak,
+		GpuSamples:            int32(usage.GPUSamples),
+		GpuUtilizationAverage: usage.GPUUtilizationAverage,
+		GpuUtilizationPeak:    usage.GPUUtilizationPeak,
+		GpuMemoryPeak:         usage.GPUMemoryPeak,
+		CpuRequest:            usage.CPURequest,
+		CpuLimit:              usage.CPULimit,
+		MemoryRequest:         usage.MemoryRequest,
+		MemoryLimit:           usage.MemoryLimit,
+		GpuRequest:            usage.GPURequest,
+	}
+}
+
+// APITokenToAPI converts a v1.APIToken to an api.APIToken. The hash of the token is not included.
+func APITokenToAPI(apiToken *v1.APIToken) *api.APIToken {
+	if apiToken == nil {
+		return nil
+	}
+
+	return &api.APIToken{
+		Uid:        apiToken.UID,
+		Name:       apiToken.Name,
+		Namespaces: apiToken.Namespaces,
+		Scopes:     apiToken.Scopes,
+		CreatedAt:  TimestampToAPIString(&apiToken.CreatedAt),
+		ExpiresAt:  TimestampToAPIString(&apiToken.ExpiresAt),
+		LastUsedAt: TimestampToAPIString(apiToken.LastUsedAt),
+	}
+}
+
+// AuditEventToAPI converts a v1.AuditEvent to an api.AuditEvent
+func AuditEventToAPI(event *v1.AuditEvent) *api.AuditEvent {
+	result := &api.AuditEvent{
+		Uid:          event.UID,
+		CreatedAt:    TimestampToAPIString(&event.CreatedAt),
+		Username:     event.Username,
+		Groups:       event.Groups,
+		Namespace:    event.Namespace,
+		ResourceType: event.ResourceType,
+		ResourceUid:  event.ResourceUID,
+		Method:       event.Method,
+		Action:       event.Action,
+		Code:         event.Code,
+		Message:      event.Message,
+	}
+	if event.Request != nil {
+		result.Request = *event.Request
+	}
+
+	return result
+}
+
+// RoleToAPI converts a v1.Role to an api.Role
+func RoleToAPI(role *v1.Role) *api.Role {
+	result := &api.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Rules:       make([]*api.RoleRule, len(role.Rules)),
+	}
+	for i, rule := range role.Rules {
+		result.Rules[i] = &api.RoleRule{
+			Resources:          rule.Resources,
+			Actions:            rule.Actions,
+			Methods:            rule.Methods,
+			WorkspaceTemplates: rule.WorkspaceTemplates,
+		}
+	}
+
+	return result
+}
+
+// RoleBindingToAPI converts a v1.RoleBinding to an api.RoleBinding
+func RoleBindingToAPI(binding *v1.RoleBinding) *api.RoleBinding {
+	return &api.RoleBinding{
+		Uid:         binding.UID,
+		SubjectKind: binding.SubjectKind,
+		SubjectName: binding.SubjectName,
+		Role:        binding.Role,
+		CreatedAt:   TimestampToAPIString(&binding.CreatedAt),
+	}
+}
+
+// SharingToAPI converts a v1.Ownership to an api.Sharing
+func SharingToAPI(ownership *v1.Ownership) *api.Sharing {
+	return &api.Sharing{
+		Visibility: ownership.Visibility,
+		Users:      ownership.SharedUsers,
+		Groups:     ownership.SharedGroups,
+	}
+}
+
+// APISharingToOwnership converts an api.Sharing to a v1.Ownership of owner, sharing may be nil
+func APISharingToOwnership(owner string, sharing *api.Sharing) v1.Ownership {
+	ownership := v1.Ownership{
+		Owner: owner,
+	}
+
+	if sharing != nil {
+		ownership.Visibility = sharing.Visibility
+		ownership.SharedUsers = sharing.Users
+		ownership.SharedGroups = sharing.Groups
+	}
+
+	return ownership
+}
@@ -32,6 +32,8 @@ func apiCronWorkflow(cwf *v1.CronWorkflow) (cronWorkflow *api.CronWorkflow) {
 		Labels:    converter.MappingToKeyValue(cwf.Labels),
 		Manifest:  cwf.Manifest,
 		Namespace: cwf.Namespace,
+		Owner:     cwf.Owner,
+		Sharing:   converter.SharingToAPI(&cwf.Ownership),
 	}
 
 	if cwf.WorkflowExecution != nil {
@@ -87,6 +89,10 @@ func (c *CronWorkflowServer) CreateCronWorkflow(ctx context.Context, req *api.Cr
 		Labels:            converter.APIKeyValueToLabel(req.CronWorkflow.Labels),
 		Namespace:         req.Namespace,
 	}
+	cronWorkflow.Ownership, err = newOwnership(ctx, req.CronWorkflow.Sharing)
+	if err != nil {
+		return nil, err
+	}
 
 	cwf, err := client.CreateCronWorkflow(req.Namespace, &cronWorkflow)
 	if err != nil {
@@ -165,8 +171,13 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 		return nil, err
 	}
 
+	ownership, err := ownershipFilter(ctx, req.Namespace, req.Ownership)
+	if err != nil {
+		return nil, err
+	}
+
 	paginator := pagination.NewRequest(req.Page, req.PageSize)
-	cronWorkflows, err := client.ListCronWorkflows(req.Namespace, req.WorkflowTemplateName, &paginator)
+	cronWorkflows, err := client.ListCronWorkflows(req.Namespace, req.WorkflowTemplateName, &paginator, ownership)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +186,7 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 		apiCronWorkflows = append(apiCronWorkflows, apiCronWorkflow(cwf))
 	}
 
-	count, err := client.CountCronWorkflows(req.Namespace, req.WorkflowTemplateName)
+	count, err := client.CountCronWorkflows(req.Namespace, req.WorkflowTemplateName, ownership)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +200,17 @@ func (c *CronWorkflowServer) ListCronWorkflows(ctx context.Context, req *api.Lis
 	}, nil
 }
 
+// UpdateCronWorkflowSharing changes who, besides its owner, can see and change a cron workflow
+func (c *CronWorkflowServer) UpdateCronWorkflowSharing(ctx context.Context, req *api.UpdateCronWorkflowSharingRequest) (*api.Sharing, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "cronworkflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	return updateSharing(ctx, "cronworkflows", req.Namespace, req.Uid, req.Sharing)
+}
+
 func (c *CronWorkflowServer) DeleteCronWorkflow(ctx context.Context, req *api.DeleteCronWorkflowRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "argoproj.io", "cronworkflows", "")
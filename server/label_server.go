@@ -4,8 +4,11 @@ import (
 	"context"
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/pkg/util/label"
 	"github.com/onepanelio/core/server/auth"
 	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
 	"strings"
 )
 
@@ -52,6 +55,17 @@ func mapKeyValuesToMap(keyValues []*api.KeyValue) map[string]string {
 	return result
 }
 
+// validateUserLabels returns an error if any of the keys is reserved for labels only the system sets
+func validateUserLabels(labels map[string]string) error {
+	for key := range labels {
+		if label.IsReserved(key) {
+			return util.NewUserError(codes.InvalidArgument, "The '"+key+"' label is reserved.")
+		}
+	}
+
+	return nil
+}
+
 // LabelServer is an implementation of the grpc LabelServer
 type LabelServer struct {
 	api.UnimplementedLabelServiceServer
@@ -91,6 +105,9 @@ func (s *LabelServer) AddLabels(ctx context.Context, req *api.AddLabelsRequest)
 	}
 
 	labelsMap := mapKeyValuesToMap(req.Labels.Items)
+	if err := validateUserLabels(labelsMap); err != nil {
+		return nil, err
+	}
 	if err := client.AddLabels(req.Namespace, req.Resource, req.Uid, labelsMap); err != nil {
 		return nil, err
 	}
@@ -115,6 +132,9 @@ func (s *LabelServer) ReplaceLabels(ctx context.Context, req *api.ReplaceLabelsR
 	}
 
 	labelsMap := mapKeyValuesToMap(req.Labels.Items)
+	if err := validateUserLabels(labelsMap); err != nil {
+		return nil, err
+	}
 	if err := client.ReplaceLabels(req.Namespace, req.Resource, req.Uid, labelsMap); err != nil {
 		return nil, err
 	}
@@ -141,6 +161,9 @@ func (s *LabelServer) DeleteLabel(ctx context.Context, req *api.DeleteLabelReque
 
 	labelsMap := make(map[string]string)
 	labelsMap[req.Key] = "placeholder"
+	if err := validateUserLabels(labelsMap); err != nil {
+		return nil, err
+	}
 
 	if err := client.DeleteLabels(req.Namespace, req.Resource, req.Uid, labelsMap); err != nil {
 		return nil, err
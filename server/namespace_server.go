@@ -7,7 +7,10 @@ import (
 
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/server/auth"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
 )
 
 // NamespaceServer is an implementation of the grpc NamespaceServer
@@ -82,12 +85,236 @@ func (s *NamespaceServer) CreateNamespace(ctx context.Context, createNamespace *
 		return nil, err
 	}
 
+	if createNamespace.Namespace == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Namespace is required.")
+	}
+
+	var bootstrap *v1.NamespaceBootstrap
+	if createNamespace.Bootstrap != nil {
+		bootstrap = namespaceBootstrap(createNamespace.Bootstrap)
+		if err := authorizeNamespaceBootstrap(ctx, client, createNamespace.Namespace.Name, bootstrap); err != nil {
+			return nil, err
+		}
+		if err := bootstrap.Validate(); err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	namespace, err := client.CreateNamespace(createNamespace.Namespace.Name)
 	if err != nil {
 		return nil, err
 	}
 
+	if bootstrap != nil {
+		if _, err := client.BootstrapNamespace(namespace.Name, bootstrap); err != nil {
+			// The namespace is deleted so creating it can be retried
+			if _, deleteErr := client.DeleteNamespace(namespace.Name, false); deleteErr != nil {
+				log.WithFields(log.Fields{
+					"Namespace": namespace.Name,
+					"Error":     deleteErr.Error(),
+				}).Error("Could not delete namespace that failed to bootstrap.")
+				return nil, util.NewUserError(codes.Unknown, "Namespace was created but could not be bootstrapped, bootstrap it again to retry.")
+			}
+			return nil, err
+		}
+	}
+
 	return &api.Namespace{
 		Name: namespace.Name,
 	}, nil
 }
+
+// namespaceBootstrap converts the bootstrap of a request
+func namespaceBootstrap(req *api.NamespaceBootstrap) *v1.NamespaceBootstrap {
+	bootstrap := &v1.NamespaceBootstrap{
+		SourceNamespace:      req.SourceNamespace,
+		CopyTemplates:        req.CopyTemplates,
+		CopyRoleBindingUsers: req.CopyRoleBindingUsers,
+		ResourceQuota:        req.ResourceQuota,
+	}
+	for _, binding := range req.RoleBindings {
+		bootstrap.RoleBindings = append(bootstrap.RoleBindings, &v1.RoleBinding{
+			SubjectKind: binding.SubjectKind,
+			SubjectName: binding.SubjectName,
+			Role:        binding.Role,
+		})
+	}
+
+	return bootstrap
+}
+
+// authorizeNamespaceBootstrap checks that the user can read what bootstrap copies from its source namespace,
+// and can create the copied roles and role bindings in namespace without gaining permissions they don't have
+func authorizeNamespaceBootstrap(ctx context.Context, client *v1.Client, namespace string, bootstrap *v1.NamespaceBootstrap) error {
+	if bootstrap.SourceNamespace == "" {
+		return nil
+	}
+
+	roleResources := []string{"config", "secrets", "roles"}
+	if bootstrap.CopyTemplates {
+		roleResources = append(roleResources, "workflowtemplates", "workspacetemplates")
+	}
+	if err := auth.AuthorizeNamespaceResources(ctx, bootstrap.SourceNamespace, "read", roleResources...); err != nil {
+		return err
+	}
+
+	allowed, err := auth.IsAuthorized(client, bootstrap.SourceNamespace, "get", "", "secrets", "onepanel")
+	if err != nil || !allowed {
+		return err
+	}
+	for _, resource := range []string{"roles", "rolebindings"} {
+		allowed, err = auth.IsAuthorized(client, bootstrap.SourceNamespace, "list", "rbac.authorization.k8s.io", resource, "")
+		if err != nil || !allowed {
+			return err
+		}
+	}
+	rbacChecks := []struct {
+		verb     string
+		resource string
+	}{
+		{"create", "roles"},
+		{"escalate", "roles"},
+		{"create", "rolebindings"},
+		{"bind", "roles"},
+		{"bind", "clusterroles"},
+	}
+	for _, check := range rbacChecks {
+		allowed, err = auth.IsAuthorized(client, namespace, check.verb, "rbac.authorization.k8s.io", check.resource, "")
+		if err != nil || !allowed {
+			return err
+		}
+	}
+	if bootstrap.CopyTemplates {
+		allowed, err = auth.IsAuthorized(client, bootstrap.SourceNamespace, "list", "argoproj.io", "workflowtemplates", "")
+		if err != nil || !allowed {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BootstrapNamespace sets up an existing namespace, e.g. one created before bootstrapping was possible
+func (s *NamespaceServer) BootstrapNamespace(ctx context.Context, req *api.BootstrapNamespaceRequest) (*api.NamespaceBootstrapResult, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, "", "update", "", "namespaces", req.Namespace)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Bootstrap == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Bootstrap is required.")
+	}
+	bootstrap := namespaceBootstrap(req.Bootstrap)
+	if err := authorizeNamespaceBootstrap(ctx, client, req.Namespace, bootstrap); err != nil {
+		return nil, err
+	}
+
+	result, err := client.BootstrapNamespace(req.Namespace, bootstrap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.NamespaceBootstrapResult{
+		Created: result.Created,
+		Skipped: result.Skipped,
+	}, nil
+}
+
+// DeleteNamespace deletes a namespace and everything in it, or reports what would be deleted if dryRun is set
+func (s *NamespaceServer) DeleteNamespace(ctx context.Context, req *api.DeleteNamespaceRequest) (*api.NamespaceDeletionReport, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, "", "delete", "", "namespaces", req.Namespace)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	report, err := client.DeleteNamespace(req.Namespace, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.NamespaceDeletionReport{
+		Namespace: report.Namespace,
+		DryRun:    report.DryRun,
+		Resources: report.Resources,
+		Volumes:   report.Volumes,
+		Records:   report.Records,
+	}, nil
+}
+
+// CopyResources copies the selected resources of another namespace to the namespace of the request
+func (s *NamespaceServer) CopyResources(ctx context.Context, req *api.CopyResourcesRequest) (*api.CopyResourcesResponse, error) {
+	client := getClient(ctx)
+	if req.Selection == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Selection is required.")
+	}
+
+	// The user must be able to read what is copied and create it in the namespace
+	checks := []struct {
+		selected     bool
+		group        string
+		resource     string
+		roleResource string
+	}{
+		{len(req.Selection.WorkflowTemplates) > 0, "argoproj.io", "workflowtemplates", "workflowtemplates"},
+		// Workspace templates are authorized as the workflow templates they create
+		{len(req.Selection.WorkspaceTemplates) > 0, "argoproj.io", "workflowtemplates", "workspacetemplates"},
+		{len(req.Selection.CronWorkflows) > 0, "argoproj.io", "cronworkflows", "cronworkflows"},
+		{len(req.Selection.Secrets) > 0, "", "secrets", "secrets"},
+	}
+	for _, check := range checks {
+		if !check.selected {
+			continue
+		}
+		if err := auth.AuthorizeNamespaceResources(ctx, req.SourceNamespace, "read", check.roleResource); err != nil {
+			return nil, err
+		}
+		if err := auth.AuthorizeNamespaceResources(ctx, req.Namespace, "create", check.roleResource); err != nil {
+			return nil, err
+		}
+		allowed, err := auth.IsAuthorized(client, req.SourceNamespace, "get", check.group, check.resource, "")
+		if err != nil || !allowed {
+			return nil, err
+		}
+		allowed, err = auth.IsAuthorized(client, req.Namespace, "create", check.group, check.resource, "")
+		if err != nil || !allowed {
+			return nil, err
+		}
+	}
+
+	user, err := auth.GetRequestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := client.CopyResources(req.SourceNamespace, req.Namespace, &v1.CopySelection{
+		WorkflowTemplates:  req.Selection.WorkflowTemplates,
+		AllVersions:        req.Selection.AllVersions,
+		WorkspaceTemplates: req.Selection.WorkspaceTemplates,
+		CronWorkflows:      req.Selection.CronWorkflows,
+		Secrets:            req.Selection.Secrets,
+		Labels:             req.Selection.Labels,
+		OnConflict:         req.Selection.OnConflict,
+		Owner:              user.Username,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.CopyResourcesResponse{
+		Namespace:       report.TargetNamespace,
+		SourceNamespace: report.SourceNamespace,
+	}
+	for _, result := range report.Results {
+		resp.Results = append(resp.Results, &api.CopyResult{
+			Kind:    result.Kind,
+			Source:  result.Source,
+			Target:  result.Target,
+			Status:  result.Status,
+			Message: result.Message,
+		})
+	}
+
+	return resp, nil
+}
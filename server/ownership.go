@@ -0,0 +1,64 @@
+package server
+
+import (
+	"context"
+
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// newOwnership returns the ownership of a resource created by the request in ctx, shared as sharing
+func newOwnership(ctx context.Context, sharing *api.Sharing) (v1.Ownership, error) {
+	user, err := auth.GetRequestUser(ctx)
+	if err != nil {
+		return v1.Ownership{}, err
+	}
+
+	return converter.APISharingToOwnership(user.Username, sharing), nil
+}
+
+// ownershipFilter returns a filter that limits a list to the resources the caller can access in namespace.
+// value is empty, mine or shared.
+func ownershipFilter(ctx context.Context, namespace, value string) (*v1.OwnershipFilter, error) {
+	caller, err := auth.GetCaller(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := v1.NewOwnershipFilter(value, caller.Username, caller.Groups, caller.Admin)
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	return filter, nil
+}
+
+// updateSharing changes who can access a resource, e.g. workspaces. Only its owner or a namespace admin can.
+func updateSharing(ctx context.Context, resource, namespace, uid string, sharing *api.Sharing) (*api.Sharing, error) {
+	client := getClient(ctx)
+
+	ownership, err := client.GetOwnership(resource, namespace, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := auth.GetCaller(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Admin && !ownership.IsOwner(caller.Username) {
+		return nil, util.NewUserError(codes.PermissionDenied, "Only the owner or a namespace admin can change who this is shared with.")
+	}
+
+	updated := converter.APISharingToOwnership(ownership.Owner, sharing)
+	result, err := client.UpdateSharing(resource, namespace, uid, &updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.SharingToAPI(result), nil
+}
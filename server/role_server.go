@@ -0,0 +1,98 @@
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// RoleServer is an implementation of the grpc RoleServer
+type RoleServer struct {
+	api.UnimplementedRoleServiceServer
+}
+
+// NewRoleServer creates a new RoleServer
+func NewRoleServer() *RoleServer {
+	return &RoleServer{}
+}
+
+// ListRoles returns the roles that can be bound in namespaces
+func (s *RoleServer) ListRoles(ctx context.Context, req *api.ListRolesRequest) (*api.ListRolesResponse, error) {
+	roles := make([]*api.Role, len(v1.Roles))
+	for i, role := range v1.Roles {
+		roles[i] = converter.RoleToAPI(role)
+	}
+
+	return &api.ListRolesResponse{
+		Roles: roles,
+	}, nil
+}
+
+// ListRoleBindings returns the role bindings of a namespace
+func (s *RoleServer) ListRoleBindings(ctx context.Context, req *api.ListRoleBindingsRequest) (*api.ListRoleBindingsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "rbac.authorization.k8s.io", "rolebindings", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	bindings, err := client.ListRoleBindings(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	apiBindings := make([]*api.RoleBinding, len(bindings))
+	for i, binding := range bindings {
+		apiBindings[i] = converter.RoleBindingToAPI(binding)
+	}
+
+	return &api.ListRoleBindingsResponse{
+		Count:        int32(len(apiBindings)),
+		RoleBindings: apiBindings,
+	}, nil
+}
+
+// CreateRoleBinding binds a role to a user or group in a namespace
+func (s *RoleServer) CreateRoleBinding(ctx context.Context, req *api.CreateRoleBindingRequest) (*api.RoleBinding, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "rbac.authorization.k8s.io", "rolebindings", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.RoleBinding == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Role binding is required.")
+	}
+
+	binding, err := client.CreateRoleBinding(req.Namespace, &v1.RoleBinding{
+		SubjectKind: req.RoleBinding.SubjectKind,
+		SubjectName: req.RoleBinding.SubjectName,
+		Role:        req.RoleBinding.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.RoleBindingToAPI(binding), nil
+}
+
+// DeleteRoleBinding deletes a role binding of a namespace
+func (s *RoleServer) DeleteRoleBinding(ctx context.Context, req *api.DeleteRoleBindingRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "delete", "rbac.authorization.k8s.io", "rolebindings", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.DeleteRoleBinding(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
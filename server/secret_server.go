@@ -2,11 +2,14 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	api "github.com/onepanelio/core/api/gen"
 	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
 	"github.com/onepanelio/core/server/auth"
+	"google.golang.org/grpc/codes"
 )
 
 // SecretServer is an implementation of the grpc SecretServer
@@ -21,8 +24,19 @@ func NewSecretServer() *SecretServer {
 
 func apiSecret(s *v1.Secret) *api.Secret {
 	return &api.Secret{
-		Name: s.Name,
-		Data: s.Data,
+		Name:    s.Name,
+		Data:    s.Data,
+		Type:    s.Type,
+		Version: int32(s.Version),
+	}
+}
+
+func apiSecretVersion(v *v1.SecretVersion) *api.SecretVersion {
+	return &api.SecretVersion{
+		Version:   int32(v.Version),
+		Keys:      v.Keys,
+		CreatedAt: v.CreatedAt.UTC().Format(time.RFC3339),
+		Current:   v.Current,
 	}
 }
 
@@ -36,6 +50,7 @@ func (s *SecretServer) CreateSecret(ctx context.Context, req *api.CreateSecretRe
 	err = client.CreateSecret(req.Namespace, &v1.Secret{
 		Name: req.Secret.Name,
 		Data: req.Secret.Data,
+		Type: req.Secret.Type,
 	})
 	if err != nil {
 		return nil, err
@@ -105,7 +120,7 @@ func (s *SecretServer) DeleteSecret(ctx context.Context, req *api.DeleteSecretRe
 		return nil, err
 	}
 
-	isDeleted, err := client.DeleteSecret(req.Namespace, req.Name)
+	isDeleted, err := client.DeleteSecret(req.Namespace, req.Name, req.Force)
 	if err != nil {
 		return &api.DeleteSecretResponse{
 			Deleted: false,
@@ -187,3 +202,135 @@ func (s *SecretServer) UpdateSecretKeyValue(ctx context.Context, req *api.Update
 		Updated: isUpdated,
 	}, nil
 }
+
+func (s *SecretServer) PatchSecret(ctx context.Context, req *api.PatchSecretRequest) (*api.SecretVersion, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "", "secrets", req.Name)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	version, err := client.PatchSecret(req.Namespace, req.Name, &v1.SecretPatch{
+		Upsert: req.Upsert,
+		Delete: req.Delete,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return apiSecretVersion(version), nil
+}
+
+func (s *SecretServer) CreateTypedSecret(ctx context.Context, req *api.CreateTypedSecretRequest) (*api.Secret, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "", "secrets", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	var secret *v1.Secret
+	switch credentials := req.Credentials.(type) {
+	case *api.CreateTypedSecretRequest_DockerRegistry:
+		secret, err = v1.NewDockerConfigJSONSecret(req.Name, &v1.DockerRegistryCredentials{
+			Server:   credentials.DockerRegistry.Server,
+			Username: credentials.DockerRegistry.Username,
+			Password: credentials.DockerRegistry.Password,
+			Email:    credentials.DockerRegistry.Email,
+		})
+	case *api.CreateTypedSecretRequest_S3:
+		secret, err = v1.NewS3Secret(req.Name, &v1.S3Credentials{
+			AccessKey: credentials.S3.AccessKey,
+			SecretKey: credentials.S3.SecretKey,
+		})
+	case *api.CreateTypedSecretRequest_Gcs:
+		secret, err = v1.NewGCSSecret(req.Name, &v1.GCSCredentials{
+			ServiceAccountKey: credentials.Gcs.ServiceAccountKey,
+		})
+	case *api.CreateTypedSecretRequest_Ssh:
+		secret, err = v1.NewSSHSecret(req.Name, &v1.SSHKey{
+			PrivateKey: credentials.Ssh.PrivateKey,
+			KnownHosts: credentials.Ssh.KnownHosts,
+		})
+	default:
+		return nil, util.NewUserError(codes.InvalidArgument, "Registry, S3 or GCS credentials, or an SSH key, are required.")
+	}
+	if err != nil {
+		return nil, util.NewUserError(codes.InvalidArgument, err.Error())
+	}
+
+	if err := client.CreateSecret(req.Namespace, secret); err != nil {
+		return nil, err
+	}
+
+	// Values are not returned, the caller already has them
+	return &api.Secret{
+		Name:    secret.Name,
+		Type:    secret.Type,
+		Version: 1,
+	}, nil
+}
+
+func (s *SecretServer) ListSecretVersions(ctx context.Context, req *api.ListSecretVersionsRequest) (*api.ListSecretVersionsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "", "secrets", req.Name)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	versions, err := client.ListSecretVersions(req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersions := make([]*api.SecretVersion, 0, len(versions))
+	for _, version := range versions {
+		apiVersions = append(apiVersions, apiSecretVersion(version))
+	}
+
+	return &api.ListSecretVersionsResponse{
+		Versions: apiVersions,
+	}, nil
+}
+
+func (s *SecretServer) RollbackSecret(ctx context.Context, req *api.RollbackSecretRequest) (*api.SecretVersion, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "", "secrets", req.Name)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	version, err := client.RollbackSecret(req.Namespace, req.Name, int(req.Version))
+	if err != nil {
+		return nil, err
+	}
+
+	return apiSecretVersion(version), nil
+}
+
+func (s *SecretServer) GetSecretUsage(ctx context.Context, req *api.GetSecretUsageRequest) (*api.SecretUsage, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "", "secrets", req.Name)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	usage, err := client.GetSecretUsage(req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	references := make([]*api.SecretReference, 0, len(usage.References))
+	for _, reference := range usage.References {
+		references = append(references, &api.SecretReference{
+			Kind:    reference.Kind,
+			Uid:     reference.UID,
+			Name:    reference.Name,
+			Version: reference.Version,
+		})
+	}
+
+	return &api.SecretUsage{
+		Name:       usage.Name,
+		References: references,
+	}, nil
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	api "github.com/onepanelio/core/api/gen"
+	v1 "github.com/onepanelio/core/pkg"
+	"github.com/onepanelio/core/pkg/util"
+	"github.com/onepanelio/core/server/auth"
+	"github.com/onepanelio/core/server/converter"
+	"google.golang.org/grpc/codes"
+)
+
+// TokenServer is an implementation of the grpc TokenServer
+type TokenServer struct {
+	api.UnimplementedTokenServiceServer
+}
+
+// NewTokenServer creates a new TokenServer
+func NewTokenServer() *TokenServer {
+	return &TokenServer{}
+}
+
+// getTokenOwner returns the user the API tokens of a request belong to.
+// API tokens can't manage tokens themselves, so a token can't be used to create a token with more access.
+func getTokenOwner(ctx context.Context) (*auth.User, error) {
+	if auth.GetAPIToken(ctx) != nil {
+		return nil, util.NewUserError(codes.PermissionDenied, "API tokens can't be used to manage API tokens.")
+	}
+
+	return auth.GetUser(getClient(ctx))
+}
+
+// CreateAPIToken creates an API token that acts as the current user in the given namespaces, with the given scopes
+func (s *TokenServer) CreateAPIToken(ctx context.Context, req *api.CreateAPITokenRequest) (*api.CreateAPITokenResponse, error) {
+	owner, err := getTokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !auth.CanVerifyAPITokenOwner(owner.Username) {
+		return nil, util.NewUserError(codes.PermissionDenied, "API tokens can only be created by service accounts and users that logged in with the identity provider.")
+	}
+
+	// The groups of a session are only known to be current until it expires, so its tokens expire with it
+	ttl := time.Duration(req.Ttl) * time.Second
+	if owner.ExpiresAt != nil {
+		remaining := time.Until(*owner.ExpiresAt)
+		if ttl == 0 || ttl > remaining {
+			ttl = remaining
+		}
+	}
+
+	// Kubernetes adds system:authenticated to impersonated users itself
+	groups := make([]string, 0, len(owner.Groups))
+	for _, group := range owner.Groups {
+		if group != "system:authenticated" {
+			groups = append(groups, group)
+		}
+	}
+
+	apiToken := &v1.APIToken{
+		Name:        req.Name,
+		Owner:       owner.Username,
+		OwnerGroups: groups,
+		Namespaces:  req.Namespaces,
+		Scopes:      req.Scopes,
+	}
+
+	client := getClient(ctx)
+	token, err := client.CreateAPIToken(apiToken, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CreateAPITokenResponse{
+		ApiToken: converter.APITokenToAPI(apiToken),
+		Token:    token,
+	}, nil
+}
+
+// ListAPITokens returns the API tokens of the current user that have not been revoked
+func (s *TokenServer) ListAPITokens(ctx context.Context, req *api.ListAPITokensRequest) (*api.ListAPITokensResponse, error) {
+	owner, err := getTokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client := getClient(ctx)
+	apiTokens, err := client.ListAPITokens(owner.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*api.APIToken, len(apiTokens))
+	for i, apiToken := range apiTokens {
+		result[i] = converter.APITokenToAPI(apiToken)
+	}
+
+	return &api.ListAPITokensResponse{
+		Count:     int32(len(result)),
+		ApiTokens: result,
+	}, nil
+}
+
+// RevokeAPIToken revokes an API token of the current user
+func (s *TokenServer) RevokeAPIToken(ctx context.Context, req *api.RevokeAPITokenRequest) (*empty.Empty, error) {
+	owner, err := getTokenOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	client := getClient(ctx)
+	if err := client.RevokeAPIToken(owner.Username, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
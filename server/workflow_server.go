@@ -101,6 +101,10 @@ func apiWorkflowExecution(wf *v1.WorkflowExecution, router router.Web) (workflow
 		Manifest:  manifest,
 		Labels:    converter.MappingToKeyValue(wf.Labels),
 		Metrics:   converter.MetricsToAPI(wf.Metrics),
+		Retries:   converter.WorkflowExecutionRetriesToAPI(wf.Retries),
+		Priority:  wf.Priority,
+		Owner:     wf.Owner,
+		Sharing:   converter.SharingToAPI(&wf.Ownership),
 	}
 
 	if wf.StartedAt != nil && !wf.StartedAt.IsZero() {
@@ -130,6 +134,15 @@ func apiWorkflowExecution(wf *v1.WorkflowExecution, router router.Web) (workflow
 	return
 }
 
+func apiWorkflowExecutionApproval(approval *v1.WorkflowExecutionApproval) *api.WorkflowExecutionApproval {
+	return &api.WorkflowExecutionApproval{
+		NodeId:     approval.NodeID,
+		ApprovedBy: approval.ApprovedBy,
+		Comment:    approval.Comment,
+		CreatedAt:  converter.TimestampToAPIString(&approval.CreatedAt),
+	}
+}
+
 func (s *WorkflowServer) CreateWorkflowExecution(ctx context.Context, req *api.CreateWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
@@ -137,12 +150,18 @@ func (s *WorkflowServer) CreateWorkflowExecution(ctx context.Context, req *api.C
 		return nil, err
 	}
 
+	labels := converter.APIKeyValueToLabel(req.Body.Labels)
+	if err := validateUserLabels(labels); err != nil {
+		return nil, err
+	}
+
 	workflow := &v1.WorkflowExecution{
-		Labels: converter.APIKeyValueToLabel(req.Body.Labels),
+		Labels: labels,
 		WorkflowTemplate: &v1.WorkflowTemplate{
 			UID:     req.Body.WorkflowTemplateUid,
 			Version: req.Body.WorkflowTemplateVersion,
 		},
+		Priority: req.Body.Priority,
 	}
 	for _, param := range req.Body.Parameters {
 		workflow.Parameters = append(workflow.Parameters, v1.Parameter{
@@ -151,6 +170,11 @@ func (s *WorkflowServer) CreateWorkflowExecution(ctx context.Context, req *api.C
 		})
 	}
 
+	workflow.Ownership, err = newOwnership(ctx, req.Body.Sharing)
+	if err != nil {
+		return nil, err
+	}
+
 	workflowTemplate, err := client.GetWorkflowTemplate(req.Namespace, req.Body.WorkflowTemplateUid, req.Body.WorkflowTemplateVersion)
 	if err != nil {
 		return nil, err
@@ -177,7 +201,12 @@ func (s *WorkflowServer) CloneWorkflowExecution(ctx context.Context, req *api.Cl
 		return nil, err
 	}
 
-	wf, err := client.CloneWorkflowExecution(req.Namespace, req.Uid)
+	user, err := auth.GetRequestUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	wf, err := client.CloneWorkflowExecution(req.Namespace, req.Uid, user.Username)
 	if err != nil {
 		return nil, err
 	}
@@ -292,7 +321,7 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 		return err
 	}
 
-	watcher, err := client.GetWorkflowExecutionLogs(req.Namespace, req.Uid, req.PodName, req.ContainerName)
+	watcher, err := client.GetWorkflowExecutionLogs(stream.Context(), req.Namespace, req.Uid, req.PodName, req.ContainerName)
 	if err != nil {
 		return err
 	}
@@ -306,13 +335,7 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 
 		apiLogEntries := make([]*api.LogEntry, len(le))
 		for i, item := range le {
-			apiLogEntries[i] = &api.LogEntry{
-				Content: item.Content,
-			}
-
-			if item.Timestamp.After(time.Time{}) {
-				apiLogEntries[i].Timestamp = item.Timestamp.Format(time.RFC3339)
-			}
+			apiLogEntries[i] = converter.LogEntryToAPI(item)
 		}
 
 		if err := stream.Send(&api.LogStreamResponse{
@@ -325,6 +348,175 @@ func (s *WorkflowServer) GetWorkflowExecutionLogs(req *api.GetWorkflowExecutionL
 	return nil
 }
 
+func (s *WorkflowServer) SearchWorkflowExecutionLogs(ctx context.Context, req *api.SearchWorkflowExecutionLogsRequest) (*api.SearchWorkflowExecutionLogsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	search := &v1.WorkflowExecutionLogSearch{
+		Query:         req.Query,
+		Regex:         req.Regex,
+		Level:         req.Level,
+		ContainerName: req.ContainerName,
+		Limit:         int(req.Limit),
+	}
+	if req.Since != "" {
+		since, err := time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Since must be a RFC3339 timestamp.")
+		}
+		search.Since = &since
+	}
+	if req.Until != "" {
+		until, err := time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			return nil, util.NewUserError(codes.InvalidArgument, "Until must be a RFC3339 timestamp.")
+		}
+		search.Until = &until
+	}
+
+	result, err := client.SearchWorkflowExecutionLogs(req.Namespace, req.Uid, search)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.SearchWorkflowExecutionLogsResponse{
+		Matches:   make([]*api.WorkflowExecutionLogMatch, len(result.Matches)),
+		Truncated: result.Truncated,
+	}
+	for i, match := range result.Matches {
+		resp.Matches[i] = &api.WorkflowExecutionLogMatch{
+			PodName:       match.PodName,
+			DisplayName:   match.DisplayName,
+			TemplateName:  match.TemplateName,
+			ContainerName: match.ContainerName,
+			LineNumber:    int32(match.LineNumber),
+			LogEntry:      converter.LogEntryToAPI(match.Entry),
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *WorkflowServer) DownloadWorkflowExecutionLogs(ctx context.Context, req *api.DownloadWorkflowExecutionLogsRequest) (*api.WorkflowExecutionLogsBundle, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	bundle, err := client.GetWorkflowExecutionLogsBundle(req.Namespace, req.Uid, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.WorkflowExecutionLogsBundle{
+		Name:        bundle.Name,
+		ContentType: bundle.ContentType,
+		Data:        bundle.Data,
+	}, nil
+}
+
+// GetWorkflowExecutionDiagnostics explains why the nodes of a workflow execution are pending, or why they failed
+func (s *WorkflowServer) GetWorkflowExecutionDiagnostics(ctx context.Context, req *api.GetWorkflowExecutionDiagnosticsRequest) (*api.WorkflowExecutionDiagnostics, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	diagnostics, err := client.GetWorkflowExecutionDiagnostics(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.WorkflowExecutionDiagnostics{
+		Uid:      diagnostics.UID,
+		Phase:    diagnostics.Phase,
+		Message:  diagnostics.Message,
+		NodePool: diagnostics.NodePool,
+		Nodes:    make([]*api.WorkflowExecutionNodeDiagnostics, len(diagnostics.Nodes)),
+		Findings: converter.DiagnosticFindingsToAPI(diagnostics.Findings),
+	}
+	for i, node := range diagnostics.Nodes {
+		apiNode := &api.WorkflowExecutionNodeDiagnostics{
+			Id:           node.ID,
+			DisplayName:  node.DisplayName,
+			TemplateName: node.TemplateName,
+			Phase:        node.Phase,
+			Message:      node.Message,
+			PodPhase:     node.PodPhase,
+			Conditions:   converter.PodConditionsToAPI(node.Conditions),
+			Events:       converter.KubernetesEventsToAPI(node.Events),
+			OomKilled:    node.OOMKilled,
+			Logs:         make([]*api.LogEntry, len(node.Logs)),
+			Findings:     converter.DiagnosticFindingsToAPI(node.Findings),
+		}
+		if node.ExitCode != nil {
+			apiNode.HasExitCode = true
+			apiNode.ExitCode = *node.ExitCode
+		}
+		for j, entry := range node.Logs {
+			apiNode.Logs[j] = converter.LogEntryToAPI(entry)
+		}
+
+		resp.Nodes[i] = apiNode
+	}
+
+	return resp, nil
+}
+
+// GetWorkflowExecutionTimeline returns the stages of every node of a workflow execution and its critical path
+func (s *WorkflowServer) GetWorkflowExecutionTimeline(ctx context.Context, req *api.GetWorkflowExecutionTimelineRequest) (*api.WorkflowExecutionTimeline, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	timeline, err := client.GetWorkflowExecutionTimeline(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.WorkflowExecutionTimeline{
+		Uid:                        timeline.UID,
+		Phase:                      timeline.Phase,
+		StartedAt:                  converter.TimestampToAPIString(timeline.StartedAt),
+		FinishedAt:                 converter.TimestampToAPIString(timeline.FinishedAt),
+		Nodes:                      make([]*api.WorkflowExecutionTimelineNode, len(timeline.Nodes)),
+		CriticalPath:               timeline.CriticalPath,
+		CriticalPathSeconds:        timeline.CriticalPathSeconds,
+		CriticalPathWaitingSeconds: timeline.CriticalPathWaitingSeconds,
+		CriticalPathRunningSeconds: timeline.CriticalPathRunningSeconds,
+		WaitingSeconds:             timeline.WaitingSeconds,
+		RunningSeconds:             timeline.RunningSeconds,
+	}
+	for i, node := range timeline.Nodes {
+		resp.Nodes[i] = &api.WorkflowExecutionTimelineNode{
+			Id:             node.ID,
+			Name:           node.Name,
+			DisplayName:    node.DisplayName,
+			TemplateName:   node.TemplateName,
+			Type:           node.Type,
+			Phase:          node.Phase,
+			BoundaryId:     node.BoundaryID,
+			Children:       node.Children,
+			QueuedAt:       converter.TimestampToAPIString(node.QueuedAt),
+			ScheduledAt:    converter.TimestampToAPIString(node.ScheduledAt),
+			StartedAt:      converter.TimestampToAPIString(node.StartedAt),
+			FinishedAt:     converter.TimestampToAPIString(node.FinishedAt),
+			WaitingSeconds: node.WaitingSeconds,
+			RunningSeconds: node.RunningSeconds,
+			CriticalPath:   node.CriticalPath,
+		}
+	}
+
+	return resp, nil
+}
+
 func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *api.GetWorkflowExecutionMetricsRequest) (*api.GetWorkflowExecutionMetricsResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -332,11 +524,17 @@ func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *a
 		return nil, err
 	}
 
-	metrics, err := client.GetWorkflowExecutionMetrics(req.Namespace, req.Uid, req.PodName)
+	usage, err := client.GetWorkflowExecutionResourceUsage(req.Namespace, req.Uid, req.PodName)
 	if err != nil {
 		return nil, err
 	}
 
+	// Nodes that don't write sys-metrics.json may still have sampled resource usage
+	metrics, err := client.GetWorkflowExecutionMetrics(req.Namespace, req.Uid, req.PodName)
+	if err != nil && usage == nil {
+		return nil, err
+	}
+
 	var apiMetrics []*api.Metric
 	for _, m := range metrics {
 		apiMetrics = append(apiMetrics, &api.Metric{
@@ -345,11 +543,14 @@ func (s *WorkflowServer) GetWorkflowExecutionMetrics(ctx context.Context, req *a
 			Format: m.Format,
 		})
 	}
-	if len(apiMetrics) == 0 {
+	if len(apiMetrics) == 0 && usage == nil {
 		return nil, util.NewUserError(codes.NotFound, "Metrics were not found.")
 	}
 
-	return &api.GetWorkflowExecutionMetricsResponse{Metrics: apiMetrics}, nil
+	return &api.GetWorkflowExecutionMetricsResponse{
+		Metrics:       apiMetrics,
+		ResourceUsage: converter.ResourceUsageToAPI(usage),
+	}, nil
 }
 
 // ListWorkflowExecutions returns a list of workflow executions that are specified by the criteria in the ListWorkflowExecutionsRequest
@@ -368,12 +569,17 @@ func (s *WorkflowServer) ListWorkflowExecutions(ctx context.Context, req *api.Li
 	if err != nil {
 		return nil, err
 	}
+	ownership, err := ownershipFilter(ctx, req.Namespace, req.Ownership)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceRequest := &request.Request{
 		Pagination: pagination.New(req.Page, req.PageSize),
 		Filter: v1.WorkflowExecutionFilter{
-			Labels: labelFilter,
-			Phase:  req.Phase,
+			Labels:    labelFilter,
+			Phase:     req.Phase,
+			Ownership: ownership,
 		},
 		Sort: reqSort,
 	}
@@ -436,6 +642,124 @@ func (s *WorkflowServer) ResubmitWorkflowExecution(ctx context.Context, req *api
 	return apiWorkflowExecution(wf, webRouter), nil
 }
 
+func (s *WorkflowServer) RetryWorkflowExecution(ctx context.Context, req *api.RetryWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	parameterOverrides := make([]v1.Parameter, 0)
+	for _, param := range req.Parameters {
+		parameterOverrides = append(parameterOverrides, v1.Parameter{
+			Name:  param.Name,
+			Value: ptr.String(param.Value),
+		})
+	}
+
+	wf, err := client.RetryWorkflowExecution(req.Namespace, req.Uid, req.NodeId, parameterOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	wf.Namespace = req.Namespace
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(wf, webRouter), nil
+}
+
+func (s *WorkflowServer) SuspendWorkflowExecution(ctx context.Context, req *api.SuspendWorkflowExecutionRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if err := client.SuspendWorkflowExecution(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+func (s *WorkflowServer) ResumeWorkflowExecution(ctx context.Context, req *api.ResumeWorkflowExecutionRequest) (*api.WorkflowExecution, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	wf, err := client.ResumeWorkflowExecution(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	wf.Namespace = req.Namespace
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecution(wf, webRouter), nil
+}
+
+func (s *WorkflowServer) ApproveWorkflowNode(ctx context.Context, req *api.ApproveWorkflowNodeRequest) (*api.WorkflowExecutionApproval, error) {
+	client := getClient(ctx)
+	// approve is not a Kubernetes verb, it has to be granted on workflows explicitly, or with *
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "approve", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	username, err := auth.GetUsername(client)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := client.ApproveWorkflowNode(req.Namespace, req.Uid, req.NodeId, username, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecutionApproval(approval), nil
+}
+
+func (s *WorkflowServer) ListWorkflowExecutionApprovals(ctx context.Context, req *api.ListWorkflowExecutionApprovalsRequest) (*api.ListWorkflowExecutionApprovalsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	approvals, err := client.ListWorkflowExecutionApprovals(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	apiApprovals := make([]*api.WorkflowExecutionApproval, 0)
+	for _, approval := range approvals {
+		apiApprovals = append(apiApprovals, apiWorkflowExecutionApproval(approval))
+	}
+
+	return &api.ListWorkflowExecutionApprovalsResponse{
+		Approvals: apiApprovals,
+	}, nil
+}
+
+// UpdateWorkflowExecutionSharing changes who, besides its owner, can see and change a workflow execution
+func (s *WorkflowServer) UpdateWorkflowExecutionSharing(ctx context.Context, req *api.UpdateWorkflowExecutionSharingRequest) (*api.Sharing, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	return updateSharing(ctx, "workflows", req.Namespace, req.Uid, req.Sharing)
+}
+
 func (s *WorkflowServer) TerminateWorkflowExecution(ctx context.Context, req *api.TerminateWorkflowExecutionRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
@@ -451,6 +775,119 @@ func (s *WorkflowServer) TerminateWorkflowExecution(ctx context.Context, req *ap
 	return &empty.Empty{}, nil
 }
 
+// apiWorkflowExecutionSweep converts a package workflow execution sweep to the api version
+func apiWorkflowExecutionSweep(sweep *v1.WorkflowExecutionSweep, router router.Web) *api.WorkflowExecutionSweep {
+	result := &api.WorkflowExecutionSweep{
+		Uid:   sweep.UID,
+		Stats: converter.WorkflowExecutionStatisticsReportToAPI(sweep.Statistics),
+	}
+
+	for _, wf := range sweep.Executions {
+		result.WorkflowExecutions = append(result.WorkflowExecutions, apiWorkflowExecution(wf, router))
+	}
+
+	return result
+}
+
+func (s *WorkflowServer) CreateWorkflowExecutionSweep(ctx context.Context, req *api.CreateWorkflowExecutionSweepRequest) (*api.WorkflowExecutionSweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "create", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if req.Body.Sweep == nil {
+		return nil, util.NewUserError(codes.InvalidArgument, "Sweep is required.")
+	}
+
+	labels := converter.APIKeyValueToLabel(req.Body.Labels)
+	if err := validateUserLabels(labels); err != nil {
+		return nil, err
+	}
+
+	workflow := &v1.WorkflowExecution{
+		Labels:   labels,
+		Priority: req.Body.Priority,
+	}
+	workflow.Ownership, err = newOwnership(ctx, req.Body.Sharing)
+	if err != nil {
+		return nil, err
+	}
+	for _, param := range req.Body.Parameters {
+		workflow.Parameters = append(workflow.Parameters, v1.Parameter{
+			Name:  param.Name,
+			Value: ptr.String(param.Value),
+		})
+	}
+
+	spec := &v1.WorkflowExecutionSweepSpec{
+		Strategy: req.Body.Sweep.Strategy,
+		MaxCount: int(req.Body.Sweep.MaxCount),
+		Seed:     req.Body.Sweep.Seed,
+	}
+	for _, param := range req.Body.Sweep.Parameters {
+		spec.Parameters = append(spec.Parameters, v1.WorkflowExecutionSweepParameter{
+			Name:   param.Name,
+			Values: param.Values,
+		})
+	}
+
+	workflowTemplate, err := client.GetWorkflowTemplate(req.Namespace, req.Body.WorkflowTemplateUid, req.Body.WorkflowTemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	sweep, err := client.CreateWorkflowExecutionSweep(req.Namespace, workflow, workflowTemplate, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecutionSweep(sweep, webRouter), nil
+}
+
+func (s *WorkflowServer) GetWorkflowExecutionSweep(ctx context.Context, req *api.GetWorkflowExecutionSweepRequest) (*api.WorkflowExecutionSweep, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "list", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	sweep, err := client.GetWorkflowExecutionSweep(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	webRouter, err := client.GetWebRouter()
+	if err != nil {
+		return nil, err
+	}
+
+	return apiWorkflowExecutionSweep(sweep, webRouter), nil
+}
+
+func (s *WorkflowServer) TerminateWorkflowExecutionSweep(ctx context.Context, req *api.TerminateWorkflowExecutionSweepRequest) (*empty.Empty, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "argoproj.io", "workflows", "")
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	if _, err := client.GetWorkflowExecutionSweep(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	if err := client.TerminateWorkflowExecutionSweep(req.Namespace, req.Uid); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (s *WorkflowServer) GetArtifact(ctx context.Context, req *api.GetArtifactRequest) (*api.ArtifactResponse, error) {
 	client := getClient(ctx)
 	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "argoproj.io", "workflows", req.Uid)
@@ -38,6 +38,7 @@ func apiWorkflowTemplate(wft *v1.WorkflowTemplate) *api.WorkflowTemplate {
 		Parameters:  converter.ParametersToAPI(wft.Parameters),
 		Stats:       converter.WorkflowExecutionStatisticsReportToAPI(wft.WorkflowExecutionStatisticReport),
 		Description: wft.Description,
+		Concurrency: converter.WorkflowTemplateConcurrencyToAPI(wft.Concurrency),
 	}
 
 	if wft.CronWorkflowsStatisticsReport != nil {
@@ -89,6 +90,7 @@ func (s *WorkflowTemplateServer) CreateWorkflowTemplate(ctx context.Context, req
 		Manifest:    req.WorkflowTemplate.Manifest,
 		Labels:      converter.APIKeyValueToLabel(req.WorkflowTemplate.Labels),
 		Description: req.WorkflowTemplate.Description,
+		Concurrency: converter.APIWorkflowTemplateConcurrencyToInternal(req.WorkflowTemplate.Concurrency),
 	}
 	workflowTemplate, err = client.CreateWorkflowTemplate(req.Namespace, workflowTemplate)
 	if err != nil {
@@ -113,6 +115,7 @@ func (s *WorkflowTemplateServer) CreateWorkflowTemplateVersion(ctx context.Conte
 		Manifest:    req.WorkflowTemplate.Manifest,
 		Labels:      converter.APIKeyValueToLabel(req.WorkflowTemplate.Labels),
 		Description: req.WorkflowTemplate.Description,
+		Concurrency: converter.APIWorkflowTemplateConcurrencyToInternal(req.WorkflowTemplate.Concurrency),
 	}
 
 	workflowTemplate, err = client.CreateWorkflowTemplateVersion(req.Namespace, workflowTemplate)
@@ -138,6 +141,7 @@ func (s *WorkflowTemplateServer) GetWorkflowTemplate(ctx context.Context, req *a
 	if err != nil {
 		return nil, err
 	}
+	client.ResolveWorkflowTemplateParameterOptions(req.Namespace, workflowTemplate)
 
 	versionsCount, err := client.CountWorkflowTemplateVersions(req.Namespace, req.Uid)
 	if err != nil {
@@ -76,6 +76,8 @@ func apiWorkspace(wt *v1.Workspace, config v1.SystemConfig) *api.Workspace {
 		CreatedAt:           wt.CreatedAt.UTC().Format(time.RFC3339),
 		Url:                 wt.GetURL(*protocol, *domain),
 		WorkspaceComponents: apiServices,
+		Owner:               wt.Owner,
+		Sharing:             converter.SharingToAPI(&wt.Ownership),
 	}
 	res.Parameters = converter.ParametersToAPI(wt.Parameters)
 
@@ -160,6 +162,11 @@ func (s *WorkspaceServer) CreateWorkspace(ctx context.Context, req *api.CreateWo
 		return nil, util.NewUserError(codes.AlreadyExists, "That name is reserved, choose a different name for the workspace.")
 	}
 
+	workspace.Ownership, err = newOwnership(ctx, req.Body.Sharing)
+	if err != nil {
+		return nil, err
+	}
+
 	workspace, err = client.CreateWorkspace(req.Namespace, workspace)
 	if err != nil {
 		return nil, err
@@ -272,12 +279,17 @@ func (s *WorkspaceServer) ListWorkspaces(ctx context.Context, req *api.ListWorks
 	if err != nil {
 		return nil, err
 	}
+	ownership, err := ownershipFilter(ctx, req.Namespace, req.Ownership)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceRequest := &request.Request{
 		Pagination: pagination.New(req.Page, req.PageSize),
 		Filter: v1.WorkspaceFilter{
-			Labels: labelFilter,
-			Phase:  req.Phase,
+			Labels:    labelFilter,
+			Phase:     req.Phase,
+			Ownership: ownership,
 		},
 		Sort: reqSort,
 	}
@@ -363,6 +375,17 @@ func (s *WorkspaceServer) DeleteWorkspace(ctx context.Context, req *api.DeleteWo
 	return &empty.Empty{}, err
 }
 
+// UpdateWorkspaceSharing changes who, besides its owner, can see and change a workspace
+func (s *WorkspaceServer) UpdateWorkspaceSharing(ctx context.Context, req *api.UpdateWorkspaceSharingRequest) (*api.Sharing, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "update", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	return updateSharing(ctx, "workspaces", req.Namespace, req.Uid, req.Sharing)
+}
+
 // RetryLastWorkspaceAction will attempt the last action on the workspace again.
 func (s *WorkspaceServer) RetryLastWorkspaceAction(ctx context.Context, req *api.RetryActionWorkspaceRequest) (*empty.Empty, error) {
 	client := getClient(ctx)
@@ -451,8 +474,12 @@ func (s *WorkspaceServer) GetWorkspaceContainerLogs(req *api.GetWorkspaceContain
 		return err
 	}
 
-	sinceTime := time.Unix(req.SinceTime, 0)
-	watcher, err := client.GetWorkspaceContainerLogs(req.Namespace, req.Uid, req.ContainerName, sinceTime)
+	watcher, err := client.GetWorkspaceContainerLogs(stream.Context(), req.Namespace, req.Uid, req.ContainerName, &v1.WorkspaceLogOptions{
+		SinceTime: time.Unix(req.SinceTime, 0),
+		TailLines: req.TailLines,
+		Previous:  req.Previous,
+		Follow:    !req.NoFollow,
+	})
 	if err != nil {
 		return err
 	}
@@ -466,13 +493,40 @@ func (s *WorkspaceServer) GetWorkspaceContainerLogs(req *api.GetWorkspaceContain
 
 		apiLogEntries := make([]*api.LogEntry, len(le))
 		for i, item := range le {
-			apiLogEntries[i] = &api.LogEntry{
-				Content: item.Content,
-			}
+			apiLogEntries[i] = converter.LogEntryToAPI(item)
+		}
 
-			if item.Timestamp.After(time.Time{}) {
-				apiLogEntries[i].Timestamp = item.Timestamp.Format(time.RFC3339)
-			}
+		if err := stream.Send(&api.LogStreamResponse{
+			LogEntries: apiLogEntries,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceServer) GetWorkspaceLogs(req *api.GetWorkspaceLogsRequest, stream api.WorkspaceService_GetWorkspaceLogsServer) error {
+	client := getClient(stream.Context())
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return err
+	}
+
+	watcher, err := client.GetWorkspaceLogs(stream.Context(), req.Namespace, req.Uid, req.ContainerNames, &v1.WorkspaceLogOptions{
+		SinceTime: time.Unix(req.SinceTime, 0),
+		TailLines: req.TailLines,
+		Previous:  req.Previous,
+		Follow:    !req.NoFollow,
+	})
+	if err != nil {
+		return err
+	}
+
+	for le := range watcher {
+		apiLogEntries := make([]*api.LogEntry, len(le))
+		for i, item := range le {
+			apiLogEntries[i] = converter.LogEntryToAPI(item)
 		}
 
 		if err := stream.Send(&api.LogStreamResponse{
@@ -485,6 +539,92 @@ func (s *WorkspaceServer) GetWorkspaceContainerLogs(req *api.GetWorkspaceContain
 	return nil
 }
 
+func (s *WorkspaceServer) ListWorkspaceContainers(ctx context.Context, req *api.ListWorkspaceContainersRequest) (*api.ListWorkspaceContainersResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	podName, containers, err := client.ListWorkspaceContainers(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.ListWorkspaceContainersResponse{
+		PodName:    podName,
+		Containers: make([]*api.WorkspaceContainer, len(containers)),
+	}
+	for i, container := range containers {
+		resp.Containers[i] = apiWorkspaceContainer(container)
+	}
+
+	return resp, nil
+}
+
+func apiWorkspaceContainer(container *v1.WorkspaceContainer) *api.WorkspaceContainer {
+	return &api.WorkspaceContainer{
+		Name:         container.Name,
+		Image:        container.Image,
+		Init:         container.Init,
+		Ready:        container.Ready,
+		RestartCount: container.RestartCount,
+		State:        container.State,
+		Reason:       container.Reason,
+	}
+}
+
+// GetWorkspaceDiagnostics returns the events, pod status and workflow status of a workspace with known problems explained
+func (s *WorkspaceServer) GetWorkspaceDiagnostics(ctx context.Context, req *api.GetWorkspaceDiagnosticsRequest) (*api.WorkspaceDiagnostics, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	diagnostics, err := client.GetWorkspaceDiagnostics(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.WorkspaceDiagnostics{
+		Phase:      string(diagnostics.Phase),
+		PodName:    diagnostics.PodName,
+		PodPhase:   diagnostics.PodPhase,
+		Conditions: converter.PodConditionsToAPI(diagnostics.Conditions),
+		Containers: make([]*api.WorkspaceContainer, len(diagnostics.Containers)),
+		Events:     converter.KubernetesEventsToAPI(diagnostics.Events),
+		Workflow:   converter.WorkflowStatusSummaryToAPI(diagnostics.Workflow),
+		Findings:   converter.DiagnosticFindingsToAPI(diagnostics.Findings),
+	}
+	for i, container := range diagnostics.Containers {
+		resp.Containers[i] = apiWorkspaceContainer(container)
+	}
+
+	return resp, nil
+}
+
+// GetWorkspaceMetrics returns the sampled resource usage of a workspace
+func (s *WorkspaceServer) GetWorkspaceMetrics(ctx context.Context, req *api.GetWorkspaceMetricsRequest) (*api.GetWorkspaceMetricsResponse, error) {
+	client := getClient(ctx)
+	allowed, err := auth.IsAuthorized(client, req.Namespace, "get", "onepanel.io", "workspaces", req.Uid)
+	if err != nil || !allowed {
+		return nil, err
+	}
+
+	usage, err := client.GetWorkspaceResourceUsage(req.Namespace, req.Uid)
+	if err != nil {
+		return nil, err
+	}
+	if usage == nil {
+		return nil, util.NewUserError(codes.NotFound, "Metrics were not found.")
+	}
+
+	return &api.GetWorkspaceMetricsResponse{
+		ResourceUsage: converter.ResourceUsageToAPI(usage),
+	}, nil
+}
+
 // ListWorkspacesField returns a list of all the distinct values of a field from Workspaces
 func (s *WorkspaceServer) ListWorkspacesField(ctx context.Context, req *api.ListWorkspacesFieldRequest) (*api.ListWorkspacesFieldResponse, error) {
 	client := getClient(ctx)
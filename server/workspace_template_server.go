@@ -129,6 +129,12 @@ func (s *WorkspaceTemplateServer) GetWorkspaceTemplate(ctx context.Context, req
 	if err != nil {
 		return nil, err
 	}
+	if workspaceTemplate == nil {
+		return nil, util.NewUserError(codes.NotFound, "Workspace template not found.")
+	}
+	if err := client.ResolveWorkspaceTemplateParameterOptions(req.Namespace, workspaceTemplate); err != nil {
+		return nil, err
+	}
 
 	return apiWorkspaceTemplate(workspaceTemplate), nil
 }